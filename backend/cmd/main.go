@@ -16,6 +16,7 @@ import (
 	"job-platform/internal/config"
 	"job-platform/internal/cron"
 	"job-platform/internal/database"
+	"job-platform/internal/realtime"
 	"job-platform/internal/repository"
 	"job-platform/internal/router"
 	"job-platform/internal/search"
@@ -64,6 +65,10 @@ func main() {
 		log.Println("⚠️  Cache service not available")
 	}
 
+	// Initialize real-time hub (delivers events published by any instance to connected users)
+	realtimeHub := realtime.NewHub(redisClient)
+	realtimeHub.Start()
+
 	// Initialize MinIO
 	minioConfig := &storage.MinioConfig{
 		Endpoint:        cfg.MinioEndpoint,
@@ -76,6 +81,8 @@ func main() {
 		BucketCerts:     cfg.MinioBucketCerts,
 		BucketPortfolio: cfg.MinioBucketPortfolios,
 		BucketCompanies: cfg.MinioBucketCompanies,
+		BucketMessages:  cfg.MinioBucketMessages,
+		BucketOffers:    cfg.MinioBucketOffers,
 	}
 
 	minioClient, err := storage.NewMinioClient(minioConfig)
@@ -111,16 +118,17 @@ func main() {
 		log.Println("⚠️  MeiliSearch not configured (MEILI_HOST not set)")
 	}
 
-	// Setup router with MinIO, MeiliSearch, and Cache clients
-	r := router.SetupRouter(cfg, db, redisClient, minioClient, meiliClient, cacheService)
-
-	// Initialize job repositories and services for cron
+	// Initialize job repositories and services (shared by the router, cron and background workers)
 	jobRepo := repository.NewJobRepository(db)
 	jobCategoryRepo := repository.NewJobCategoryRepository(db)
 	applicationRepo := repository.NewApplicationRepository(db)
 	jobViewRepo := repository.NewJobViewRepository(db)
 	userRepo := repository.NewUserRepository(db)
 	blogRepo := repository.NewBlogRepository(db)
+	notificationRepo := repository.NewNotificationRepository(db)
+	notificationPrefsRepo := repository.NewNotificationPreferencesRepository(db)
+	companyRepo := repository.NewCompanyRepository(db)
+	teamRepo := repository.NewTeamRepository(db)
 
 	jobService := service.NewJobService(
 		jobRepo,
@@ -137,15 +145,69 @@ func main() {
 			DefaultExpiryDays:      30,
 		},
 	)
+	jobService.SetTeamRepositories(companyRepo, teamRepo)
+	jobService.SetDuplicateService(service.NewJobDuplicateService(repository.NewJobDuplicateRepository(db), jobRepo, db))
+	webhookService := router.NewWebhookService(cfg, db)
+	jobService.SetWebhookService(webhookService)
+	notificationService := service.NewNotificationService(notificationRepo, notificationPrefsRepo)
+	notificationService.SetPushService(router.NewPushService(cfg, db))
+	notificationService.SetRealtimeHub(realtimeHub)
+	notificationService.SetEmailService(router.NewEmailSender(cfg), userRepo, repository.NewNotificationDigestRepository(db), &service.NotificationEmailConfig{
+		CompanyName:  "Job Platform",
+		SupportEmail: cfg.EmailFrom,
+		FrontendURL:  cfg.FrontendURL,
+	})
+	searchService := service.NewSearchService(meiliClient)
+	jobService.SetSearchAndCache(searchService, cacheService)
 
-	// Start cron scheduler
-	cronScheduler := cron.NewJobCronScheduler(jobService)
+	// Start cron scheduler (expiry warnings and job expiry)
+	cronScheduler := cron.NewJobCronScheduler(jobService, notificationService, searchService, cacheService, 1*time.Hour)
 	cronScheduler.Start()
 
+	// Import queue workers (scrape and create queued job URLs, claim jobs of crashed instances again)
+	scraperService := service.NewScraperService(service.NewAIService())
+	importQueueService := service.NewImportQueueService(repository.NewImportQueueRepository(db), scraperService, jobService)
+
+	// Job upload worker (creates confirmed bulk uploads, resumes uploads interrupted by a restart)
+	jobUploadService := service.NewJobUploadService(repository.NewJobUploadRepository(db), jobService)
+
+	// Setup router with MinIO, MeiliSearch, and Cache clients
+	r := router.SetupRouter(cfg, db, redisClient, minioClient, meiliClient, cacheService, cronScheduler, realtimeHub, jobService, importQueueService, jobUploadService)
+
 	// Start view sync scheduler (syncs Redis view counts to DB every 5 minutes)
 	viewSyncScheduler := cron.NewViewSyncScheduler(cacheService, jobRepo, blogRepo, cache.ViewCountSyncPeriod)
 	viewSyncScheduler.Start()
 
+	// Start import queue workers
+	importQueueService.Start(service.DefaultImportWorkers)
+
+	// Start job upload worker
+	jobUploadService.Start()
+
+	// Start job source scheduler (re-crawls career pages when due)
+	jobSourceRepo := repository.NewJobSourceRepository(db)
+	jobSourceService := service.NewJobSourceService(jobSourceRepo, jobRepo, companyRepo, scraperService, importQueueService, jobService)
+	jobSourceScheduler := cron.NewJobSourceScheduler(jobSourceService, 15*time.Minute)
+	jobSourceScheduler.Start()
+
+	// Start job alert scheduler (matches new jobs to saved searches and sends alerts)
+	savedSearchService := service.NewSavedSearchService(repository.NewSavedSearchRepository(db), jobRepo, router.NewEmailSender(cfg), &service.SavedSearchConfig{
+		CompanyName:  "Job Platform",
+		SupportEmail: cfg.EmailFrom,
+		FrontendURL:  cfg.FrontendURL,
+	})
+	savedSearchService.SetNotificationService(notificationService)
+	jobAlertScheduler := cron.NewJobAlertScheduler(savedSearchService, 15*time.Minute)
+	jobAlertScheduler.Start()
+
+	// Start notification digest scheduler (emails batched high-volume notifications once a day)
+	notificationDigestScheduler := cron.NewNotificationDigestScheduler(notificationService, time.Hour)
+	notificationDigestScheduler.Start()
+
+	// Start webhook delivery scheduler (retries failed webhook deliveries with backoff)
+	webhookDeliveryScheduler := cron.NewWebhookDeliveryScheduler(webhookService, 30*time.Second)
+	webhookDeliveryScheduler.Start()
+
 	// Create HTTP server
 	addr := fmt.Sprintf("%s:%s", cfg.AppHost, cfg.AppPort)
 	srv := &http.Server{
@@ -174,6 +236,15 @@ func main() {
 	// Stop cron schedulers
 	cronScheduler.Stop()
 	viewSyncScheduler.Stop()
+	jobSourceScheduler.Stop()
+	jobAlertScheduler.Stop()
+	notificationDigestScheduler.Stop()
+	webhookDeliveryScheduler.Stop()
+	importQueueService.Stop()
+	jobUploadService.Stop()
+
+	// Close open notification streams so the server can shut down
+	realtimeHub.Stop()
 
 	// Graceful shutdown with timeout
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
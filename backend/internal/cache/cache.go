@@ -23,6 +23,8 @@ const (
 	PrefixCompany      = "company:"
 	PrefixCompanyList  = "company_list:"
 	PrefixLocation     = "locations:"
+	PrefixFeed         = "feed:"
+	PrefixSitemap      = "sitemap:"
 )
 
 // Default TTLs
@@ -37,6 +39,8 @@ const (
 	DefaultCategoryTTL   = 1 * time.Hour  // Categories change rarely
 	DefaultCompanyTTL    = 15 * time.Minute
 	DefaultLocationTTL   = 30 * time.Minute // Locations change rarely
+	DefaultFeedTTL       = 15 * time.Minute // Aggregators poll feeds a few times a day
+	DefaultSitemapTTL    = 1 * time.Hour    // Invalidated whenever listed content changes
 )
 
 // CacheService provides caching operations using Redis
@@ -130,7 +134,13 @@ func (c *CacheService) InvalidateAllJobCaches(ctx context.Context) error {
 	if err := c.DeletePattern(ctx, PrefixJobList+"*"); err != nil {
 		return err
 	}
-	return c.DeletePattern(ctx, PrefixSearch+"jobs:*")
+	if err := c.DeletePattern(ctx, PrefixSearch+"jobs:*"); err != nil {
+		return err
+	}
+	if err := c.InvalidateFeeds(ctx); err != nil {
+		return err
+	}
+	return c.InvalidateSitemaps(ctx, "jobs")
 }
 
 // CacheJobList stores a job list result
@@ -151,6 +161,48 @@ func (c *CacheService) GetCachedJobList(ctx context.Context, cacheKey string, de
 	return c.Get(ctx, key, dest)
 }
 
+// ==================== Feed Caching ====================
+
+// CacheFeed stores a rendered job feed. Feeds are stored as raw XML, not JSON.
+func (c *CacheService) CacheFeed(ctx context.Context, cacheKey string, data []byte) error {
+	key := PrefixFeed + cacheKey
+	return c.client.Set(ctx, key, data, DefaultFeedTTL).Err()
+}
+
+// GetCachedFeed retrieves a rendered job feed from cache
+func (c *CacheService) GetCachedFeed(ctx context.Context, cacheKey string) ([]byte, error) {
+	key := PrefixFeed + cacheKey
+	return c.client.Get(ctx, key).Bytes()
+}
+
+// InvalidateFeeds clears all cached job feeds
+func (c *CacheService) InvalidateFeeds(ctx context.Context) error {
+	return c.DeletePattern(ctx, PrefixFeed+"*")
+}
+
+// ==================== Sitemap Caching ====================
+
+// CacheSitemap stores a rendered sitemap file. Sitemaps are stored as raw XML, not JSON.
+func (c *CacheService) CacheSitemap(ctx context.Context, cacheKey string, data []byte) error {
+	key := PrefixSitemap + cacheKey
+	return c.client.Set(ctx, key, data, DefaultSitemapTTL).Err()
+}
+
+// GetCachedSitemap retrieves a rendered sitemap file from cache
+func (c *CacheService) GetCachedSitemap(ctx context.Context, cacheKey string) ([]byte, error) {
+	key := PrefixSitemap + cacheKey
+	return c.client.Get(ctx, key).Bytes()
+}
+
+// InvalidateSitemaps clears the cached sitemap files of a section (jobs, companies, blogs)
+// along with the sitemap index, whose lastmod values depend on them
+func (c *CacheService) InvalidateSitemaps(ctx context.Context, section string) error {
+	if err := c.DeletePattern(ctx, PrefixSitemap+section+":*"); err != nil {
+		return err
+	}
+	return c.Delete(ctx, PrefixSitemap+"index")
+}
+
 // ==================== Blog Caching ====================
 
 // CacheBlog stores a blog in cache
@@ -196,7 +248,10 @@ func (c *CacheService) InvalidateAllBlogCaches(ctx context.Context) error {
 	if err := c.DeletePattern(ctx, PrefixBlogList+"*"); err != nil {
 		return err
 	}
-	return c.DeletePattern(ctx, PrefixSearch+"blogs:*")
+	if err := c.DeletePattern(ctx, PrefixSearch+"blogs:*"); err != nil {
+		return err
+	}
+	return c.InvalidateSitemaps(ctx, "blogs")
 }
 
 // ==================== Category Caching ====================
@@ -286,7 +341,10 @@ func (c *CacheService) InvalidateCompany(ctx context.Context, companyID string,
 		}
 	}
 	// Also invalidate company lists
-	return c.DeletePattern(ctx, PrefixCompanyList+"*")
+	if err := c.DeletePattern(ctx, PrefixCompanyList+"*"); err != nil {
+		return err
+	}
+	return c.InvalidateSitemaps(ctx, "companies")
 }
 
 // InvalidateAllCompanyCaches clears all company-related caches
@@ -294,7 +352,10 @@ func (c *CacheService) InvalidateAllCompanyCaches(ctx context.Context) error {
 	if err := c.DeletePattern(ctx, PrefixCompany+"*"); err != nil {
 		return err
 	}
-	return c.DeletePattern(ctx, PrefixCompanyList+"*")
+	if err := c.DeletePattern(ctx, PrefixCompanyList+"*"); err != nil {
+		return err
+	}
+	return c.InvalidateSitemaps(ctx, "companies")
 }
 
 // ==================== Search Results Caching ====================
@@ -455,6 +516,8 @@ func (c *CacheService) GetCacheStats(ctx context.Context) (map[string]interface{
 	companyCount, _ := c.countKeys(ctx, PrefixCompany+"*")
 	companyListCount, _ := c.countKeys(ctx, PrefixCompanyList+"*")
 	locationCount, _ := c.countKeys(ctx, PrefixLocation+"*")
+	feedCount, _ := c.countKeys(ctx, PrefixFeed+"*")
+	sitemapCount, _ := c.countKeys(ctx, PrefixSitemap+"*")
 	rateLimitCount, _ := c.countKeys(ctx, "rate_limit:*")
 	ipRateLimitCount, _ := c.countKeys(ctx, "ip_rate_limit:*")
 
@@ -474,6 +537,8 @@ func (c *CacheService) GetCacheStats(ctx context.Context) (map[string]interface{
 		"company_cache":    companyCount,
 		"company_list_cache": companyListCount,
 		"location_cache":   locationCount,
+		"feed_cache":       feedCount,
+		"sitemap_cache":    sitemapCount,
 		"rate_limits":      rateLimitCount + ipRateLimitCount,
 		"redis_info":       info,
 	}, nil
@@ -614,7 +679,10 @@ func (c *CacheService) InvalidateCompanyCaches(ctx context.Context) error {
 	if err := c.DeletePattern(ctx, PrefixCompany+"*"); err != nil {
 		return err
 	}
-	return c.DeletePattern(ctx, PrefixCompanyList+"*")
+	if err := c.DeletePattern(ctx, PrefixCompanyList+"*"); err != nil {
+		return err
+	}
+	return c.InvalidateSitemaps(ctx, "companies")
 }
 
 // InvalidateCategoryCaches clears all category caches
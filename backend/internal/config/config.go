@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -42,6 +43,8 @@ type Config struct {
 	MinioBucketCerts       string
 	MinioBucketPortfolios  string
 	MinioBucketCompanies   string
+	MinioBucketMessages    string
+	MinioBucketOffers      string
 
 	// File Upload Limits
 	MaxResumeSizeMB       int64
@@ -86,6 +89,14 @@ type Config struct {
 	SMTPPassword string
 	EmailFrom    string
 
+	// Web Push (VAPID)
+	VAPIDPublicKey  string
+	VAPIDPrivateKey string
+	VAPIDSubject    string
+
+	// Employer API
+	APIKeyRateLimit int // Requests per minute per API key
+
 	// Admin
 	AdminEmailDomain   string
 	SuperAdminEmail    string
@@ -95,6 +106,9 @@ type Config struct {
 	AdminFrontendURL        string
 	EmailVerificationURL    string
 	PasswordResetURL        string
+
+	// Public URL of the API, used for links in sitemaps and feeds. Defaults to FrontendURL + /api/v1.
+	APIPublicURL string
 }
 
 func Load() (*Config, error) {
@@ -114,6 +128,9 @@ func Load() (*Config, error) {
 	viper.SetDefault("MAX_RESUME_SIZE_MB", 10)
 	viper.SetDefault("MAX_AVATAR_SIZE_MB", 5)
 	viper.SetDefault("RESUME_URL_EXPIRY_HOURS", 24)
+	viper.SetDefault("MINIO_BUCKET_MESSAGES", "messages")
+	viper.SetDefault("MINIO_BUCKET_OFFERS", "offers")
+	viper.SetDefault("API_KEY_RATE_LIMIT", 120)
 
 	cfg := &Config{
 		AppEnv:  viper.GetString("APP_ENV"),
@@ -144,6 +161,8 @@ func Load() (*Config, error) {
 		MinioBucketCerts:      viper.GetString("MINIO_BUCKET_CERTIFICATES"),
 		MinioBucketPortfolios: viper.GetString("MINIO_BUCKET_PORTFOLIOS"),
 		MinioBucketCompanies:  viper.GetString("MINIO_BUCKET_COMPANIES"),
+		MinioBucketMessages:   viper.GetString("MINIO_BUCKET_MESSAGES"),
+		MinioBucketOffers:     viper.GetString("MINIO_BUCKET_OFFERS"),
 
 		// File Upload Limits
 		MaxResumeSizeMB:       viper.GetInt64("MAX_RESUME_SIZE_MB"),
@@ -188,6 +207,14 @@ func Load() (*Config, error) {
 		SMTPPassword: viper.GetString("SMTP_PASSWORD"),
 		EmailFrom:    viper.GetString("EMAIL_FROM"),
 
+		// Web Push (VAPID)
+		VAPIDPublicKey:  viper.GetString("VAPID_PUBLIC_KEY"),
+		VAPIDPrivateKey: viper.GetString("VAPID_PRIVATE_KEY"),
+		VAPIDSubject:    viper.GetString("VAPID_SUBJECT"),
+
+		// Employer API
+		APIKeyRateLimit: viper.GetInt("API_KEY_RATE_LIMIT"),
+
 		// Admin
 		AdminEmailDomain: viper.GetString("ADMIN_EMAIL_DOMAIN"),
 		SuperAdminEmail:  viper.GetString("SUPER_ADMIN_EMAIL"),
@@ -197,6 +224,13 @@ func Load() (*Config, error) {
 		AdminFrontendURL:     viper.GetString("ADMIN_FRONTEND_URL"),
 		EmailVerificationURL: viper.GetString("EMAIL_VERIFICATION_URL"),
 		PasswordResetURL:     viper.GetString("PASSWORD_RESET_URL"),
+
+		APIPublicURL: strings.TrimRight(viper.GetString("API_PUBLIC_URL"), "/"),
+	}
+
+	// The API is served under the frontend's domain unless configured otherwise
+	if cfg.APIPublicURL == "" {
+		cfg.APIPublicURL = strings.TrimRight(cfg.FrontendURL, "/") + "/api/v1"
 	}
 
 	return cfg, nil
@@ -0,0 +1,76 @@
+package cron
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"job-platform/internal/service"
+)
+
+// JobAlertScheduler matches new jobs against saved searches and sends the job alerts that are due
+type JobAlertScheduler struct {
+	savedSearchService *service.SavedSearchService
+	stopChan           chan struct{}
+	interval           time.Duration
+}
+
+// NewJobAlertScheduler creates a new job alert scheduler.
+// The interval is how long instant alerts can take; daily and weekly digests are sent when due.
+func NewJobAlertScheduler(savedSearchService *service.SavedSearchService, interval time.Duration) *JobAlertScheduler {
+	if interval == 0 {
+		interval = 15 * time.Minute // Default check interval
+	}
+	return &JobAlertScheduler{
+		savedSearchService: savedSearchService,
+		stopChan:           make(chan struct{}),
+		interval:           interval,
+	}
+}
+
+// Start begins the job alert scheduler
+func (s *JobAlertScheduler) Start() {
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		log.Printf("✅ Job alert scheduler started (interval: %v)", s.interval)
+
+		for {
+			select {
+			case <-ticker.C:
+				s.sendJobAlerts()
+			case <-s.stopChan:
+				log.Println("🛑 Job alert scheduler stopped")
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the job alert scheduler
+func (s *JobAlertScheduler) Stop() {
+	close(s.stopChan)
+}
+
+// sendJobAlerts records new matches of saved searches and sends the alerts that are due
+func (s *JobAlertScheduler) sendJobAlerts() {
+	ctx := context.Background()
+
+	matched, err := s.savedSearchService.MatchNewJobs(ctx)
+	if err != nil {
+		log.Printf("Error matching saved searches: %v", err)
+	}
+	if matched > 0 {
+		log.Printf("📊 Matched %d new jobs to saved searches", matched)
+	}
+
+	sent, err := s.savedSearchService.SendJobAlerts(ctx, s.interval)
+	if err != nil {
+		log.Printf("Error sending job alerts: %v", err)
+		return
+	}
+	if sent > 0 {
+		log.Printf("📧 Sent %d job alerts", sent)
+	}
+}
@@ -1,42 +1,297 @@
 package cron
 
 import (
+	"context"
+	"job-platform/internal/cache"
 	"job-platform/internal/service"
 	"log"
+	"math"
+	"sync"
+	"time"
 )
 
+const (
+	// TaskExpireJobs marks overdue jobs as expired and removes them from search and caches
+	TaskExpireJobs = "expire_overdue_jobs"
+	// TaskExpiryWarnings notifies employers about jobs that are about to expire
+	TaskExpiryWarnings = "send_expiry_warnings"
+	// TaskPublishScheduled publishes scheduled jobs whose publish time has been reached
+	TaskPublishScheduled = "publish_scheduled_jobs"
+
+	// maxRunHistory is the number of task runs kept for GetSchedulerStatus
+	maxRunHistory = 50
+)
+
+// CronRun records a single execution of a scheduled task
+type CronRun struct {
+	Task       string    `json:"task"`
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+	DurationMs int64     `json:"duration_ms"`
+	Processed  int       `json:"processed"`
+	Error      string    `json:"error,omitempty"`
+}
+
 // JobCronScheduler manages scheduled job-related tasks
 type JobCronScheduler struct {
-	jobService *service.JobService
-	stopChan   chan bool
+	jobService          *service.JobService
+	notificationService *service.NotificationService
+	searchService       *service.SearchService
+	cacheService        *cache.CacheService
+	interval            time.Duration
+	stopChan            chan bool
+
+	mu        sync.RWMutex
+	running   bool
+	startedAt *time.Time
+	nextRunAt *time.Time
+	history   []CronRun // newest first
 }
 
 // NewJobCronScheduler creates a new job cron scheduler
-func NewJobCronScheduler(jobService *service.JobService) *JobCronScheduler {
+func NewJobCronScheduler(
+	jobService *service.JobService,
+	notificationService *service.NotificationService,
+	searchService *service.SearchService,
+	cacheService *cache.CacheService,
+	interval time.Duration,
+) *JobCronScheduler {
+	if interval == 0 {
+		interval = 1 * time.Hour // Default run interval
+	}
 	return &JobCronScheduler{
-		jobService: jobService,
-		stopChan:   make(chan bool),
+		jobService:          jobService,
+		notificationService: notificationService,
+		searchService:       searchService,
+		cacheService:        cacheService,
+		interval:            interval,
+		stopChan:            make(chan bool),
 	}
 }
 
 // Start begins all cron jobs
-// Note: Job expiry has been disabled - jobs never expire automatically
 func (s *JobCronScheduler) Start() {
 	log.Println("🕐 Starting job cron scheduler...")
-	log.Println("✅ Job cron scheduler started (expiry disabled)")
+
+	now := time.Now()
+	s.mu.Lock()
+	s.running = true
+	s.startedAt = &now
+	s.mu.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		// Run once on startup so overdue jobs don't wait for the first tick
+		s.runAll()
+
+		for {
+			select {
+			case <-ticker.C:
+				s.runAll()
+			case <-s.stopChan:
+				return
+			}
+		}
+	}()
+
+	log.Printf("✅ Job cron scheduler started (interval: %v)", s.interval)
 }
 
 // Stop gracefully stops all cron jobs
 func (s *JobCronScheduler) Stop() {
 	log.Println("🛑 Stopping job cron scheduler...")
 	close(s.stopChan)
+
+	s.mu.Lock()
+	s.running = false
+	s.nextRunAt = nil
+	s.mu.Unlock()
+
 	log.Println("✅ Job cron scheduler stopped")
 }
 
+// runAll runs every scheduled task once
+func (s *JobCronScheduler) runAll() {
+	s.runTask(TaskPublishScheduled, s.publishScheduledJobs)
+	// Warn first so jobs expiring within this interval still get a warning
+	s.runTask(TaskExpiryWarnings, s.sendExpiryWarnings)
+	s.runTask(TaskExpireJobs, s.expireOverdueJobs)
+
+	next := time.Now().Add(s.interval)
+	s.mu.Lock()
+	s.nextRunAt = &next
+	s.mu.Unlock()
+}
+
+// runTask executes a task and records it in the run history
+func (s *JobCronScheduler) runTask(name string, task func(ctx context.Context) (int, error)) {
+	run := CronRun{
+		Task:      name,
+		StartedAt: time.Now(),
+	}
+
+	processed, err := task(context.Background())
+
+	run.FinishedAt = time.Now()
+	run.DurationMs = run.FinishedAt.Sub(run.StartedAt).Milliseconds()
+	run.Processed = processed
+	if err != nil {
+		run.Error = err.Error()
+		log.Printf("❌ Cron task %s failed: %v", name, err)
+	} else if processed > 0 {
+		log.Printf("📊 Cron task %s processed %d jobs", name, processed)
+	}
+
+	s.mu.Lock()
+	s.history = append([]CronRun{run}, s.history...)
+	if len(s.history) > maxRunHistory {
+		s.history = s.history[:maxRunHistory]
+	}
+	s.mu.Unlock()
+}
+
+// publishScheduledJobs publishes due scheduled jobs and adds them to search
+func (s *JobCronScheduler) publishScheduledJobs(ctx context.Context) (int, error) {
+	jobs, err := s.jobService.PublishScheduledJobs()
+	if err != nil {
+		return 0, err
+	}
+
+	if len(jobs) == 0 {
+		return 0, nil
+	}
+
+	if s.searchService != nil && s.searchService.IsAvailable() {
+		for i := range jobs {
+			if err := s.searchService.IndexJob(&jobs[i]); err != nil {
+				log.Printf("Error indexing published job %s: %v", jobs[i].ID, err)
+			}
+		}
+	}
+
+	// Cached job lists don't contain the newly published jobs yet
+	if s.cacheService != nil && s.cacheService.IsAvailable() {
+		if err := s.cacheService.InvalidateAllJobCaches(ctx); err != nil {
+			log.Printf("Error invalidating job caches after publishing: %v", err)
+		}
+		if err := s.cacheService.InvalidateLocations(ctx); err != nil {
+			log.Printf("Error invalidating location cache after publishing: %v", err)
+		}
+	}
+
+	return len(jobs), nil
+}
+
+// expireOverdueJobs expires overdue jobs and removes them from search and caches
+func (s *JobCronScheduler) expireOverdueJobs(ctx context.Context) (int, error) {
+	jobs, err := s.jobService.ExpireOverdueJobs()
+	if err != nil {
+		return 0, err
+	}
+
+	if len(jobs) == 0 {
+		return 0, nil
+	}
+
+	// Remove expired jobs from the search index
+	if s.searchService != nil && s.searchService.IsAvailable() {
+		for i := range jobs {
+			if err := s.searchService.DeleteJob(&jobs[i]); err != nil {
+				log.Printf("Error removing expired job %s from search index: %v", jobs[i].ID, err)
+			}
+		}
+	}
+
+	// Drop cached job details, lists and search results that may still contain them
+	if s.cacheService != nil && s.cacheService.IsAvailable() {
+		if err := s.cacheService.InvalidateAllJobCaches(ctx); err != nil {
+			log.Printf("Error invalidating job caches after expiry: %v", err)
+		}
+		if err := s.cacheService.InvalidateLocations(ctx); err != nil {
+			log.Printf("Error invalidating location cache after expiry: %v", err)
+		}
+	}
+
+	return len(jobs), nil
+}
+
+// sendExpiryWarnings notifies employers about jobs entering the warning window
+func (s *JobCronScheduler) sendExpiryWarnings(ctx context.Context) (int, error) {
+	if s.notificationService == nil {
+		return 0, nil
+	}
+
+	jobs, err := s.jobService.GetJobsNeedingExpiryWarning()
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, job := range jobs {
+		if job.ExpiresAt == nil {
+			continue
+		}
+
+		days := int(math.Ceil(time.Until(*job.ExpiresAt).Hours() / 24))
+		if days < 1 {
+			days = 1
+		}
+
+		if err := s.notificationService.NotifyJobExpiringSoon(ctx, job.EmployerID, job.ID, job.Title, days); err != nil {
+			log.Printf("Error sending expiry warning for job %s: %v", job.ID, err)
+			continue
+		}
+
+		if err := s.jobService.MarkExpiryWarningSent(job.ID); err != nil {
+			log.Printf("Error marking expiry warning sent for job %s: %v", job.ID, err)
+			continue
+		}
+		sent++
+	}
+
+	return sent, nil
+}
+
 // GetSchedulerStatus returns the current status of the scheduler
 func (s *JobCronScheduler) GetSchedulerStatus() map[string]interface{} {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	status := "stopped"
+	if s.running {
+		status = "running"
+	}
+
+	history := make([]CronRun, len(s.history))
+	copy(history, s.history)
+
+	// Latest run per task
+	lastRuns := make(map[string]CronRun)
+	for _, run := range history {
+		if _, ok := lastRuns[run.Task]; !ok {
+			lastRuns[run.Task] = run
+		}
+	}
+
+	jobs := []map[string]interface{}{}
+	for _, task := range []string{TaskPublishScheduled, TaskExpiryWarnings, TaskExpireJobs} {
+		job := map[string]interface{}{
+			"name":     task,
+			"interval": s.interval.String(),
+		}
+		if run, ok := lastRuns[task]; ok {
+			job["last_run"] = run
+		}
+		jobs = append(jobs, job)
+	}
+
 	return map[string]interface{}{
-		"status": "running",
-		"jobs":   []map[string]interface{}{},
+		"status":      status,
+		"started_at":  s.startedAt,
+		"next_run_at": s.nextRunAt,
+		"jobs":        jobs,
+		"history":     history,
 	}
 }
@@ -0,0 +1,67 @@
+package cron
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"job-platform/internal/service"
+)
+
+// JobSourceScheduler re-crawls career-page job sources when they are due
+type JobSourceScheduler struct {
+	jobSourceService *service.JobSourceService
+	stopChan         chan struct{}
+	interval         time.Duration
+}
+
+// NewJobSourceScheduler creates a new job source scheduler.
+// The interval controls how often due sources are checked, not how often each source is crawled.
+func NewJobSourceScheduler(jobSourceService *service.JobSourceService, interval time.Duration) *JobSourceScheduler {
+	if interval == 0 {
+		interval = 15 * time.Minute // Default check interval
+	}
+	return &JobSourceScheduler{
+		jobSourceService: jobSourceService,
+		stopChan:         make(chan struct{}),
+		interval:         interval,
+	}
+}
+
+// Start begins the job source scheduler
+func (s *JobSourceScheduler) Start() {
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		log.Printf("✅ Job source scheduler started (interval: %v)", s.interval)
+
+		for {
+			select {
+			case <-ticker.C:
+				s.syncDueSources()
+			case <-s.stopChan:
+				log.Println("🛑 Job source scheduler stopped")
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the job source scheduler
+func (s *JobSourceScheduler) Stop() {
+	close(s.stopChan)
+}
+
+// syncDueSources crawls all sources whose schedule is due
+func (s *JobSourceScheduler) syncDueSources() {
+	count, err := s.jobSourceService.SyncDueSources(context.Background())
+	if err != nil {
+		log.Printf("Error syncing job sources: %v", err)
+		return
+	}
+
+	if count > 0 {
+		log.Printf("📊 Crawled %d job sources", count)
+	}
+}
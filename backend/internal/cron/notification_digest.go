@@ -0,0 +1,67 @@
+package cron
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"job-platform/internal/service"
+)
+
+// NotificationDigestScheduler sends the daily digest emails of batched notifications
+type NotificationDigestScheduler struct {
+	notificationService *service.NotificationService
+	stopChan            chan struct{}
+	interval            time.Duration
+}
+
+// NewNotificationDigestScheduler creates a new notification digest scheduler.
+// Each user receives at most one digest per service.NotificationDigestPeriod; the interval is
+// how often due digests are looked for.
+func NewNotificationDigestScheduler(notificationService *service.NotificationService, interval time.Duration) *NotificationDigestScheduler {
+	if interval == 0 {
+		interval = 1 * time.Hour // Default check interval
+	}
+	return &NotificationDigestScheduler{
+		notificationService: notificationService,
+		stopChan:            make(chan struct{}),
+		interval:            interval,
+	}
+}
+
+// Start begins the notification digest scheduler
+func (s *NotificationDigestScheduler) Start() {
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		log.Printf("✅ Notification digest scheduler started (interval: %v)", s.interval)
+
+		for {
+			select {
+			case <-ticker.C:
+				s.sendDigests()
+			case <-s.stopChan:
+				log.Println("🛑 Notification digest scheduler stopped")
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the notification digest scheduler
+func (s *NotificationDigestScheduler) Stop() {
+	close(s.stopChan)
+}
+
+// sendDigests sends the notification digests that are due
+func (s *NotificationDigestScheduler) sendDigests() {
+	sent, err := s.notificationService.SendEmailDigests(context.Background())
+	if err != nil {
+		log.Printf("Error sending notification digests: %v", err)
+		return
+	}
+	if sent > 0 {
+		log.Printf("📧 Sent %d notification digests", sent)
+	}
+}
@@ -0,0 +1,84 @@
+package cron
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"job-platform/internal/service"
+)
+
+// WebhookDeliveryScheduler retries failed webhook deliveries when their backoff has passed and
+// prunes the delivery log
+type WebhookDeliveryScheduler struct {
+	webhookService *service.WebhookService
+	stopChan       chan struct{}
+	interval       time.Duration
+}
+
+// NewWebhookDeliveryScheduler creates a new webhook delivery scheduler.
+// New deliveries are attempted right away; the interval is how often due retries are looked for.
+func NewWebhookDeliveryScheduler(webhookService *service.WebhookService, interval time.Duration) *WebhookDeliveryScheduler {
+	if interval == 0 {
+		interval = 30 * time.Second // Default check interval
+	}
+	return &WebhookDeliveryScheduler{
+		webhookService: webhookService,
+		stopChan:       make(chan struct{}),
+		interval:       interval,
+	}
+}
+
+// Start begins the webhook delivery scheduler
+func (s *WebhookDeliveryScheduler) Start() {
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		cleanupTicker := time.NewTicker(24 * time.Hour)
+		defer cleanupTicker.Stop()
+
+		log.Printf("✅ Webhook delivery scheduler started (interval: %v)", s.interval)
+
+		for {
+			select {
+			case <-ticker.C:
+				s.retryDeliveries()
+			case <-cleanupTicker.C:
+				s.cleanupDeliveries()
+			case <-s.stopChan:
+				log.Println("🛑 Webhook delivery scheduler stopped")
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the webhook delivery scheduler
+func (s *WebhookDeliveryScheduler) Stop() {
+	close(s.stopChan)
+}
+
+// retryDeliveries attempts the webhook deliveries whose retry is due
+func (s *WebhookDeliveryScheduler) retryDeliveries() {
+	attempted, err := s.webhookService.ProcessDueDeliveries(context.Background())
+	if err != nil {
+		log.Printf("Error retrying webhook deliveries: %v", err)
+		return
+	}
+	if attempted > 0 {
+		log.Printf("🔁 Retried %d webhook deliveries", attempted)
+	}
+}
+
+// cleanupDeliveries removes finished deliveries past their retention
+func (s *WebhookDeliveryScheduler) cleanupDeliveries() {
+	deleted, err := s.webhookService.CleanupDeliveries()
+	if err != nil {
+		log.Printf("Error cleaning up webhook deliveries: %v", err)
+		return
+	}
+	if deleted > 0 {
+		log.Printf("🧹 Removed %d old webhook deliveries", deleted)
+	}
+}
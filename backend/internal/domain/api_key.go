@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// APIKeyScope represents what an API key may access
+type APIKeyScope string
+
+const (
+	APIKeyScopeJobsRead          APIKeyScope = "jobs:read"
+	APIKeyScopeJobsWrite         APIKeyScope = "jobs:write"
+	APIKeyScopeApplicationsRead  APIKeyScope = "applications:read"
+	APIKeyScopeApplicationsWrite APIKeyScope = "applications:write"
+)
+
+// APIKeyScopes lists the scopes an API key can be granted
+var APIKeyScopes = []APIKeyScope{
+	APIKeyScopeJobsRead,
+	APIKeyScopeJobsWrite,
+	APIKeyScopeApplicationsRead,
+	APIKeyScopeApplicationsWrite,
+}
+
+// IsValid checks if the scope is supported
+func (s APIKeyScope) IsValid() bool {
+	for _, scope := range APIKeyScopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// APIKey is a company credential for the employer API. Only the hash of the key is stored.
+type APIKey struct {
+	ID         uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	CompanyID  uuid.UUID      `gorm:"type:uuid;not null;index" json:"company_id"`
+	CreatedBy  uuid.UUID      `gorm:"type:uuid;not null" json:"created_by"` // Requests made with the key act as this user
+	Name       string         `gorm:"size:100;not null" json:"name"`
+	Prefix     string         `gorm:"size:20;not null" json:"prefix"` // Start of the key, to recognize it
+	KeyHash    string         `gorm:"size:64;uniqueIndex;not null" json:"-"`
+	Scopes     pq.StringArray `gorm:"type:text[];not null" json:"scopes"`
+	ExpiresAt  *time.Time     `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time     `json:"last_used_at,omitempty"`
+	LastUsedIP string         `gorm:"column:last_used_ip;size:45" json:"last_used_ip,omitempty"`
+	RevokedAt  *time.Time     `json:"revoked_at,omitempty"`
+	RevokedBy  *uuid.UUID     `gorm:"type:uuid" json:"revoked_by,omitempty"`
+	CreatedAt  time.Time      `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt  time.Time      `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+
+	// Relationships
+	Creator *User `gorm:"foreignKey:CreatedBy" json:"creator,omitempty"`
+}
+
+// TableName specifies the table name for APIKey
+func (APIKey) TableName() string {
+	return "api_keys"
+}
+
+// HasScope checks if the key was granted a scope
+func (k *APIKey) HasScope(scope APIKeyScope) bool {
+	for _, s := range k.Scopes {
+		if APIKeyScope(s) == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// IsRevoked returns true if the key was revoked
+func (k *APIKey) IsRevoked() bool {
+	return k.RevokedAt != nil
+}
+
+// IsExpired returns true if the key's expiry has passed
+func (k *APIKey) IsExpired() bool {
+	return k.ExpiresAt != nil && time.Now().After(*k.ExpiresAt)
+}
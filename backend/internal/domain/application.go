@@ -40,6 +40,13 @@ type Application struct {
 	StatusUpdatedBy *uuid.UUID `gorm:"type:uuid"`
 	RejectionReason string     `gorm:"type:text"`
 
+	// Custom pipeline stage (nil when the company uses the default pipeline)
+	StageID *uuid.UUID `gorm:"type:uuid;index"`
+
+	// Screening (set when a FLAG knockout answer was given)
+	IsFlagged  bool   `gorm:"default:false"`
+	FlagReason string `gorm:"type:text"`
+
 	// Notes (employer internal)
 	EmployerNotes string `gorm:"type:text"`
 	Rating        *int   `gorm:"check:rating >= 1 AND rating <= 5"`
@@ -55,6 +62,7 @@ type Application struct {
 	Applicant     User                       `gorm:"foreignKey:ApplicantID"`
 	StatusUpdater *User                      `gorm:"foreignKey:StatusUpdatedBy"`
 	StatusHistory []ApplicationStatusHistory `gorm:"foreignKey:ApplicationID"`
+	Stage         *PipelineStage             `gorm:"foreignKey:StageID"`
 }
 
 // TableName specifies the table name for Application
@@ -82,6 +90,9 @@ type ApplicationStatusHistory struct {
 	ApplicationID uuid.UUID          `gorm:"type:uuid;not null;index"`
 	FromStatus    *ApplicationStatus `gorm:"type:varchar(20)"`
 	ToStatus      ApplicationStatus  `gorm:"type:varchar(20);not null"`
+	FromStage     string             `gorm:"size:100"`
+	ToStage       string             `gorm:"size:100"`
+	ToStageID     *uuid.UUID         `gorm:"type:uuid"`
 	ChangedBy     *uuid.UUID         `gorm:"type:uuid"`
 	Notes         string             `gorm:"type:text"`
 	CreatedAt     time.Time
@@ -113,6 +113,9 @@ type Company struct {
 	VerificationDocuments  pq.StringArray `gorm:"type:text[]"`
 	RejectionReason        *string        `gorm:"type:text"`
 
+	// Job postings by recruiters need approval from an ADMIN or OWNER
+	RequireJobApproval bool `gorm:"default:false"`
+
 	// Featuring
 	IsFeatured   bool       `gorm:"default:false"`
 	FeaturedUntil *time.Time
@@ -231,6 +234,11 @@ func (m *CompanyTeamMember) CanPostJobs() bool {
 	return m.Role == TeamRoleOwner || m.Role == TeamRoleAdmin || m.Role == TeamRoleRecruiter
 }
 
+// CanApproveJobs checks if member can approve job postings of other members
+func (m *CompanyTeamMember) CanApproveJobs() bool {
+	return m.Role == TeamRoleOwner || m.Role == TeamRoleAdmin
+}
+
 // CanEditCompany checks if member can edit company profile
 func (m *CompanyTeamMember) CanEditCompany() bool {
 	return m.Role == TeamRoleOwner || m.Role == TeamRoleAdmin
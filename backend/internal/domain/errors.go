@@ -48,6 +48,11 @@ var (
 	ErrJobAlreadyExpired     = errors.New("JOB_008: Job has already expired")
 	ErrCannotRenewActiveJob  = errors.New("JOB_009: Cannot renew active job")
 	ErrInvalidSlug           = errors.New("JOB_010: Invalid job slug")
+	ErrInvalidOpenings       = errors.New("JOB_011: Openings must be between 1 and 1000")
+	ErrInvalidHiringManager  = errors.New("JOB_012: Hiring manager must be an active member of the company team")
+	ErrNotJobReviewer        = errors.New("JOB_013: Only a company admin or owner can review this job")
+	ErrJobNotPublished       = errors.New("JOB_014: Job has not been published yet")
+	ErrJobRevisionNotFound   = errors.New("JOB_015: Job revision not found")
 )
 
 // Application errors
@@ -254,6 +259,166 @@ var (
 	ErrNewsletterEmailRequired     = errors.New("NEWSLETTER_004: Email is required")
 )
 
+// Import errors
+var (
+	ErrImportQueueNotFound    = errors.New("IMPORT_001: Import queue not found")
+	ErrExtractionTaskNotFound = errors.New("IMPORT_002: Link extraction task not found")
+)
+
+// Job source errors
+var (
+	ErrJobSourceNotFound         = errors.New("JOB_SOURCE_001: Job source not found")
+	ErrInvalidJobSourceURL       = errors.New("JOB_SOURCE_002: Job source URL must be a valid http(s) URL")
+	ErrInvalidExtractionStrategy = errors.New("JOB_SOURCE_003: Invalid extraction strategy")
+	ErrInvalidCrawlInterval      = errors.New("JOB_SOURCE_004: Crawl interval must be between 1 and 720 hours")
+)
+
+// Screening question errors
+var (
+	ErrInvalidScreeningQuestion  = errors.New("SCREENING_001: Invalid screening question")
+	ErrScreeningAnswerRequired   = errors.New("SCREENING_002: Please answer all required screening questions")
+	ErrInvalidScreeningAnswer    = errors.New("SCREENING_003: Invalid answer to a screening question")
+	ErrTooManyScreeningQuestions = errors.New("SCREENING_004: Too many screening questions")
+)
+
+// Pipeline errors
+var (
+	ErrPipelineStageNotFound     = errors.New("PIPELINE_001: Pipeline stage not found")
+	ErrInvalidPipeline           = errors.New("PIPELINE_002: Pipeline stages must have unique names, start with a SUBMITTED stage and follow the hiring order")
+	ErrInvalidPipelineStatus     = errors.New("PIPELINE_003: Stage status must be one of SUBMITTED, REVIEWED, SHORTLISTED, INTERVIEW, OFFERED, HIRED")
+	ErrApplicationAlreadyInStage = errors.New("PIPELINE_004: Application is already in this stage")
+	ErrApplicationClosed         = errors.New("PIPELINE_005: Application is hired, rejected or withdrawn and cannot be moved")
+	ErrTooManyApplications       = errors.New("PIPELINE_006: Too many applications in one request")
+	ErrPipelineMissingStages     = errors.New("PIPELINE_007: Pipeline must have an OFFERED and a HIRED stage")
+	ErrHireRequiresOffer         = errors.New("PIPELINE_008: Applications can only be hired from an offer stage")
+)
+
+// Interview errors
+var (
+	ErrInterviewNotFound      = errors.New("INTERVIEW_001: Interview not found")
+	ErrInvalidInterviewSlots  = errors.New("INTERVIEW_002: Interview slots must be in the future, match the interview duration and not overlap")
+	ErrInterviewSlotNotFound  = errors.New("INTERVIEW_003: Interview slot not found")
+	ErrInterviewNotOpen       = errors.New("INTERVIEW_004: Interview has been cancelled or completed")
+	ErrInterviewAlreadyBooked = errors.New("INTERVIEW_005: A slot has already been selected for this interview")
+	ErrInvalidInterviewer     = errors.New("INTERVIEW_006: Interviewers must be active members of the company")
+	ErrInvalidTimezone        = errors.New("INTERVIEW_007: Invalid time zone")
+	ErrInterviewNotScheduled  = errors.New("INTERVIEW_008: Interview has not been scheduled yet")
+)
+
+// Scorecard errors
+var (
+	ErrInvalidScorecardCriteria  = errors.New("SCORECARD_001: Scorecard criteria must have unique, non-empty names")
+	ErrInvalidScorecardRating    = errors.New("SCORECARD_002: Ratings must be between 1 and 5 and reference the job's criteria")
+	ErrScorecardIncomplete       = errors.New("SCORECARD_003: Rate every criterion and choose a recommendation before submitting")
+	ErrScorecardAlreadySubmitted = errors.New("SCORECARD_004: Scorecard has already been submitted")
+	ErrNotApplicationReviewer    = errors.New("SCORECARD_005: You are not a reviewer for this application")
+	ErrInvalidHireRecommendation = errors.New("SCORECARD_006: Invalid hire recommendation")
+)
+
+// Note errors
+var (
+	ErrNoteNotFound       = errors.New("NOTE_001: Note not found")
+	ErrNoteEmpty          = errors.New("NOTE_002: Note content is required")
+	ErrNoteTooLong        = errors.New("NOTE_003: Note content must be at most 5000 characters")
+	ErrNotNoteAuthor      = errors.New("NOTE_004: Only the author can edit or delete this note")
+	ErrInvalidNoteMention = errors.New("NOTE_005: Mentioned users must be members of the company team")
+)
+
+// Message errors
+var (
+	ErrConversationNotFound   = errors.New("MESSAGE_001: Conversation not found")
+	ErrNotConversationMember  = errors.New("MESSAGE_002: You are not part of this conversation")
+	ErrMessageEmpty           = errors.New("MESSAGE_003: Message must have text or an attachment")
+	ErrMessageTooLong         = errors.New("MESSAGE_004: Message must be at most 5000 characters")
+	ErrTooManyAttachments     = errors.New("MESSAGE_005: A message can have at most 5 attachments")
+	ErrInvalidAttachment      = errors.New("MESSAGE_006: Attachment type or size is not allowed")
+	ErrAttachmentUploadFailed = errors.New("MESSAGE_007: Failed to upload attachment")
+	ErrAttachmentNotFound     = errors.New("MESSAGE_008: Attachment not found")
+)
+
+// Offer errors
+var (
+	ErrOfferNotFound        = errors.New("OFFER_001: Offer not found")
+	ErrOfferAlreadyOpen     = errors.New("OFFER_002: Application already has an offer in progress")
+	ErrOfferNotDraft        = errors.New("OFFER_003: Only draft offers can be changed")
+	ErrOfferNotSent         = errors.New("OFFER_004: Offer is not waiting for an answer")
+	ErrOfferExpired         = errors.New("OFFER_005: Offer has expired")
+	ErrInvalidOfferSalary   = errors.New("OFFER_006: Offer salary must be greater than zero")
+	ErrInvalidOfferDates    = errors.New("OFFER_007: Offer must expire in the future and before the start date")
+	ErrInvalidOfferDocument = errors.New("OFFER_008: Offer letter must be a PDF of at most 10MB")
+	ErrOfferUploadFailed    = errors.New("OFFER_009: Failed to upload offer letter")
+	ErrOfferJobFilled       = errors.New("OFFER_010: The job is no longer hiring or all its openings are filled")
+)
+
+// Duplicate job errors
+var (
+	ErrJobDuplicateNotFound = errors.New("DUPLICATE_001: Duplicate flag not found")
+	ErrJobDuplicateResolved = errors.New("DUPLICATE_002: Duplicate flag has already been resolved")
+	ErrInvalidMergeTarget   = errors.New("DUPLICATE_003: The job to keep must be one of the flagged jobs")
+	ErrMergeAcrossEmployers = errors.New("DUPLICATE_004: Jobs of different employers or companies can't be merged")
+)
+
+// Feed errors
+var (
+	ErrInvalidFeedFormat = errors.New("FEED_001: Unsupported feed format, use indeed, linkedin, rss or atom")
+)
+
+// Sitemap errors
+var (
+	ErrSitemapNotFound = errors.New("SITEMAP_001: Sitemap not found")
+)
+
+// Saved search errors
+var (
+	ErrSavedSearchNotFound         = errors.New("SAVED_SEARCH_001: Saved search not found")
+	ErrSavedSearchLimitReached     = errors.New("SAVED_SEARCH_002: Maximum number of saved searches reached")
+	ErrInvalidSavedSearchFrequency = errors.New("SAVED_SEARCH_003: Frequency must be INSTANT, DAILY or WEEKLY")
+	ErrSavedSearchFiltersRequired  = errors.New("SAVED_SEARCH_004: A saved search needs at least one filter")
+	ErrInvalidUnsubscribeToken     = errors.New("SAVED_SEARCH_005: Invalid or expired unsubscribe link")
+)
+
+// Web push errors
+var (
+	ErrPushNotConfigured        = errors.New("PUSH_001: Push notifications are not configured")
+	ErrPushSubscriptionNotFound = errors.New("PUSH_002: Push subscription not found")
+	ErrInvalidPushSubscription  = errors.New("PUSH_003: Invalid push subscription")
+)
+
+// Webhook errors
+var (
+	ErrWebhookNotFound         = errors.New("WEBHOOK_001: Webhook not found")
+	ErrWebhookLimitReached     = errors.New("WEBHOOK_002: Maximum number of webhooks reached")
+	ErrInvalidWebhookURL       = errors.New("WEBHOOK_003: Webhook URL must be a public https URL")
+	ErrInvalidWebhookEvents    = errors.New("WEBHOOK_004: Invalid webhook events")
+	ErrWebhookDeliveryNotFound = errors.New("WEBHOOK_005: Webhook delivery not found")
+)
+
+// API key errors
+var (
+	ErrAPIKeyNotFound      = errors.New("APIKEY_001: API key not found")
+	ErrInvalidAPIKey       = errors.New("APIKEY_002: Invalid, expired or revoked API key")
+	ErrInvalidAPIKeyScopes = errors.New("APIKEY_003: Invalid API key scopes")
+	ErrAPIKeyLimitReached  = errors.New("APIKEY_004: Maximum number of API keys reached")
+	ErrAPIKeyScopeRequired = errors.New("APIKEY_005: API key is missing the scope required for this endpoint")
+	ErrAPIKeyNotAllowed    = errors.New("APIKEY_006: This endpoint can't be used with an API key")
+	ErrInvalidAPIKeyExpiry = errors.New("APIKEY_007: API key expiry must be in the future")
+)
+
+// Job upload errors
+var (
+	ErrJobUploadNotFound       = errors.New("JOBUPLOAD_001: Job upload not found")
+	ErrInvalidJobUploadFile    = errors.New("JOBUPLOAD_002: Upload a CSV or XLSX file with a header row and at least one job")
+	ErrJobUploadTooLarge       = errors.New("JOBUPLOAD_003: Job upload file is too large")
+	ErrJobUploadTooManyRows    = errors.New("JOBUPLOAD_004: Job upload has too many rows")
+	ErrJobUploadNotValidated   = errors.New("JOBUPLOAD_005: Job upload was already confirmed")
+	ErrJobUploadHasNoValidRows = errors.New("JOBUPLOAD_006: Job upload has no valid rows to create")
+)
+
+// Export errors
+var (
+	ErrInvalidExportFormat = errors.New("EXPORT_001: Invalid export format, use csv or xlsx")
+)
+
 // ErrorCode represents an error with a code
 type ErrorCode struct {
 	Code    string
@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// ImportStatus represents the status of an import queue, import job or extraction task
+type ImportStatus string
+
+const (
+	ImportStatusPending    ImportStatus = "pending"
+	ImportStatusProcessing ImportStatus = "processing"
+	ImportStatusCompleted  ImportStatus = "completed"
+	ImportStatusFailed     ImportStatus = "failed"
+	ImportStatusCancelled  ImportStatus = "cancelled"
+)
+
+// IsFinished checks if the status is terminal
+func (s ImportStatus) IsFinished() bool {
+	return s == ImportStatusCompleted || s == ImportStatusFailed || s == ImportStatusCancelled
+}
+
+// ImportQueue represents a batch of job URLs imported by an admin
+type ImportQueue struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	AdminID   uuid.UUID `gorm:"type:uuid;not null;index" json:"-"` // Admin who created the queue (not exposed in JSON)
+	SourceURL string    `gorm:"type:text" json:"source_url"`
+
+	// Set when the queue was created by a scheduled job source crawl
+	JobSourceID *uuid.UUID `gorm:"type:uuid;index" json:"job_source_id,omitempty"`
+	CompanyID   *uuid.UUID `gorm:"type:uuid" json:"company_id,omitempty"`
+
+	Status    ImportStatus `gorm:"type:varchar(20);not null;default:'pending'" json:"status"`
+	TotalJobs int          `gorm:"not null;default:0" json:"total_jobs"`
+	Completed int          `gorm:"not null;default:0" json:"completed"`
+	Failed    int          `gorm:"not null;default:0" json:"failed"`
+	Cancelled int          `gorm:"not null;default:0" json:"cancelled"`
+	CreatedAt time.Time    `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt time.Time    `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+
+	// Relationships
+	Jobs []ImportJob `gorm:"foreignKey:QueueID" json:"jobs"`
+}
+
+// TableName specifies the table name for ImportQueue
+func (ImportQueue) TableName() string {
+	return "import_queues"
+}
+
+// IsActive checks if the queue still has work to do
+func (q *ImportQueue) IsActive() bool {
+	return q.Status == ImportStatusPending || q.Status == ImportStatusProcessing
+}
+
+// ImportJob represents a single job URL within an import queue
+type ImportJob struct {
+	ID        uuid.UUID    `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	QueueID   uuid.UUID    `gorm:"type:uuid;not null;index" json:"queue_id"`
+	Position  int          `gorm:"not null;default:0" json:"position"`
+	URL       string       `gorm:"type:text;not null" json:"url"`
+	Title     string       `gorm:"type:varchar(500)" json:"title"`
+	Status    ImportStatus `gorm:"type:varchar(20);not null;default:'pending'" json:"status"`
+	Error     string       `gorm:"type:text" json:"error,omitempty"`
+	JobID     *uuid.UUID   `gorm:"type:uuid" json:"job_id,omitempty"` // Job created from this URL
+	ClaimedAt *time.Time   `json:"claimed_at,omitempty"`              // When a worker claimed the job, see ClaimNextJob
+	CreatedAt time.Time    `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt time.Time    `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+
+	// Set instead of JobID when the URL was skipped because the job already exists
+	DuplicateOfID *uuid.UUID `gorm:"type:uuid" json:"duplicate_of_id,omitempty"`
+}
+
+// TableName specifies the table name for ImportJob
+func (ImportJob) TableName() string {
+	return "import_jobs"
+}
+
+// LinkExtractionTask represents a background job link extraction from a career page
+type LinkExtractionTask struct {
+	ID        uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	SourceURL string         `gorm:"type:text;not null" json:"source_url"`
+	Status    ImportStatus   `gorm:"type:varchar(20);not null;default:'pending'" json:"status"`
+	Links     datatypes.JSON `gorm:"type:jsonb;not null;default:'[]'" json:"links,omitempty"`
+	Total     int            `gorm:"not null;default:0" json:"total"`
+	Error     string         `gorm:"type:text" json:"error,omitempty"`
+	ClaimedAt *time.Time     `json:"-"` // Lease of the worker extracting the links
+	CreatedAt time.Time      `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt time.Time      `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+// TableName specifies the table name for LinkExtractionTask
+func (LinkExtractionTask) TableName() string {
+	return "link_extraction_tasks"
+}
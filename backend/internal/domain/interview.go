@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// InterviewType represents how an interview is held
+type InterviewType string
+
+const (
+	InterviewTypePhone  InterviewType = "PHONE"
+	InterviewTypeVideo  InterviewType = "VIDEO"
+	InterviewTypeOnsite InterviewType = "ONSITE"
+)
+
+// InterviewStatus represents the scheduling state of an interview
+type InterviewStatus string
+
+const (
+	InterviewStatusProposed  InterviewStatus = "PROPOSED"  // Waiting for the candidate to pick a slot
+	InterviewStatusScheduled InterviewStatus = "SCHEDULED" // A slot was confirmed
+	InterviewStatusCancelled InterviewStatus = "CANCELLED"
+	InterviewStatusCompleted InterviewStatus = "COMPLETED"
+)
+
+// Interview represents an interview of a candidate for an application
+type Interview struct {
+	ID              uuid.UUID       `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	ApplicationID   uuid.UUID       `gorm:"type:uuid;not null;index" json:"application_id"`
+	CreatedBy       uuid.UUID       `gorm:"type:uuid;not null" json:"created_by"`
+	Title           string          `gorm:"size:255;not null" json:"title"`
+	Type            InterviewType   `gorm:"column:interview_type;type:varchar(20);not null" json:"type"`
+	DurationMinutes int             `gorm:"not null" json:"duration_minutes"`
+	Location        string          `gorm:"type:text" json:"location,omitempty"`
+	MeetingURL      string          `gorm:"type:text" json:"meeting_url,omitempty"`
+	Notes           string          `gorm:"type:text" json:"notes,omitempty"` // Shared with the candidate and interviewers
+	Timezone        string          `gorm:"size:64;not null;default:'UTC'" json:"timezone"`
+	Status          InterviewStatus `gorm:"type:varchar(20);not null;default:'PROPOSED';index" json:"status"`
+
+	// Confirmed time (set once a slot is selected)
+	SelectedSlotID *uuid.UUID `gorm:"type:uuid" json:"selected_slot_id,omitempty"`
+	ScheduledStart *time.Time `json:"scheduled_start,omitempty"`
+	ScheduledEnd   *time.Time `json:"scheduled_end,omitempty"`
+
+	// Sequence is the iCalendar SEQUENCE, incremented on every reschedule and cancellation
+	Sequence int `gorm:"not null;default:0" json:"sequence"`
+
+	CancelReason string     `gorm:"type:text" json:"cancel_reason,omitempty"`
+	CancelledAt  *time.Time `json:"cancelled_at,omitempty"`
+	CreatedAt    time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt    time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+
+	// Relationships
+	Application  *Application           `gorm:"foreignKey:ApplicationID" json:"-"`
+	Slots        []InterviewSlot        `gorm:"foreignKey:InterviewID" json:"slots"`
+	Interviewers []InterviewInterviewer `gorm:"foreignKey:InterviewID" json:"interviewers"`
+}
+
+// TableName specifies the table name for Interview
+func (Interview) TableName() string {
+	return "interviews"
+}
+
+// IsOpen checks if the interview is still proposed or scheduled
+func (i *Interview) IsOpen() bool {
+	return i.Status == InterviewStatusProposed || i.Status == InterviewStatusScheduled
+}
+
+// IsScheduled checks if the interview has a confirmed time
+func (i *Interview) IsScheduled() bool {
+	return i.Status == InterviewStatusScheduled && i.ScheduledStart != nil && i.ScheduledEnd != nil
+}
+
+// SlotByID returns the proposed slot with the given ID
+func (i *Interview) SlotByID(id uuid.UUID) *InterviewSlot {
+	for idx := range i.Slots {
+		if i.Slots[idx].ID == id {
+			return &i.Slots[idx]
+		}
+	}
+	return nil
+}
+
+// TimeLocation returns the interview's time zone, falling back to UTC
+func (i *Interview) TimeLocation() *time.Location {
+	if loc, err := time.LoadLocation(i.Timezone); err == nil {
+		return loc
+	}
+	return time.UTC
+}
+
+// InterviewSlot represents a time slot proposed to the candidate
+type InterviewSlot struct {
+	ID          uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	InterviewID uuid.UUID `gorm:"type:uuid;not null;index" json:"interview_id"`
+	StartTime   time.Time `gorm:"not null" json:"start_time"`
+	EndTime     time.Time `gorm:"not null" json:"end_time"`
+	CreatedAt   time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+}
+
+// TableName specifies the table name for InterviewSlot
+func (InterviewSlot) TableName() string {
+	return "interview_slots"
+}
+
+// InterviewInterviewer links a company team member to an interview
+type InterviewInterviewer struct {
+	ID           uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	InterviewID  uuid.UUID `gorm:"type:uuid;not null;index" json:"interview_id"`
+	TeamMemberID uuid.UUID `gorm:"type:uuid;not null" json:"team_member_id"`
+	UserID       uuid.UUID `gorm:"type:uuid;not null;index" json:"user_id"`
+	CreatedAt    time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+
+	// Relationships
+	User *User `gorm:"foreignKey:UserID" json:"-"`
+}
+
+// TableName specifies the table name for InterviewInterviewer
+func (InterviewInterviewer) TableName() string {
+	return "interview_interviewers"
+}
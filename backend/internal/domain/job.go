@@ -39,6 +39,8 @@ const (
 	JobStatusExpired         JobStatus = "EXPIRED"
 	JobStatusClosed          JobStatus = "CLOSED"
 	JobStatusRejected        JobStatus = "REJECTED"
+	JobStatusScheduled       JobStatus = "SCHEDULED"      // Approved and waiting for its PublishedAt
+	JobStatusPendingReview   JobStatus = "PENDING_REVIEW" // Waiting for a company ADMIN or OWNER to approve it
 )
 
 // WorkplaceType represents where the work is performed
@@ -52,8 +54,9 @@ const (
 
 // Job represents a job posting
 type Job struct {
-	ID         uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
-	EmployerID uuid.UUID `gorm:"type:uuid;not null;index"`
+	ID         uuid.UUID  `gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	EmployerID uuid.UUID  `gorm:"type:uuid;not null;index"`
+	CompanyID  *uuid.UUID `gorm:"type:uuid;index"`
 
 	// Basic Info
 	Title            string `gorm:"size:255;not null"`
@@ -96,12 +99,23 @@ type Job struct {
 	ApplicationURL   string         `gorm:"type:text"`
 	ApplicationEmail string         `gorm:"size:255"`
 
+	// Hiring (the job closes once this many applicants are hired)
+	Openings        int        `gorm:"not null;default:1"`
+	RequisitionID   string     `gorm:"size:100;index"` // Internal requisition reference, never shown to candidates
+	HiringManagerID *uuid.UUID `gorm:"type:uuid"`      // Company team member responsible for the hire
+	FilledAt        *time.Time // Set when the job is closed because all openings were filled
+
 	// Status & Moderation
 	Status          JobStatus  `gorm:"type:varchar(20);not null;default:ACTIVE;index"`
 	RejectionReason string     `gorm:"type:text"`
 	ModeratedBy     *uuid.UUID `gorm:"type:uuid"`
 	ModeratedAt     *time.Time
 
+	// Company review (recruiter postings in companies that require approval)
+	ReviewedBy *uuid.UUID `gorm:"type:uuid"`
+	ReviewedAt *time.Time
+	ReviewNote string `gorm:"type:text"` // Why the reviewer sent the job back to draft
+
 	// Featuring
 	IsFeatured   bool       `gorm:"default:false"`
 	FeaturedUntil *time.Time
@@ -111,16 +125,18 @@ type Job struct {
 	ApplicationsCount int `gorm:"default:0"`
 
 	// Scraping
-	OriginalURL  *string `gorm:"size:1000"`
-	ScrapedData  *string `gorm:"type:jsonb"`
-	ScrapeStatus string  `gorm:"size:20;default:manual"`
+	OriginalURL  *string    `gorm:"size:1000"`
+	ScrapedData  *string    `gorm:"type:jsonb"`
+	ScrapeStatus string     `gorm:"size:20;default:manual"`
+	JobSourceID  *uuid.UUID `gorm:"type:uuid"` // Job source whose crawl imported the job
 
 	// Dates
-	PublishedAt *time.Time
-	ExpiresAt   *time.Time
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
-	DeletedAt   *time.Time `gorm:"index"`
+	PublishedAt         *time.Time
+	ExpiresAt           *time.Time
+	ExpiryWarningSentAt *time.Time
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+	DeletedAt           *time.Time `gorm:"index"`
 
 	// Relationships
 	Employer     User                    `gorm:"foreignKey:EmployerID"`
@@ -129,6 +145,9 @@ type Job struct {
 	Applications []Application           `gorm:"foreignKey:JobID"`
 	SavedJobs    []SavedJob              `gorm:"foreignKey:JobID"`
 	Views        []JobView               `gorm:"foreignKey:JobID"`
+
+	ScreeningQuestions []ScreeningQuestion `gorm:"foreignKey:JobID"`
+	HiringManager      *CompanyTeamMember  `gorm:"foreignKey:HiringManagerID"`
 }
 
 // TableName specifies the table name for Job
@@ -151,6 +170,20 @@ func (j *Job) CanAcceptApplications() bool {
 	return j.Status == JobStatusActive
 }
 
+// IsUnpublished returns true if the job has not gone live yet
+func (j *Job) IsUnpublished() bool {
+	switch j.Status {
+	case JobStatusDraft, JobStatusPendingReview, JobStatusPendingApproval, JobStatusScheduled:
+		return true
+	}
+	return false
+}
+
+// IsFilled returns true if the job was closed because all openings were filled
+func (j *Job) IsFilled() bool {
+	return j.FilledAt != nil
+}
+
 // BeforeCreate sets default values before creating
 func (j *Job) BeforeCreate() error {
 	if j.Status == "" {
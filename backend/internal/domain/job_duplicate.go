@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JobFingerprint holds the normalised content of a job used to detect duplicates
+type JobFingerprint struct {
+	JobID           uuid.UUID `gorm:"type:uuid;primary_key" json:"job_id"`
+	TitleKey        string    `gorm:"size:255;not null" json:"title_key"`
+	CompanyKey      string    `gorm:"size:255;not null;index" json:"company_key"`
+	LocationKey     string    `gorm:"size:255;not null;default:''" json:"location_key"`
+	DescriptionHash int64     `gorm:"not null" json:"description_hash"` // 64-bit simhash of description shingles
+	UpdatedAt       time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+// TableName specifies the table name for JobFingerprint
+func (JobFingerprint) TableName() string {
+	return "job_fingerprints"
+}
+
+// JobDuplicateStatus represents the review state of a duplicate flag
+type JobDuplicateStatus string
+
+const (
+	JobDuplicateStatusPending   JobDuplicateStatus = "PENDING"
+	JobDuplicateStatusDismissed JobDuplicateStatus = "DISMISSED" // Reviewed, the jobs are different
+	JobDuplicateStatusMerged    JobDuplicateStatus = "MERGED"
+)
+
+// JobDuplicate flags a job as a likely duplicate of an older job
+type JobDuplicate struct {
+	ID            uuid.UUID          `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	JobID         uuid.UUID          `gorm:"type:uuid;not null;index" json:"job_id"`
+	DuplicateOfID uuid.UUID          `gorm:"type:uuid;not null;index" json:"duplicate_of_id"`
+	Score         float64            `gorm:"type:real;not null" json:"score"` // 0-1, 1 means the same posting
+	Status        JobDuplicateStatus `gorm:"type:varchar(20);not null;default:'PENDING';index" json:"status"`
+	ResolvedBy    *uuid.UUID         `gorm:"type:uuid" json:"resolved_by,omitempty"`
+	ResolvedAt    *time.Time         `json:"resolved_at,omitempty"`
+	CreatedAt     time.Time          `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt     time.Time          `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+
+	// Relationships
+	Job         *Job `gorm:"foreignKey:JobID" json:"-"`
+	DuplicateOf *Job `gorm:"foreignKey:DuplicateOfID" json:"-"`
+}
+
+// TableName specifies the table name for JobDuplicate
+func (JobDuplicate) TableName() string {
+	return "job_duplicates"
+}
+
+// MergedJobs returns the kept and the removed job of a merged flag. Relationships must be loaded.
+func (d *JobDuplicate) MergedJobs() (kept *Job, removed *Job) {
+	if d.Job != nil && d.Job.DeletedAt == nil {
+		return d.Job, d.DuplicateOf
+	}
+	return d.DuplicateOf, d.Job
+}
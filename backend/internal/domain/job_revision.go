@@ -0,0 +1,202 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JobRevisionSource describes what produced a job revision
+type JobRevisionSource string
+
+const (
+	JobRevisionSourceOriginal JobRevisionSource = "ORIGINAL" // The job before its first tracked update
+	JobRevisionSourceEmployer JobRevisionSource = "EMPLOYER"
+	JobRevisionSourceAdmin    JobRevisionSource = "ADMIN"
+	JobRevisionSourceRestore  JobRevisionSource = "RESTORE" // A previous revision was restored
+)
+
+// JobRevision is a numbered version of a job posting
+type JobRevision struct {
+	ID             uuid.UUID         `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	JobID          uuid.UUID         `gorm:"type:uuid;not null;index" json:"job_id"`
+	RevisionNumber int               `gorm:"not null" json:"revision_number"`
+	Snapshot       JobSnapshot       `gorm:"type:jsonb;not null" json:"snapshot"`
+	Source         JobRevisionSource `gorm:"type:varchar(20);not null" json:"source"`
+	ChangedBy      *uuid.UUID        `gorm:"type:uuid" json:"changed_by,omitempty"`
+	RestoredFrom   *int              `json:"restored_from,omitempty"` // Revision number that was restored
+	CreatedAt      time.Time         `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+
+	// Relationships
+	ChangedByUser *User `gorm:"foreignKey:ChangedBy" json:"-"`
+}
+
+// TableName specifies the table name for JobRevision
+func (JobRevision) TableName() string {
+	return "job_revisions"
+}
+
+// JobSnapshot holds the editable content of a job at one revision
+type JobSnapshot struct {
+	Title              string                `json:"title"`
+	Description        string                `json:"description"`
+	ShortDescription   string                `json:"short_description"`
+	CompanyName        string                `json:"company_name"`
+	CompanyLogoURL     string                `json:"company_logo_url"`
+	JobType            JobType               `json:"job_type"`
+	ExperienceLevel    ExperienceLevel       `json:"experience_level"`
+	WorkplaceType      WorkplaceType         `json:"workplace_type"`
+	Location           string                `json:"location"`
+	City               string                `json:"city"`
+	State              string                `json:"state"`
+	Country            string                `json:"country"`
+	Latitude           *float64              `json:"latitude"`
+	Longitude          *float64              `json:"longitude"`
+	SalaryMin          *int                  `json:"salary_min"`
+	SalaryMax          *int                  `json:"salary_max"`
+	SalaryCurrency     string                `json:"salary_currency"`
+	SalaryPeriod       string                `json:"salary_period"`
+	HideSalary         bool                  `json:"hide_salary"`
+	Skills             []string              `json:"skills"`
+	Education          string                `json:"education"`
+	YearsExperienceMin int                   `json:"years_experience_min"`
+	YearsExperienceMax *int                  `json:"years_experience_max"`
+	Benefits           []string              `json:"benefits"`
+	ApplicationURL     string                `json:"application_url"`
+	ApplicationEmail   string                `json:"application_email"`
+	Openings           int                   `json:"openings"`
+	RequisitionID      string                `json:"requisition_id"`
+	HiringManagerID    *uuid.UUID            `json:"hiring_manager_id"`
+	CategoryIDs        []uuid.UUID           `json:"category_ids"`
+	ScreeningQuestions []JobSnapshotQuestion `json:"screening_questions"`
+}
+
+// JobSnapshotQuestion is a screening question as stored in a job snapshot
+type JobSnapshotQuestion struct {
+	Question        string                `json:"question"`
+	Type            ScreeningQuestionType `json:"type"`
+	Options         []string              `json:"options"`
+	MinValue        *float64              `json:"min_value"`
+	MaxValue        *float64              `json:"max_value"`
+	IsRequired      bool                  `json:"is_required"`
+	KnockoutAction  KnockoutAction        `json:"knockout_action"`
+	KnockoutAnswers []string              `json:"knockout_answers"`
+}
+
+// JobFieldChange is a field that differs between two job revisions
+type JobFieldChange struct {
+	Field string      `json:"field"`
+	Old   interface{} `json:"old"`
+	New   interface{} `json:"new"`
+}
+
+// NewJobSnapshot captures the editable content of a job. Categories and screening
+// questions are taken from the loaded associations.
+func NewJobSnapshot(job *Job) JobSnapshot {
+	snapshot := JobSnapshot{
+		Title:              job.Title,
+		Description:        job.Description,
+		ShortDescription:   job.ShortDescription,
+		CompanyName:        job.CompanyName,
+		CompanyLogoURL:     job.CompanyLogoURL,
+		JobType:            job.JobType,
+		ExperienceLevel:    job.ExperienceLevel,
+		WorkplaceType:      job.WorkplaceType,
+		Location:           job.Location,
+		City:               job.City,
+		State:              job.State,
+		Country:            job.Country,
+		Latitude:           job.Latitude,
+		Longitude:          job.Longitude,
+		SalaryMin:          job.SalaryMin,
+		SalaryMax:          job.SalaryMax,
+		SalaryCurrency:     job.SalaryCurrency,
+		SalaryPeriod:       job.SalaryPeriod,
+		HideSalary:         job.HideSalary,
+		Skills:             append([]string{}, job.Skills...),
+		Education:          job.Education,
+		YearsExperienceMin: job.YearsExperienceMin,
+		YearsExperienceMax: job.YearsExperienceMax,
+		Benefits:           append([]string{}, job.Benefits...),
+		ApplicationURL:     job.ApplicationURL,
+		ApplicationEmail:   job.ApplicationEmail,
+		Openings:           job.Openings,
+		RequisitionID:      job.RequisitionID,
+		HiringManagerID:    job.HiringManagerID,
+		CategoryIDs:        make([]uuid.UUID, 0, len(job.Categories)),
+		ScreeningQuestions: make([]JobSnapshotQuestion, 0, len(job.ScreeningQuestions)),
+	}
+
+	for _, category := range job.Categories {
+		snapshot.CategoryIDs = append(snapshot.CategoryIDs, category.ID)
+	}
+	// Category order is not meaningful, keep it stable so it doesn't show up as a change
+	sort.Slice(snapshot.CategoryIDs, func(i, j int) bool {
+		return snapshot.CategoryIDs[i].String() < snapshot.CategoryIDs[j].String()
+	})
+
+	for _, q := range job.ScreeningQuestions {
+		snapshot.ScreeningQuestions = append(snapshot.ScreeningQuestions, JobSnapshotQuestion{
+			Question:        q.Question,
+			Type:            q.Type,
+			Options:         append([]string{}, q.Options...),
+			MinValue:        q.MinValue,
+			MaxValue:        q.MaxValue,
+			IsRequired:      q.IsRequired,
+			KnockoutAction:  q.KnockoutAction,
+			KnockoutAnswers: append([]string{}, q.KnockoutAnswers...),
+		})
+	}
+
+	return snapshot
+}
+
+// Diff returns the fields that changed from prev to s, in snapshot field order
+func (s JobSnapshot) Diff(prev JobSnapshot) []JobFieldChange {
+	changes := []JobFieldChange{}
+
+	newValue := reflect.ValueOf(s)
+	oldValue := reflect.ValueOf(prev)
+	snapshotType := newValue.Type()
+
+	for i := 0; i < snapshotType.NumField(); i++ {
+		oldField := oldValue.Field(i).Interface()
+		newField := newValue.Field(i).Interface()
+		if reflect.DeepEqual(oldField, newField) {
+			continue
+		}
+		changes = append(changes, JobFieldChange{
+			Field: strings.Split(snapshotType.Field(i).Tag.Get("json"), ",")[0],
+			Old:   oldField,
+			New:   newField,
+		})
+	}
+
+	return changes
+}
+
+// Value implements the driver.Valuer interface for JobSnapshot
+func (s JobSnapshot) Value() (driver.Value, error) {
+	return json.Marshal(s)
+}
+
+// Scan implements the sql.Scanner interface for JobSnapshot
+func (s *JobSnapshot) Scan(value interface{}) error {
+	if value == nil {
+		*s = JobSnapshot{}
+		return nil
+	}
+
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New("failed to scan JobSnapshot: value is not []byte")
+	}
+
+	return json.Unmarshal(bytes, s)
+}
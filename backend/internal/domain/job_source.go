@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JobSourceStrategy represents how job links are extracted from a career page
+type JobSourceStrategy string
+
+const (
+	// JobSourceStrategyAuto detects job APIs or falls back to HTML link extraction
+	JobSourceStrategyAuto JobSourceStrategy = "AUTO"
+	// JobSourceStrategyListing follows the listing page and its pagination
+	JobSourceStrategyListing JobSourceStrategy = "LISTING"
+)
+
+// IsValid checks if the strategy is supported
+func (s JobSourceStrategy) IsValid() bool {
+	return s == JobSourceStrategyAuto || s == JobSourceStrategyListing
+}
+
+// JobSourceSyncStatus represents the outcome of the last crawl of a source
+type JobSourceSyncStatus string
+
+const (
+	JobSourceSyncSuccess JobSourceSyncStatus = "SUCCESS"
+	JobSourceSyncFailed  JobSourceSyncStatus = "FAILED"
+)
+
+// JobSource represents a company career page that is re-crawled on a schedule
+type JobSource struct {
+	ID                 uuid.UUID         `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	CompanyID          *uuid.UUID        `gorm:"type:uuid;index" json:"company_id,omitempty"`
+	CreatedBy          uuid.UUID         `gorm:"type:uuid;not null" json:"created_by"`
+	Name               string            `gorm:"size:255;not null" json:"name"`
+	URL                string            `gorm:"type:text;not null" json:"url"`
+	ExtractionStrategy JobSourceStrategy `gorm:"type:varchar(20);not null;default:'AUTO'" json:"extraction_strategy"`
+	CrawlIntervalHours int               `gorm:"not null;default:24" json:"crawl_interval_hours"`
+	CloseMissingJobs   bool              `gorm:"not null;default:true" json:"close_missing_jobs"`
+	IsActive           bool              `gorm:"not null;default:true" json:"is_active"`
+
+	// Last crawl
+	LastCrawledAt  *time.Time           `json:"last_crawled_at,omitempty"`
+	NextCrawlAt    *time.Time           `gorm:"index" json:"next_crawl_at,omitempty"`
+	LastStatus     *JobSourceSyncStatus `gorm:"type:varchar(20)" json:"last_status,omitempty"`
+	LastError      string               `gorm:"type:text" json:"last_error,omitempty"`
+	LastLinksFound int                  `gorm:"not null;default:0" json:"last_links_found"`
+	LastNewJobs    int                  `gorm:"not null;default:0" json:"last_new_jobs"`
+	LastClosedJobs int                  `gorm:"not null;default:0" json:"last_closed_jobs"`
+	LastQueueID    *uuid.UUID           `gorm:"type:uuid" json:"last_queue_id,omitempty"`
+
+	CreatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+// TableName specifies the table name for JobSource
+func (JobSource) TableName() string {
+	return "job_sources"
+}
+
+// CrawlInterval returns the configured crawl interval as a duration
+func (s *JobSource) CrawlInterval() time.Duration {
+	return time.Duration(s.CrawlIntervalHours) * time.Hour
+}
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gorm.io/datatypes"
+)
+
+// JobUploadMode represents who a bulk upload creates jobs as
+type JobUploadMode string
+
+const (
+	JobUploadModeEmployer JobUploadMode = "EMPLOYER" // Jobs are created as the uploading employer
+	JobUploadModeAdmin    JobUploadMode = "ADMIN"    // Jobs are created by an admin with the company from each row
+)
+
+// JobUploadStatus represents the status of a bulk job upload
+type JobUploadStatus string
+
+const (
+	JobUploadStatusValidated  JobUploadStatus = "VALIDATED" // Dry run done, waiting for confirmation
+	JobUploadStatusPending    JobUploadStatus = "PENDING"
+	JobUploadStatusProcessing JobUploadStatus = "PROCESSING"
+	JobUploadStatusCompleted  JobUploadStatus = "COMPLETED"
+)
+
+// JobUploadRowStatus represents the status of a row of a bulk job upload
+type JobUploadRowStatus string
+
+const (
+	JobUploadRowStatusValid   JobUploadRowStatus = "VALID"
+	JobUploadRowStatusInvalid JobUploadRowStatus = "INVALID" // Skipped when the upload is confirmed
+	JobUploadRowStatusCreated JobUploadRowStatus = "CREATED"
+	JobUploadRowStatusFailed  JobUploadRowStatus = "FAILED" // Valid, but creating the job failed
+)
+
+// JobUpload is a CSV or XLSX file of jobs uploaded to be created in bulk
+type JobUpload struct {
+	ID             uuid.UUID       `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	UploadedBy     uuid.UUID       `gorm:"type:uuid;not null;index" json:"uploaded_by"`
+	Mode           JobUploadMode   `gorm:"type:varchar(20);not null" json:"mode"`
+	Filename       string          `gorm:"size:255;not null" json:"filename"`
+	Status         JobUploadStatus `gorm:"type:varchar(20);not null;default:'VALIDATED'" json:"status"`
+	TotalRows      int             `gorm:"not null;default:0" json:"total_rows"`
+	ValidRows      int             `gorm:"not null;default:0" json:"valid_rows"`
+	CreatedJobs    int             `gorm:"not null;default:0" json:"created_jobs"`
+	FailedRows     int             `gorm:"not null;default:0" json:"failed_rows"`
+	IgnoredColumns pq.StringArray  `gorm:"type:text[]" json:"ignored_columns"` // Header columns that don't map to a job field
+	ConfirmedAt    *time.Time      `json:"confirmed_at,omitempty"`
+	ClaimedAt      *time.Time      `json:"-"` // Lease of the worker processing the upload
+	CompletedAt    *time.Time      `json:"completed_at,omitempty"`
+	CreatedAt      time.Time       `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt      time.Time       `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+
+	// Relationships
+	Rows []JobUploadRow `gorm:"foreignKey:UploadID" json:"rows,omitempty"`
+}
+
+// TableName specifies the table name for JobUpload
+func (JobUpload) TableName() string {
+	return "job_uploads"
+}
+
+// JobUploadRow is a row of a bulk job upload with its validation result
+type JobUploadRow struct {
+	ID        uuid.UUID          `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	UploadID  uuid.UUID          `gorm:"type:uuid;not null;index" json:"upload_id"`
+	RowNumber int                `gorm:"not null" json:"row_number"` // Row in the file, counting the header as row 1
+	Title     string             `gorm:"size:255" json:"title"`
+	Data      datatypes.JSON     `gorm:"type:jsonb;not null" json:"data"` // The row as a create job request
+	Status    JobUploadRowStatus `gorm:"type:varchar(20);not null" json:"status"`
+	Errors    datatypes.JSON     `gorm:"type:jsonb" json:"errors,omitempty"` // Validation errors per column
+	Error     string             `gorm:"type:text" json:"error,omitempty"`   // Why creating the job failed
+	JobID     *uuid.UUID         `gorm:"type:uuid" json:"job_id,omitempty"`
+	CreatedAt time.Time          `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt time.Time          `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+// TableName specifies the table name for JobUploadRow
+func (JobUploadRow) TableName() string {
+	return "job_upload_rows"
+}
+
+// JobUploadFieldError is a validation error of a column in a row of a bulk job upload
+type JobUploadFieldError struct {
+	Column  string `json:"column"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Conversation is the message thread between an employer and an applicant about an application
+type Conversation struct {
+	ID            uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	ApplicationID uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex" json:"application_id"`
+	EmployerID    uuid.UUID  `gorm:"type:uuid;not null;index" json:"employer_id"`
+	CandidateID   uuid.UUID  `gorm:"type:uuid;not null;index" json:"candidate_id"`
+	LastMessageAt *time.Time `json:"last_message_at,omitempty"`
+	CreatedAt     time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt     time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+
+	// Relationships
+	Application *Application `gorm:"foreignKey:ApplicationID" json:"-"`
+	Employer    *User        `gorm:"foreignKey:EmployerID" json:"-"`
+	Candidate   *User        `gorm:"foreignKey:CandidateID" json:"-"`
+	LastMessage *Message     `gorm:"-" json:"-"`
+	UnreadCount int64        `gorm:"-" json:"-"` // Unread messages for the viewing participant
+}
+
+// TableName specifies the table name for Conversation
+func (Conversation) TableName() string {
+	return "conversations"
+}
+
+// IsParticipant checks if the user is the employer or the candidate of the conversation
+func (c *Conversation) IsParticipant(userID uuid.UUID) bool {
+	return c.EmployerID == userID || c.CandidateID == userID
+}
+
+// OtherParticipant returns the ID of the other side of the conversation
+func (c *Conversation) OtherParticipant(userID uuid.UUID) uuid.UUID {
+	if c.EmployerID == userID {
+		return c.CandidateID
+	}
+	return c.EmployerID
+}
+
+// Message is a message in a conversation
+type Message struct {
+	ID             uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	ConversationID uuid.UUID  `gorm:"type:uuid;not null;index" json:"conversation_id"`
+	SenderID       uuid.UUID  `gorm:"type:uuid;not null" json:"sender_id"`
+	Body           string     `gorm:"type:text;not null" json:"body"`
+	ReadAt         *time.Time `json:"read_at,omitempty"` // Set when the recipient opens the conversation
+	CreatedAt      time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+
+	// Relationships
+	Sender      *User               `gorm:"foreignKey:SenderID" json:"-"`
+	Attachments []MessageAttachment `gorm:"foreignKey:MessageID" json:"attachments,omitempty"`
+}
+
+// TableName specifies the table name for Message
+func (Message) TableName() string {
+	return "messages"
+}
+
+// IsRead checks if the recipient has read the message
+func (m *Message) IsRead() bool {
+	return m.ReadAt != nil
+}
+
+// MessageAttachment is a file attached to a message
+type MessageAttachment struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	MessageID uuid.UUID `gorm:"type:uuid;not null;index" json:"message_id"`
+	FileName  string    `gorm:"size:255;not null" json:"file_name"`
+	FilePath  string    `gorm:"size:500;not null" json:"-"`
+	FileSize  int64     `gorm:"not null" json:"file_size"`
+	MimeType  string    `gorm:"size:100;not null" json:"mime_type"`
+	CreatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+}
+
+// TableName specifies the table name for MessageAttachment
+func (MessageAttachment) TableName() string {
+	return "message_attachments"
+}
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NoteSubjectType represents what a note is attached to
+type NoteSubjectType string
+
+const (
+	NoteSubjectApplication NoteSubjectType = "APPLICATION"
+	NoteSubjectCandidate   NoteSubjectType = "CANDIDATE"
+)
+
+// Note is an internal note on an application or candidate, visible to the company team
+type Note struct {
+	ID          uuid.UUID       `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	CompanyID   uuid.UUID       `gorm:"type:uuid;not null;index" json:"company_id"`
+	SubjectType NoteSubjectType `gorm:"type:varchar(20);not null" json:"subject_type"`
+	SubjectID   uuid.UUID       `gorm:"type:uuid;not null" json:"subject_id"`
+	ParentID    *uuid.UUID      `gorm:"type:uuid;index" json:"parent_id,omitempty"`
+	AuthorID    uuid.UUID       `gorm:"type:uuid;not null" json:"author_id"`
+	Content     string          `gorm:"type:text;not null" json:"content"`
+	EditedAt    *time.Time      `json:"edited_at,omitempty"`
+	CreatedAt   time.Time       `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt   time.Time       `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+
+	// Relationships
+	Author   *User         `gorm:"foreignKey:AuthorID" json:"-"`
+	Mentions []NoteMention `gorm:"foreignKey:NoteID" json:"mentions,omitempty"`
+	Replies  []Note        `gorm:"foreignKey:ParentID" json:"replies,omitempty"`
+}
+
+// TableName specifies the table name for Note
+func (Note) TableName() string {
+	return "notes"
+}
+
+// IsReply checks if the note is a reply to another note
+func (n *Note) IsReply() bool {
+	return n.ParentID != nil
+}
+
+// IsAuthor checks if the user wrote the note
+func (n *Note) IsAuthor(userID uuid.UUID) bool {
+	return n.AuthorID == userID
+}
+
+// NoteMention links a note to a mentioned team member
+type NoteMention struct {
+	NoteID    uuid.UUID `gorm:"type:uuid;primary_key" json:"note_id"`
+	UserID    uuid.UUID `gorm:"type:uuid;primary_key" json:"user_id"`
+	CreatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+
+	// Relationships
+	User *User `gorm:"foreignKey:UserID" json:"-"`
+}
+
+// TableName specifies the table name for NoteMention
+func (NoteMention) TableName() string {
+	return "note_mentions"
+}
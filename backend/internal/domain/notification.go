@@ -22,6 +22,14 @@ const (
 	NotificationJobRejected          NotificationType = "JOB_REJECTED"
 	NotificationCompanyVerified      NotificationType = "COMPANY_VERIFIED"
 	NotificationCompanyRejected      NotificationType = "COMPANY_REJECTED"
+	NotificationInterviewProposed    NotificationType = "INTERVIEW_PROPOSED"
+	NotificationInterviewScheduled   NotificationType = "INTERVIEW_SCHEDULED"
+	NotificationInterviewCancelled   NotificationType = "INTERVIEW_CANCELLED"
+	NotificationNoteMention          NotificationType = "NOTE_MENTION"
+	NotificationNewMessage           NotificationType = "NEW_MESSAGE"
+	NotificationOfferReceived        NotificationType = "OFFER_RECEIVED"
+	NotificationOfferResponded       NotificationType = "OFFER_RESPONDED"
+	NotificationJobAlert             NotificationType = "JOB_ALERT"
 )
 
 // Notification represents an in-app notification for a user
@@ -60,6 +68,9 @@ type NotificationPreferences struct {
 	EmailTeamInvitation      bool `gorm:"default:true" json:"email_team_invitation"`
 	EmailJobModeration       bool `gorm:"default:true" json:"email_job_moderation"`
 	EmailCompanyVerification bool `gorm:"default:true" json:"email_company_verification"`
+	EmailNewMessage          bool `gorm:"default:true" json:"email_new_message"`
+	EmailJobAlert            bool `gorm:"default:true" json:"email_job_alert"`
+	EmailDailyDigest         bool `gorm:"default:true" json:"email_daily_digest"` // Batch high-volume types into a daily email
 
 	// In-app notifications
 	AppApplicationStatus   bool `gorm:"default:true" json:"app_application_status"`
@@ -71,6 +82,21 @@ type NotificationPreferences struct {
 	AppTeamInvitation      bool `gorm:"default:true" json:"app_team_invitation"`
 	AppJobModeration       bool `gorm:"default:true" json:"app_job_moderation"`
 	AppCompanyVerification bool `gorm:"default:true" json:"app_company_verification"`
+	AppNewMessage          bool `gorm:"default:true" json:"app_new_message"`
+	AppJobAlert            bool `gorm:"default:true" json:"app_job_alert"`
+
+	// Push notifications
+	PushApplicationStatus   bool `gorm:"default:true" json:"push_application_status"`
+	PushNewApplication      bool `gorm:"default:true" json:"push_new_application"`
+	PushNewJob              bool `gorm:"default:true" json:"push_new_job"`
+	PushJobExpiring         bool `gorm:"default:true" json:"push_job_expiring"`
+	PushProfileViewed       bool `gorm:"default:false" json:"push_profile_viewed"`
+	PushCompanyReview       bool `gorm:"default:true" json:"push_company_review"`
+	PushTeamInvitation      bool `gorm:"default:true" json:"push_team_invitation"`
+	PushJobModeration       bool `gorm:"default:true" json:"push_job_moderation"`
+	PushCompanyVerification bool `gorm:"default:true" json:"push_company_verification"`
+	PushNewMessage          bool `gorm:"default:true" json:"push_new_message"`
+	PushJobAlert            bool `gorm:"default:true" json:"push_job_alert"`
 
 	CreatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
 	UpdatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
@@ -100,6 +126,9 @@ func NewDefaultNotificationPreferences(userID uuid.UUID) *NotificationPreference
 		EmailTeamInvitation:      true,
 		EmailJobModeration:       true,
 		EmailCompanyVerification: true,
+		EmailNewMessage:          true,
+		EmailJobAlert:            true,
+		EmailDailyDigest:         true,
 
 		// In-app defaults
 		AppApplicationStatus:   true,
@@ -111,8 +140,40 @@ func NewDefaultNotificationPreferences(userID uuid.UUID) *NotificationPreference
 		AppTeamInvitation:      true,
 		AppJobModeration:       true,
 		AppCompanyVerification: true,
+		AppNewMessage:          true,
+		AppJobAlert:            true,
+
+		// Push defaults
+		PushApplicationStatus:   true,
+		PushNewApplication:      true,
+		PushNewJob:              true,
+		PushJobExpiring:         true,
+		PushProfileViewed:       false,
+		PushCompanyReview:       true,
+		PushTeamInvitation:      true,
+		PushJobModeration:       true,
+		PushCompanyVerification: true,
+		PushNewMessage:          true,
+		PushJobAlert:            true,
 
 		CreatedAt: now,
 		UpdatedAt: now,
 	}
 }
+
+// NotificationDigestItem is a notification waiting to be emailed in a user's daily digest
+type NotificationDigestItem struct {
+	ID        uuid.UUID        `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	UserID    uuid.UUID        `gorm:"type:uuid;not null;index" json:"user_id"`
+	Type      NotificationType `gorm:"type:varchar(50);not null" json:"type"`
+	Title     string           `gorm:"type:varchar(255);not null" json:"title"`
+	Message   string           `gorm:"type:text;not null" json:"message"`
+	Link      *string          `gorm:"type:varchar(500)" json:"link,omitempty"`
+	CreatedAt time.Time        `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	SentAt    *time.Time       `json:"sent_at,omitempty"`
+}
+
+// TableName specifies the table name for NotificationDigestItem
+func (NotificationDigestItem) TableName() string {
+	return "notification_digest_items"
+}
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OfferStatus represents the state of an offer letter
+type OfferStatus string
+
+const (
+	OfferStatusDraft     OfferStatus = "DRAFT" // Being prepared by the employer, not visible to the candidate
+	OfferStatusSent      OfferStatus = "SENT"  // Waiting for the candidate's answer
+	OfferStatusAccepted  OfferStatus = "ACCEPTED"
+	OfferStatusDeclined  OfferStatus = "DECLINED"
+	OfferStatusWithdrawn OfferStatus = "WITHDRAWN" // Withdrawn by the employer before an answer
+)
+
+// Offer represents an offer letter sent to a candidate for an application
+type Offer struct {
+	ID             uuid.UUID   `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	ApplicationID  uuid.UUID   `gorm:"type:uuid;not null;index" json:"application_id"`
+	CreatedBy      uuid.UUID   `gorm:"type:uuid;not null" json:"created_by"`
+	Status         OfferStatus `gorm:"type:varchar(20);not null;default:'DRAFT';index" json:"status"`
+	Salary         int         `gorm:"not null" json:"salary"`
+	SalaryCurrency string      `gorm:"size:3;not null;default:'USD'" json:"salary_currency"`
+	SalaryPeriod   string      `gorm:"size:20;not null;default:'YEARLY'" json:"salary_period"`
+	StartDate      time.Time   `gorm:"type:date;not null" json:"start_date"`
+	ExpiresAt      *time.Time  `json:"expires_at,omitempty"`
+	Message        string      `gorm:"type:text" json:"message,omitempty"` // Shown to the candidate with the offer
+
+	// Offer letter PDF stored in MinIO
+	DocumentName string `gorm:"size:255" json:"document_name,omitempty"`
+	DocumentPath string `gorm:"size:500" json:"-"`
+
+	// Application status before the offer was sent, restored when the offer is declined or withdrawn
+	PreviousStatus *ApplicationStatus `gorm:"type:varchar(20)" json:"-"`
+
+	SentAt        *time.Time `json:"sent_at,omitempty"`
+	RespondedAt   *time.Time `json:"responded_at,omitempty"`
+	DeclineReason string     `gorm:"type:text" json:"decline_reason,omitempty"`
+	CreatedAt     time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt     time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+
+	// Relationships
+	Application *Application `gorm:"foreignKey:ApplicationID" json:"-"`
+	Creator     *User        `gorm:"foreignKey:CreatedBy" json:"-"`
+}
+
+// TableName specifies the table name for Offer
+func (Offer) TableName() string {
+	return "offers"
+}
+
+// IsOpen checks if the offer is still a draft or waiting for an answer
+func (o *Offer) IsOpen() bool {
+	return o.Status == OfferStatusDraft || o.Status == OfferStatusSent
+}
+
+// IsExpired checks if a sent offer passed its expiry date without an answer
+func (o *Offer) IsExpired() bool {
+	return o.Status == OfferStatusSent && o.ExpiresAt != nil && time.Now().After(*o.ExpiresAt)
+}
+
+// HasDocument checks if an offer letter PDF is attached
+func (o *Offer) HasDocument() bool {
+	return o.DocumentPath != ""
+}
@@ -0,0 +1,140 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// pipelineStatuses are the application status categories a pipeline stage can map to, in hiring order.
+// REJECTED and WITHDRAWN are outcomes rather than stages and are available in every pipeline.
+var pipelineStatuses = []ApplicationStatus{
+	ApplicationStatusSubmitted,
+	ApplicationStatusReviewed,
+	ApplicationStatusShortlisted,
+	ApplicationStatusInterview,
+	ApplicationStatusOffered,
+	ApplicationStatusHired,
+}
+
+// defaultStageNames are the stage names of the built-in pipeline
+var defaultStageNames = map[ApplicationStatus]string{
+	ApplicationStatusSubmitted:   "Applied",
+	ApplicationStatusReviewed:    "Reviewed",
+	ApplicationStatusShortlisted: "Shortlisted",
+	ApplicationStatusInterview:   "Interview",
+	ApplicationStatusOffered:     "Offer",
+	ApplicationStatusHired:       "Hired",
+	ApplicationStatusRejected:    "Rejected",
+	ApplicationStatusWithdrawn:   "Withdrawn",
+}
+
+// PipelineStage represents a company-defined step of the hiring process
+type PipelineStage struct {
+	ID        uuid.UUID         `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	CompanyID uuid.UUID         `gorm:"type:uuid;not null;index" json:"company_id"`
+	Name      string            `gorm:"size:100;not null" json:"name"`
+	Status    ApplicationStatus `gorm:"type:varchar(20);not null" json:"status"` // Status category used for analytics
+	Position  int               `gorm:"not null;default:0" json:"position"`
+	CreatedAt time.Time         `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt time.Time         `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+// TableName specifies the table name for PipelineStage
+func (PipelineStage) TableName() string {
+	return "pipeline_stages"
+}
+
+// IsPipelineStatus checks if a status can be used as a pipeline stage category
+func IsPipelineStatus(status ApplicationStatus) bool {
+	return PipelineStatusRank(status) >= 0
+}
+
+// PipelineStatusRank returns the position of a status in the hiring order, or -1 if it is not a stage category
+func PipelineStatusRank(status ApplicationStatus) int {
+	for i, s := range pipelineStatuses {
+		if s == status {
+			return i
+		}
+	}
+	return -1
+}
+
+// DefaultStageName returns the built-in stage name for a status
+func DefaultStageName(status ApplicationStatus) string {
+	if name, ok := defaultStageNames[status]; ok {
+		return name
+	}
+	return string(status)
+}
+
+// Pipeline is the ordered list of stages applications of a company move through
+type Pipeline struct {
+	CompanyID *uuid.UUID
+	Stages    []PipelineStage
+	IsCustom  bool // False when the company has not configured its own stages
+}
+
+// DefaultPipeline returns the built-in pipeline with one stage per status category.
+// Default stages are not persisted and have no IDs.
+func DefaultPipeline(companyID *uuid.UUID) *Pipeline {
+	stages := make([]PipelineStage, len(pipelineStatuses))
+	for i, status := range pipelineStatuses {
+		stages[i] = PipelineStage{
+			Name:     DefaultStageName(status),
+			Status:   status,
+			Position: i,
+		}
+		if companyID != nil {
+			stages[i].CompanyID = *companyID
+		}
+	}
+	return &Pipeline{CompanyID: companyID, Stages: stages}
+}
+
+// FirstStage returns the stage new applications start in
+func (p *Pipeline) FirstStage() *PipelineStage {
+	if len(p.Stages) == 0 {
+		return nil
+	}
+	return &p.Stages[0]
+}
+
+// StageByID returns the stage with the given ID
+func (p *Pipeline) StageByID(id uuid.UUID) *PipelineStage {
+	for i := range p.Stages {
+		if p.Stages[i].ID == id {
+			return &p.Stages[i]
+		}
+	}
+	return nil
+}
+
+// StageForStatus returns the first stage mapped to a status category
+func (p *Pipeline) StageForStatus(status ApplicationStatus) *PipelineStage {
+	for i := range p.Stages {
+		if p.Stages[i].Status == status {
+			return &p.Stages[i]
+		}
+	}
+	return nil
+}
+
+// CurrentStage returns the stage an application is in. Applications without a stage
+// (default pipeline, or their stage was removed) resolve to the first stage of their status.
+func (p *Pipeline) CurrentStage(app *Application) *PipelineStage {
+	if app.StageID != nil {
+		if stage := p.StageByID(*app.StageID); stage != nil {
+			return stage
+		}
+	}
+	return p.StageForStatus(app.Status)
+}
+
+// StageName returns the display name of an application's current stage
+func (p *Pipeline) StageName(app *Application) string {
+	if stage := p.CurrentStage(app); stage != nil {
+		return stage.Name
+	}
+	return DefaultStageName(app.Status)
+}
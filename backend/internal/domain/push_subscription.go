@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PushSubscription is a browser of a user registered for Web Push notifications
+type PushSubscription struct {
+	ID            uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	UserID        uuid.UUID  `gorm:"type:uuid;not null;index" json:"user_id"`
+	Endpoint      string     `gorm:"type:text;uniqueIndex;not null" json:"-"`
+	P256dh        string     `gorm:"column:p256dh;type:varchar(255);not null" json:"-"`
+	Auth          string     `gorm:"type:varchar(255);not null" json:"-"`
+	UserAgent     string     `gorm:"type:varchar(500)" json:"user_agent"`
+	FailureCount  int        `gorm:"not null;default:0" json:"-"` // Consecutive failed deliveries
+	LastSuccessAt *time.Time `json:"last_success_at,omitempty"`
+	CreatedAt     time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt     time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+// TableName specifies the table name for PushSubscription
+func (PushSubscription) TableName() string {
+	return "push_subscriptions"
+}
@@ -0,0 +1,129 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SavedSearchFrequency controls how often a saved search sends job alerts
+type SavedSearchFrequency string
+
+const (
+	SavedSearchFrequencyInstant SavedSearchFrequency = "INSTANT" // As soon as matching jobs are found
+	SavedSearchFrequencyDaily   SavedSearchFrequency = "DAILY"
+	SavedSearchFrequencyWeekly  SavedSearchFrequency = "WEEKLY"
+)
+
+// IsValid checks if the frequency is supported
+func (f SavedSearchFrequency) IsValid() bool {
+	switch f {
+	case SavedSearchFrequencyInstant, SavedSearchFrequencyDaily, SavedSearchFrequencyWeekly:
+		return true
+	}
+	return false
+}
+
+// Period returns the time between two digests of the frequency
+func (f SavedSearchFrequency) Period() time.Duration {
+	switch f {
+	case SavedSearchFrequencyDaily:
+		return 24 * time.Hour
+	case SavedSearchFrequencyWeekly:
+		return 7 * 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// SavedSearch is a job search saved by a job seeker to be alerted of new matching jobs
+type SavedSearch struct {
+	ID               uuid.UUID            `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	UserID           uuid.UUID            `gorm:"type:uuid;not null;index" json:"user_id"`
+	Name             string               `gorm:"type:varchar(255);not null" json:"name"`
+	Filters          SavedSearchFilters   `gorm:"type:jsonb;not null" json:"filters"`
+	Frequency        SavedSearchFrequency `gorm:"type:varchar(20);not null;default:'DAILY'" json:"frequency"`
+	EmailEnabled     bool                 `gorm:"not null;default:true" json:"email_enabled"`
+	IsActive         bool                 `gorm:"not null;default:true" json:"is_active"`
+	UnsubscribeToken string               `gorm:"type:varchar(64);uniqueIndex;not null" json:"-"`
+	LastMatchedAt    *time.Time           `json:"last_matched_at,omitempty"`  // Jobs published before this were already matched
+	LastNotifiedAt   *time.Time           `json:"last_notified_at,omitempty"` // Last alert sent
+	CreatedAt        time.Time            `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt        time.Time            `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+
+	// Relationships
+	User *User `gorm:"foreignKey:UserID" json:"-"`
+}
+
+// TableName specifies the table name for SavedSearch
+func (SavedSearch) TableName() string {
+	return "saved_searches"
+}
+
+// IsDigestDue checks if an alert for the pending matches of the search can be sent at now.
+// grace lets a digest go out one scheduler run early, so daily digests don't drift by a run every day.
+func (s *SavedSearch) IsDigestDue(now time.Time, grace time.Duration) bool {
+	if s.LastNotifiedAt == nil || s.Frequency == SavedSearchFrequencyInstant {
+		return true
+	}
+	return !now.Before(s.LastNotifiedAt.Add(s.Frequency.Period() - grace))
+}
+
+// SavedSearchFilters are the job search filters of a saved search.
+// They mirror the query parameters of the public job list.
+type SavedSearchFilters struct {
+	Query            string            `json:"q,omitempty"`
+	Location         string            `json:"location,omitempty"`
+	CategorySlug     string            `json:"category,omitempty"`
+	JobTypes         []JobType         `json:"job_type,omitempty"`
+	ExperienceLevels []ExperienceLevel `json:"experience_level,omitempty"`
+	WorkplaceTypes   []WorkplaceType   `json:"workplace_type,omitempty"`
+	SalaryMin        *int              `json:"salary_min,omitempty"`
+	SalaryMax        *int              `json:"salary_max,omitempty"`
+}
+
+// IsEmpty checks if no filter is set, which would match every new job
+func (f SavedSearchFilters) IsEmpty() bool {
+	return f.Query == "" && f.Location == "" && f.CategorySlug == "" &&
+		len(f.JobTypes) == 0 && len(f.ExperienceLevels) == 0 && len(f.WorkplaceTypes) == 0 &&
+		f.SalaryMin == nil && f.SalaryMax == nil
+}
+
+// Value implements the driver.Valuer interface for SavedSearchFilters
+func (f SavedSearchFilters) Value() (driver.Value, error) {
+	return json.Marshal(f)
+}
+
+// Scan implements the sql.Scanner interface for SavedSearchFilters
+func (f *SavedSearchFilters) Scan(value interface{}) error {
+	if value == nil {
+		*f = SavedSearchFilters{}
+		return nil
+	}
+
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New("failed to scan SavedSearchFilters: value is not []byte")
+	}
+
+	return json.Unmarshal(bytes, f)
+}
+
+// SavedSearchMatch records a job found for a saved search
+type SavedSearchMatch struct {
+	SavedSearchID uuid.UUID  `gorm:"type:uuid;primaryKey" json:"saved_search_id"`
+	JobID         uuid.UUID  `gorm:"type:uuid;primaryKey" json:"job_id"`
+	MatchedAt     time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"matched_at"`
+	NotifiedAt    *time.Time `json:"notified_at,omitempty"` // Nil until the job was sent in an alert
+
+	// Relationships
+	Job *Job `gorm:"foreignKey:JobID" json:"-"`
+}
+
+// TableName specifies the table name for SavedSearchMatch
+func (SavedSearchMatch) TableName() string {
+	return "saved_search_matches"
+}
@@ -0,0 +1,208 @@
+package domain
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// HireRecommendation represents a reviewer's overall hiring recommendation
+type HireRecommendation string
+
+const (
+	RecommendationStrongNoHire HireRecommendation = "STRONG_NO_HIRE"
+	RecommendationNoHire       HireRecommendation = "NO_HIRE"
+	RecommendationHire         HireRecommendation = "HIRE"
+	RecommendationStrongHire   HireRecommendation = "STRONG_HIRE"
+)
+
+// IsValid checks if the recommendation is a known value
+func (r HireRecommendation) IsValid() bool {
+	switch r {
+	case RecommendationStrongNoHire, RecommendationNoHire, RecommendationHire, RecommendationStrongHire:
+		return true
+	}
+	return false
+}
+
+// ScorecardStatus represents whether a scorecard has been submitted
+type ScorecardStatus string
+
+const (
+	ScorecardStatusDraft     ScorecardStatus = "DRAFT"
+	ScorecardStatusSubmitted ScorecardStatus = "SUBMITTED"
+)
+
+// ScorecardCriterion is a rating criterion configured for a job
+type ScorecardCriterion struct {
+	ID          uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	JobID       uuid.UUID `gorm:"type:uuid;not null;index" json:"job_id"`
+	Position    int       `gorm:"not null;default:0" json:"position"`
+	Name        string    `gorm:"size:100;not null" json:"name"`
+	Description string    `gorm:"type:text" json:"description,omitempty"`
+	CreatedAt   time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt   time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+// TableName specifies the table name for ScorecardCriterion
+func (ScorecardCriterion) TableName() string {
+	return "job_scorecard_criteria"
+}
+
+// Scorecard is one reviewer's structured feedback on an application
+type Scorecard struct {
+	ID             uuid.UUID          `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	ApplicationID  uuid.UUID          `gorm:"type:uuid;not null;index" json:"application_id"`
+	ReviewerID     uuid.UUID          `gorm:"type:uuid;not null" json:"reviewer_id"`
+	Recommendation HireRecommendation `gorm:"type:varchar(20)" json:"recommendation,omitempty"`
+	Summary        string             `gorm:"type:text" json:"summary,omitempty"`
+	Status         ScorecardStatus    `gorm:"type:varchar(20);not null;default:'DRAFT'" json:"status"`
+	SubmittedAt    *time.Time         `json:"submitted_at,omitempty"`
+	CreatedAt      time.Time          `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt      time.Time          `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+
+	// Relationships
+	Ratings  []ScorecardRating `gorm:"foreignKey:ScorecardID" json:"ratings"`
+	Reviewer *User             `gorm:"foreignKey:ReviewerID" json:"-"`
+}
+
+// TableName specifies the table name for Scorecard
+func (Scorecard) TableName() string {
+	return "application_scorecards"
+}
+
+// IsSubmitted checks if the scorecard has been submitted
+func (s *Scorecard) IsSubmitted() bool {
+	return s.Status == ScorecardStatusSubmitted
+}
+
+// AverageRating returns the mean of the criterion ratings, or nil if nothing was rated
+func (s *Scorecard) AverageRating() *float64 {
+	if len(s.Ratings) == 0 {
+		return nil
+	}
+	total := 0
+	for _, r := range s.Ratings {
+		total += r.Rating
+	}
+	avg := float64(total) / float64(len(s.Ratings))
+	return &avg
+}
+
+// ScorecardRating is the rating of one criterion on a scorecard
+type ScorecardRating struct {
+	ID            uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	ScorecardID   uuid.UUID  `gorm:"type:uuid;not null;index" json:"scorecard_id"`
+	CriterionID   *uuid.UUID `gorm:"type:uuid" json:"criterion_id,omitempty"`
+	CriterionName string     `gorm:"size:100;not null" json:"criterion_name"`
+	Rating        int        `gorm:"not null;check:rating >= 1 AND rating <= 5" json:"rating"`
+	Comment       string     `gorm:"type:text" json:"comment,omitempty"`
+	CreatedAt     time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+}
+
+// TableName specifies the table name for ScorecardRating
+func (ScorecardRating) TableName() string {
+	return "scorecard_ratings"
+}
+
+// CriterionSummary represents the aggregated ratings of one criterion
+type CriterionSummary struct {
+	CriterionID   *uuid.UUID
+	Name          string
+	AverageRating float64
+	Count         int
+}
+
+// FeedbackSummary represents aggregated feedback from submitted scorecards
+type FeedbackSummary struct {
+	SubmittedCount  int
+	DraftCount      int
+	AverageRating   *float64
+	Recommendations map[HireRecommendation]int
+	Criteria        []CriterionSummary
+}
+
+// ApplicationFeedback represents the feedback on an application as visible to one reviewer
+type ApplicationFeedback struct {
+	Criteria    []ScorecardCriterion
+	MyScorecard *Scorecard
+	Scorecards  []Scorecard      // Submitted scorecards visible to the reviewer
+	Summary     *FeedbackSummary // Nil while other reviewers' feedback is hidden
+	HiddenCount int              // Submitted scorecards hidden until the reviewer submits their own
+}
+
+// SummarizeScorecards aggregates submitted scorecards. Criteria follow the job's order;
+// ratings of criteria that were removed since are listed after them by name.
+func SummarizeScorecards(scorecards []Scorecard, criteria []ScorecardCriterion) *FeedbackSummary {
+	summary := &FeedbackSummary{
+		Recommendations: map[HireRecommendation]int{},
+		Criteria:        []CriterionSummary{},
+	}
+
+	type total struct {
+		id    *uuid.UUID
+		sum   int
+		count int
+	}
+	totals := map[string]*total{}
+	for i := range criteria {
+		id := criteria[i].ID
+		totals[criteria[i].Name] = &total{id: &id}
+	}
+
+	ratingSum, ratingCount := 0, 0
+	for _, sc := range scorecards {
+		if !sc.IsSubmitted() {
+			summary.DraftCount++
+			continue
+		}
+		summary.SubmittedCount++
+		if sc.Recommendation != "" {
+			summary.Recommendations[sc.Recommendation]++
+		}
+		for _, r := range sc.Ratings {
+			t, ok := totals[r.CriterionName]
+			if !ok {
+				t = &total{}
+				totals[r.CriterionName] = t
+			}
+			t.sum += r.Rating
+			t.count++
+			ratingSum += r.Rating
+			ratingCount++
+		}
+	}
+
+	if ratingCount > 0 {
+		avg := float64(ratingSum) / float64(ratingCount)
+		summary.AverageRating = &avg
+	}
+
+	appendCriterion := func(name string) {
+		t := totals[name]
+		if t == nil || t.count == 0 {
+			return
+		}
+		summary.Criteria = append(summary.Criteria, CriterionSummary{
+			CriterionID:   t.id,
+			Name:          name,
+			AverageRating: float64(t.sum) / float64(t.count),
+			Count:         t.count,
+		})
+		delete(totals, name)
+	}
+	for _, c := range criteria {
+		appendCriterion(c.Name)
+	}
+	removed := make([]string, 0, len(totals))
+	for name := range totals {
+		removed = append(removed, name)
+	}
+	sort.Strings(removed)
+	for _, name := range removed {
+		appendCriterion(name)
+	}
+
+	return summary
+}
@@ -0,0 +1,272 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// ScreeningQuestionType represents the kind of answer a screening question expects
+type ScreeningQuestionType string
+
+const (
+	ScreeningQuestionText           ScreeningQuestionType = "TEXT"
+	ScreeningQuestionYesNo          ScreeningQuestionType = "YES_NO"
+	ScreeningQuestionSingleChoice   ScreeningQuestionType = "SINGLE_CHOICE"
+	ScreeningQuestionMultipleChoice ScreeningQuestionType = "MULTI_CHOICE"
+	ScreeningQuestionNumber         ScreeningQuestionType = "NUMBER"
+)
+
+// IsValid checks if the question type is supported
+func (t ScreeningQuestionType) IsValid() bool {
+	switch t {
+	case ScreeningQuestionText, ScreeningQuestionYesNo, ScreeningQuestionSingleChoice,
+		ScreeningQuestionMultipleChoice, ScreeningQuestionNumber:
+		return true
+	}
+	return false
+}
+
+// KnockoutAction represents what happens to an application when a knockout answer is given
+type KnockoutAction string
+
+const (
+	KnockoutActionNone   KnockoutAction = "NONE"
+	KnockoutActionFlag   KnockoutAction = "FLAG"
+	KnockoutActionReject KnockoutAction = "REJECT"
+)
+
+// IsValid checks if the knockout action is supported
+func (a KnockoutAction) IsValid() bool {
+	return a == KnockoutActionNone || a == KnockoutActionFlag || a == KnockoutActionReject
+}
+
+// Answer values accepted for YES_NO questions
+const (
+	ScreeningAnswerYes = "YES"
+	ScreeningAnswerNo  = "NO"
+)
+
+// ScreeningQuestion represents an employer-defined question candidates answer when applying
+type ScreeningQuestion struct {
+	ID         uuid.UUID             `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	JobID      uuid.UUID             `gorm:"type:uuid;not null;index" json:"job_id"`
+	Position   int                   `gorm:"not null;default:0" json:"position"`
+	Question   string                `gorm:"type:text;not null" json:"question"`
+	Type       ScreeningQuestionType `gorm:"column:question_type;type:varchar(20);not null" json:"type"`
+	Options    pq.StringArray        `gorm:"type:text[]" json:"options,omitempty"`    // SINGLE_CHOICE / MULTI_CHOICE
+	MinValue   *float64              `gorm:"type:numeric" json:"min_value,omitempty"` // NUMBER
+	MaxValue   *float64              `gorm:"type:numeric" json:"max_value,omitempty"` // NUMBER
+	IsRequired bool                  `gorm:"not null" json:"is_required"`
+
+	// Knockout rules. For YES_NO and choice questions an answer in KnockoutAnswers triggers
+	// the action; for NUMBER questions a value outside MinValue/MaxValue does.
+	KnockoutAction  KnockoutAction `gorm:"type:varchar(10);not null;default:'NONE'" json:"knockout_action"`
+	KnockoutAnswers pq.StringArray `gorm:"type:text[]" json:"knockout_answers,omitempty"`
+
+	CreatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+// TableName specifies the table name for ScreeningQuestion
+func (ScreeningQuestion) TableName() string {
+	return "job_screening_questions"
+}
+
+// HasKnockout checks if the question has a knockout rule
+func (q *ScreeningQuestion) HasKnockout() bool {
+	return q.KnockoutAction == KnockoutActionFlag || q.KnockoutAction == KnockoutActionReject
+}
+
+// Validate checks that the question definition is consistent for its type
+func (q *ScreeningQuestion) Validate() error {
+	if strings.TrimSpace(q.Question) == "" || !q.Type.IsValid() {
+		return ErrInvalidScreeningQuestion
+	}
+	if q.KnockoutAction == "" {
+		q.KnockoutAction = KnockoutActionNone
+	}
+	if !q.KnockoutAction.IsValid() {
+		return ErrInvalidScreeningQuestion
+	}
+
+	switch q.Type {
+	case ScreeningQuestionSingleChoice, ScreeningQuestionMultipleChoice:
+		if len(q.Options) < 2 {
+			return ErrInvalidScreeningQuestion
+		}
+		for _, answer := range q.KnockoutAnswers {
+			if !containsString(q.Options, answer) {
+				return ErrInvalidScreeningQuestion
+			}
+		}
+	case ScreeningQuestionYesNo:
+		for _, answer := range q.KnockoutAnswers {
+			if answer != ScreeningAnswerYes && answer != ScreeningAnswerNo {
+				return ErrInvalidScreeningQuestion
+			}
+		}
+	case ScreeningQuestionNumber:
+		if q.MinValue != nil && q.MaxValue != nil && *q.MinValue > *q.MaxValue {
+			return ErrInvalidScreeningQuestion
+		}
+	case ScreeningQuestionText:
+		// Free text answers cannot be knocked out
+		if q.HasKnockout() {
+			return ErrInvalidScreeningQuestion
+		}
+	}
+
+	if q.HasKnockout() && q.Type != ScreeningQuestionNumber && len(q.KnockoutAnswers) == 0 {
+		return ErrInvalidScreeningQuestion
+	}
+	if q.HasKnockout() && q.Type == ScreeningQuestionNumber && q.MinValue == nil && q.MaxValue == nil {
+		return ErrInvalidScreeningQuestion
+	}
+
+	return nil
+}
+
+// ScreeningAnswer is a validated answer stored on Application.Answers.
+// The question text is copied so answers stay readable if the job's questions change.
+type ScreeningAnswer struct {
+	QuestionID uuid.UUID             `json:"question_id"`
+	Question   string                `json:"question"`
+	Type       ScreeningQuestionType `json:"type"`
+	Value      interface{}           `json:"value"`
+	KnockedOut bool                  `json:"knocked_out,omitempty"`
+}
+
+// EvaluateAnswer validates a raw answer against the question and reports whether it is a knockout.
+// A nil answer is returned for optional questions that were left blank.
+func (q *ScreeningQuestion) EvaluateAnswer(raw interface{}) (*ScreeningAnswer, error) {
+	if isBlankAnswer(raw) {
+		if q.IsRequired {
+			return nil, ErrScreeningAnswerRequired
+		}
+		return nil, nil
+	}
+
+	answer := &ScreeningAnswer{
+		QuestionID: q.ID,
+		Question:   q.Question,
+		Type:       q.Type,
+	}
+
+	switch q.Type {
+	case ScreeningQuestionText:
+		text, ok := raw.(string)
+		if !ok {
+			return nil, ErrInvalidScreeningAnswer
+		}
+		answer.Value = strings.TrimSpace(text)
+
+	case ScreeningQuestionYesNo:
+		var value string
+		switch v := raw.(type) {
+		case bool:
+			value = ScreeningAnswerNo
+			if v {
+				value = ScreeningAnswerYes
+			}
+		case string:
+			value = strings.ToUpper(strings.TrimSpace(v))
+		}
+		if value != ScreeningAnswerYes && value != ScreeningAnswerNo {
+			return nil, ErrInvalidScreeningAnswer
+		}
+		answer.Value = value
+		answer.KnockedOut = containsString(q.KnockoutAnswers, value)
+
+	case ScreeningQuestionSingleChoice:
+		value, ok := raw.(string)
+		if !ok || !containsString(q.Options, value) {
+			return nil, ErrInvalidScreeningAnswer
+		}
+		answer.Value = value
+		answer.KnockedOut = containsString(q.KnockoutAnswers, value)
+
+	case ScreeningQuestionMultipleChoice:
+		items, ok := raw.([]interface{})
+		if !ok {
+			return nil, ErrInvalidScreeningAnswer
+		}
+		values := make([]string, 0, len(items))
+		for _, item := range items {
+			value, ok := item.(string)
+			if !ok || !containsString(q.Options, value) {
+				return nil, ErrInvalidScreeningAnswer
+			}
+			if containsString(values, value) {
+				continue
+			}
+			values = append(values, value)
+			if containsString(q.KnockoutAnswers, value) {
+				answer.KnockedOut = true
+			}
+		}
+		if len(values) == 0 && q.IsRequired {
+			return nil, ErrScreeningAnswerRequired
+		}
+		answer.Value = values
+
+	case ScreeningQuestionNumber:
+		var value float64
+		switch v := raw.(type) {
+		case float64:
+			value = v
+		case string:
+			parsed, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+			if err != nil {
+				return nil, ErrInvalidScreeningAnswer
+			}
+			value = parsed
+		default:
+			return nil, ErrInvalidScreeningAnswer
+		}
+		outOfRange := (q.MinValue != nil && value < *q.MinValue) || (q.MaxValue != nil && value > *q.MaxValue)
+		if outOfRange {
+			// Without a knockout rule the range is a hard limit on the answer
+			if !q.HasKnockout() {
+				return nil, ErrInvalidScreeningAnswer
+			}
+			answer.KnockedOut = true
+		}
+		answer.Value = value
+
+	default:
+		return nil, ErrInvalidScreeningAnswer
+	}
+
+	if !q.HasKnockout() {
+		answer.KnockedOut = false
+	}
+
+	return answer, nil
+}
+
+// isBlankAnswer checks if an answer was left empty
+func isBlankAnswer(raw interface{}) bool {
+	switch v := raw.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(v) == ""
+	case []interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+// containsString checks if a slice contains the given value
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gorm.io/datatypes"
+)
+
+// WebhookEvent represents an event companies can receive on their webhook endpoints
+type WebhookEvent string
+
+const (
+	WebhookEventApplicationCreated       WebhookEvent = "application.created"
+	WebhookEventApplicationStatusChanged WebhookEvent = "application.status_changed"
+	WebhookEventJobPublished             WebhookEvent = "job.published"
+	WebhookEventJobExpired               WebhookEvent = "job.expired"
+	WebhookEventJobClosed                WebhookEvent = "job.closed"
+	WebhookEventReviewPosted             WebhookEvent = "review.posted"
+)
+
+// WebhookEvents lists the events a webhook can subscribe to
+var WebhookEvents = []WebhookEvent{
+	WebhookEventApplicationCreated,
+	WebhookEventApplicationStatusChanged,
+	WebhookEventJobPublished,
+	WebhookEventJobExpired,
+	WebhookEventJobClosed,
+	WebhookEventReviewPosted,
+}
+
+// IsValid checks if the event is supported
+func (e WebhookEvent) IsValid() bool {
+	for _, event := range WebhookEvents {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// Webhook is an endpoint of a company that receives signed events
+type Webhook struct {
+	ID          uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	CompanyID   uuid.UUID      `gorm:"type:uuid;not null;index" json:"company_id"`
+	CreatedBy   *uuid.UUID     `gorm:"type:uuid" json:"created_by,omitempty"`
+	URL         string         `gorm:"type:text;not null" json:"url"`
+	Description string         `gorm:"size:255" json:"description"`
+	Events      pq.StringArray `gorm:"type:text[];not null" json:"events"`
+	Secret      string         `gorm:"size:100;not null" json:"-"` // Signs payloads, shown once when created or rotated
+	IsActive    bool           `gorm:"not null;default:true" json:"is_active"`
+	CreatedAt   time.Time      `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt   time.Time      `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+// TableName specifies the table name for Webhook
+func (Webhook) TableName() string {
+	return "webhooks"
+}
+
+// Subscribes checks if the webhook receives an event
+func (w *Webhook) Subscribes(event WebhookEvent) bool {
+	for _, e := range w.Events {
+		if WebhookEvent(e) == event {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDeliveryStatus represents the state of a webhook delivery
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "PENDING"   // Waiting for its first attempt or a retry
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "SUCCEEDED" // The endpoint answered with a 2xx status
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "FAILED"    // All attempts failed, or the webhook was disabled
+)
+
+// IsValid checks if the delivery status is supported
+func (s WebhookDeliveryStatus) IsValid() bool {
+	return s == WebhookDeliveryPending || s == WebhookDeliverySucceeded || s == WebhookDeliveryFailed
+}
+
+// WebhookDelivery is an event sent to a webhook, with the outcome of its last attempt
+type WebhookDelivery struct {
+	ID             uuid.UUID             `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	WebhookID      uuid.UUID             `gorm:"type:uuid;not null;index" json:"webhook_id"`
+	EventID        uuid.UUID             `gorm:"type:uuid;not null" json:"event_id"` // Shared by replays, lets receivers deduplicate
+	Event          WebhookEvent          `gorm:"type:varchar(50);not null" json:"event"`
+	Payload        datatypes.JSON        `gorm:"type:jsonb;not null" json:"payload"`
+	Status         WebhookDeliveryStatus `gorm:"type:varchar(20);not null;default:'PENDING'" json:"status"`
+	Attempts       int                   `gorm:"not null;default:0" json:"attempts"`
+	NextAttemptAt  *time.Time            `json:"next_attempt_at,omitempty"`
+	LastAttemptAt  *time.Time            `json:"last_attempt_at,omitempty"`
+	ResponseStatus *int                  `json:"response_status,omitempty"`
+	ResponseBody   string                `gorm:"type:text" json:"response_body,omitempty"` // Truncated
+	Error          string                `gorm:"type:text" json:"error,omitempty"`
+	DurationMs     *int                  `json:"duration_ms,omitempty"`
+	DeliveredAt    *time.Time            `json:"delivered_at,omitempty"`
+	ReplayOf       *uuid.UUID            `gorm:"type:uuid" json:"replay_of,omitempty"`
+	CreatedAt      time.Time             `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt      time.Time             `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+// TableName specifies the table name for WebhookDelivery
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
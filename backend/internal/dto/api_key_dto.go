@@ -0,0 +1,101 @@
+package dto
+
+import (
+	"job-platform/internal/domain"
+	"time"
+)
+
+// ============================================================
+// REQUEST DTOs
+// ============================================================
+
+// CreateAPIKeyRequest represents a request to create a company API key
+type CreateAPIKeyRequest struct {
+	Name      string     `json:"name" binding:"required,max=100"`
+	Scopes    []string   `json:"scopes" binding:"required,min=1"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// ============================================================
+// RESPONSE DTOs
+// ============================================================
+
+// APIKeyCreatorResponse represents the team member an API key acts as
+type APIKeyCreatorResponse struct {
+	ID        string `json:"id"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Email     string `json:"email"`
+}
+
+// APIKeyResponse represents an API key in API responses. The key itself is never included.
+type APIKeyResponse struct {
+	ID         string                 `json:"id"`
+	Name       string                 `json:"name"`
+	Prefix     string                 `json:"prefix"`
+	Scopes     []string               `json:"scopes"`
+	Status     string                 `json:"status"` // ACTIVE, EXPIRED or REVOKED
+	CreatedBy  *APIKeyCreatorResponse `json:"created_by,omitempty"`
+	ExpiresAt  *time.Time             `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time             `json:"last_used_at,omitempty"`
+	LastUsedIP string                 `json:"last_used_ip,omitempty"`
+	RevokedAt  *time.Time             `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time              `json:"created_at"`
+}
+
+// CreatedAPIKeyResponse represents a new API key with the key itself, which is only shown once
+type CreatedAPIKeyResponse struct {
+	APIKeyResponse
+	Key string `json:"key"`
+}
+
+// ============================================================
+// HELPER FUNCTIONS
+// ============================================================
+
+// ToAPIKeyResponse converts a domain.APIKey to APIKeyResponse
+func ToAPIKeyResponse(key *domain.APIKey) APIKeyResponse {
+	status := "ACTIVE"
+	switch {
+	case key.IsRevoked():
+		status = "REVOKED"
+	case key.IsExpired():
+		status = "EXPIRED"
+	}
+
+	scopes := []string(key.Scopes)
+	if scopes == nil {
+		scopes = []string{}
+	}
+
+	resp := APIKeyResponse{
+		ID:         key.ID.String(),
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		Scopes:     scopes,
+		Status:     status,
+		ExpiresAt:  key.ExpiresAt,
+		LastUsedAt: key.LastUsedAt,
+		LastUsedIP: key.LastUsedIP,
+		RevokedAt:  key.RevokedAt,
+		CreatedAt:  key.CreatedAt,
+	}
+	if key.Creator != nil {
+		resp.CreatedBy = &APIKeyCreatorResponse{
+			ID:        key.Creator.ID.String(),
+			FirstName: key.Creator.FirstName,
+			LastName:  key.Creator.LastName,
+			Email:     key.Creator.Email,
+		}
+	}
+	return resp
+}
+
+// ToAPIKeyResponses converts API keys to APIKeyResponses
+func ToAPIKeyResponses(keys []domain.APIKey) []APIKeyResponse {
+	responses := make([]APIKeyResponse, len(keys))
+	for i := range keys {
+		responses[i] = ToAPIKeyResponse(&keys[i])
+	}
+	return responses
+}
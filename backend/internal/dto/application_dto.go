@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"encoding/json"
 	"job-platform/internal/domain"
 	"strings"
 	"time"
@@ -27,11 +28,6 @@ type UpdateApplicationStatusRequest struct {
 	Reason string `json:"reason"`
 }
 
-// AddApplicationNotesRequest represents a request to add employer notes
-type AddApplicationNotesRequest struct {
-	Notes string `json:"notes" binding:"required"`
-}
-
 // RateApplicantRequest represents a request to rate an applicant
 type RateApplicantRequest struct {
 	Rating int `json:"rating" binding:"required,min=1,max=5"`
@@ -56,6 +52,8 @@ type ApplicantResponse struct {
 type StatusHistoryResponse struct {
 	FromStatus *string    `json:"from_status"`
 	ToStatus   string     `json:"to_status"`
+	FromStage  string     `json:"from_stage,omitempty"`
+	ToStage    string     `json:"to_stage,omitempty"`
 	ChangedBy  *string    `json:"changed_by,omitempty"`
 	Notes      string     `json:"notes,omitempty"`
 	ChangedAt  time.Time  `json:"changed_at"`
@@ -70,11 +68,16 @@ type ApplicationResponse struct {
 	CoverLetter    string                  `json:"cover_letter,omitempty"`
 	ExpectedSalary *int                    `json:"expected_salary,omitempty"`
 	AvailableFrom  *string                 `json:"available_from,omitempty"`
+	Answers        []domain.ScreeningAnswer `json:"answers,omitempty"`
 	Status         string                  `json:"status"`
 	StatusUpdatedAt *time.Time             `json:"status_updated_at,omitempty"`
+	Stage          *PipelineStageResponse  `json:"stage,omitempty"`
 	EmployerNotes  string                  `json:"employer_notes,omitempty"`
 	Rating         *int                    `json:"rating,omitempty"`
+	IsFlagged      bool                    `json:"is_flagged,omitempty"`
+	FlagReason     string                  `json:"flag_reason,omitempty"`
 	StatusHistory  []StatusHistoryResponse `json:"status_history,omitempty"`
+	Feedback       *FeedbackSummaryResponse `json:"feedback,omitempty"`
 	AppliedAt      time.Time               `json:"applied_at"`
 	CreatedAt      time.Time               `json:"created_at"`
 	UpdatedAt      time.Time               `json:"updated_at"`
@@ -117,6 +120,14 @@ func ToApplicationResponse(app *domain.Application, includeJob, includeApplicant
 		response.AvailableFrom = &dateStr
 	}
 
+	// Add screening answers if any
+	if len(app.Answers) > 0 {
+		var answers []domain.ScreeningAnswer
+		if err := json.Unmarshal(app.Answers, &answers); err == nil {
+			response.Answers = answers
+		}
+	}
+
 	// Add employer notes and rating (only for employer view)
 	if app.EmployerNotes != "" {
 		response.EmployerNotes = app.EmployerNotes
@@ -124,6 +135,10 @@ func ToApplicationResponse(app *domain.Application, includeJob, includeApplicant
 	if app.Rating != nil {
 		response.Rating = app.Rating
 	}
+	if app.IsFlagged {
+		response.IsFlagged = true
+		response.FlagReason = app.FlagReason
+	}
 
 	// Add job information if requested
 	if includeJob && app.Job.ID != uuid.Nil {
@@ -131,6 +146,19 @@ func ToApplicationResponse(app *domain.Application, includeJob, includeApplicant
 		response.Job = &jobResp
 	}
 
+	// Add pipeline stage (employer view; custom stage names are internal)
+	if includeApplicant {
+		if app.Stage != nil {
+			stage := ToPipelineStageResponse(app.Stage)
+			response.Stage = &stage
+		} else {
+			response.Stage = &PipelineStageResponse{
+				Name:   domain.DefaultStageName(app.Status),
+				Status: string(app.Status),
+			}
+		}
+	}
+
 	// Add applicant information if requested
 	if includeApplicant && app.Applicant.ID != uuid.Nil {
 		response.Applicant = &ApplicantResponse{
@@ -185,6 +213,10 @@ func ToApplicationResponse(app *domain.Application, includeJob, includeApplicant
 				Notes:      h.Notes,
 				ChangedAt:  h.CreatedAt,
 			}
+			if includeApplicant {
+				history[i].FromStage = h.FromStage
+				history[i].ToStage = h.ToStage
+			}
 		}
 		response.StatusHistory = history
 	}
@@ -54,6 +54,7 @@ type UpdateCompanyRequest struct {
 	Vision             *string               `json:"vision" binding:"omitempty"`
 	CultureDescription *string               `json:"culture_description" binding:"omitempty"`
 	BrandColor         *string               `json:"brand_color" binding:"omitempty,len=7"`
+	RequireJobApproval *bool                 `json:"require_job_approval"` // Recruiter postings need approval from an admin or owner
 }
 
 // ListCompaniesRequest represents the request to list companies
@@ -114,6 +115,7 @@ type CompanyResponse struct {
 	VerifiedAt         *time.Time            `json:"verified_at"`
 	IsFeatured         bool                  `json:"is_featured"`
 	FeaturedUntil      *time.Time            `json:"featured_until"`
+	RequireJobApproval bool                  `json:"require_job_approval"`
 	TotalJobs          int                   `json:"total_jobs"`
 	ActiveJobs         int                   `json:"active_jobs"`
 	TotalEmployees     *int                  `json:"total_employees"`
@@ -198,6 +200,7 @@ func ToCompanyResponse(company *domain.Company) CompanyResponse {
 		VerifiedAt:         company.VerifiedAt,
 		IsFeatured:         company.IsFeatured,
 		FeaturedUntil:      company.FeaturedUntil,
+		RequireJobApproval: company.RequireJobApproval,
 		TotalJobs:          company.TotalJobs,
 		ActiveJobs:         company.ActiveJobs,
 		TotalEmployees:     &company.TotalEmployees,
@@ -0,0 +1,142 @@
+package dto
+
+import (
+	"job-platform/internal/domain"
+	"time"
+)
+
+// ============================================================
+// REQUEST DTOs
+// ============================================================
+
+// CreateInterviewRequest represents a request to schedule an interview for an application.
+// A single slot confirms the interview immediately; several slots let the candidate choose.
+type CreateInterviewRequest struct {
+	Title           string      `json:"title" binding:"required,max=255"`
+	Type            string      `json:"type" binding:"required,oneof=PHONE VIDEO ONSITE"`
+	DurationMinutes int         `json:"duration_minutes" binding:"required,min=15,max=480"`
+	Location        string      `json:"location" binding:"max=500"`
+	MeetingURL      string      `json:"meeting_url" binding:"omitempty,url"`
+	Notes           string      `json:"notes"`
+	Timezone        string      `json:"timezone"` // IANA name, e.g. "Europe/Berlin"; defaults to UTC
+	InterviewerIDs  []string    `json:"interviewer_ids" binding:"omitempty,dive,uuid"`
+	Slots           []time.Time `json:"slots" binding:"required,min=1,max=10"`
+}
+
+// RescheduleInterviewRequest represents a request to propose new times for an interview
+type RescheduleInterviewRequest struct {
+	Slots  []time.Time `json:"slots" binding:"required,min=1,max=10"`
+	Reason string      `json:"reason"`
+}
+
+// CancelInterviewRequest represents a request to cancel an interview
+type CancelInterviewRequest struct {
+	Reason string `json:"reason"`
+}
+
+// SelectInterviewSlotRequest represents a candidate's choice of a proposed slot
+type SelectInterviewSlotRequest struct {
+	SlotID string `json:"slot_id" binding:"required,uuid"`
+}
+
+// ============================================================
+// RESPONSE DTOs
+// ============================================================
+
+// InterviewSlotResponse represents a proposed interview time
+type InterviewSlotResponse struct {
+	ID        string    `json:"id"`
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+}
+
+// InterviewerResponse represents an interviewer
+type InterviewerResponse struct {
+	TeamMemberID string `json:"team_member_id,omitempty"`
+	Name         string `json:"name"`
+	Email        string `json:"email,omitempty"`
+}
+
+// InterviewResponse represents an interview in API responses
+type InterviewResponse struct {
+	ID              string                  `json:"id"`
+	ApplicationID   string                  `json:"application_id"`
+	Title           string                  `json:"title"`
+	Type            string                  `json:"type"`
+	DurationMinutes int                     `json:"duration_minutes"`
+	Location        string                  `json:"location,omitempty"`
+	MeetingURL      string                  `json:"meeting_url,omitempty"`
+	Notes           string                  `json:"notes,omitempty"`
+	Timezone        string                  `json:"timezone"`
+	Status          string                  `json:"status"`
+	ScheduledStart  *time.Time              `json:"scheduled_start,omitempty"`
+	ScheduledEnd    *time.Time              `json:"scheduled_end,omitempty"`
+	Slots           []InterviewSlotResponse `json:"slots"`
+	Interviewers    []InterviewerResponse   `json:"interviewers"`
+	CancelReason    string                  `json:"cancel_reason,omitempty"`
+	CancelledAt     *time.Time              `json:"cancelled_at,omitempty"`
+	CreatedAt       time.Time               `json:"created_at"`
+	UpdatedAt       time.Time               `json:"updated_at"`
+}
+
+// ============================================================
+// HELPER FUNCTIONS
+// ============================================================
+
+// ToInterviewResponse converts a domain.Interview to InterviewResponse.
+// Interviewer contact details are only included in the employer view.
+func ToInterviewResponse(interview *domain.Interview, employerView bool) InterviewResponse {
+	response := InterviewResponse{
+		ID:              interview.ID.String(),
+		ApplicationID:   interview.ApplicationID.String(),
+		Title:           interview.Title,
+		Type:            string(interview.Type),
+		DurationMinutes: interview.DurationMinutes,
+		Location:        interview.Location,
+		MeetingURL:      interview.MeetingURL,
+		Notes:           interview.Notes,
+		Timezone:        interview.Timezone,
+		Status:          string(interview.Status),
+		ScheduledStart:  interview.ScheduledStart,
+		ScheduledEnd:    interview.ScheduledEnd,
+		Slots:           make([]InterviewSlotResponse, 0, len(interview.Slots)),
+		Interviewers:    make([]InterviewerResponse, 0, len(interview.Interviewers)),
+		CancelReason:    interview.CancelReason,
+		CancelledAt:     interview.CancelledAt,
+		CreatedAt:       interview.CreatedAt,
+		UpdatedAt:       interview.UpdatedAt,
+	}
+
+	for _, slot := range interview.Slots {
+		response.Slots = append(response.Slots, InterviewSlotResponse{
+			ID:        slot.ID.String(),
+			StartTime: slot.StartTime,
+			EndTime:   slot.EndTime,
+		})
+	}
+
+	for _, iv := range interview.Interviewers {
+		interviewer := InterviewerResponse{}
+		if iv.User != nil {
+			interviewer.Name = iv.User.FirstName + " " + iv.User.LastName
+		}
+		if employerView {
+			interviewer.TeamMemberID = iv.TeamMemberID.String()
+			if iv.User != nil {
+				interviewer.Email = iv.User.Email
+			}
+		}
+		response.Interviewers = append(response.Interviewers, interviewer)
+	}
+
+	return response
+}
+
+// ToInterviewResponses converts a list of interviews
+func ToInterviewResponses(interviews []domain.Interview, employerView bool) []InterviewResponse {
+	responses := make([]InterviewResponse, len(interviews))
+	for i := range interviews {
+		responses[i] = ToInterviewResponse(&interviews[i], employerView)
+	}
+	return responses
+}
@@ -38,6 +38,27 @@ type CreateJobRequest struct {
 	CategoryIDs        []string `json:"category_ids"`
 	ApplicationURL     string   `json:"application_url"`
 	ApplicationEmail   string   `json:"application_email" binding:"omitempty,email"`
+	ScreeningQuestions []ScreeningQuestionRequest `json:"screening_questions" binding:"omitempty,max=20,dive"`
+	// Hiring (employer only)
+	Openings        int    `json:"openings" binding:"omitempty,min=1,max=1000"` // Defaults to 1
+	RequisitionID   string `json:"requisition_id" binding:"max=100"`
+	HiringManagerID string `json:"hiring_manager_id" binding:"omitempty,uuid"` // Company team member ID
+	// Publishing (employer only)
+	SaveAsDraft bool       `json:"save_as_draft"`
+	PublishAt   *time.Time `json:"publish_at"` // Schedules publishing for a later time
+}
+
+// ScreeningQuestionRequest represents a screening question in a job create/update request
+type ScreeningQuestionRequest struct {
+	ID              *string  `json:"id" binding:"omitempty,uuid"` // Existing question to keep when updating a job
+	Question        string   `json:"question" binding:"required,max=500"`
+	Type            string   `json:"type" binding:"required,oneof=TEXT YES_NO SINGLE_CHOICE MULTI_CHOICE NUMBER"`
+	Options         []string `json:"options" binding:"omitempty,max=50"`
+	MinValue        *float64 `json:"min_value"`
+	MaxValue        *float64 `json:"max_value"`
+	IsRequired      *bool    `json:"is_required"` // Defaults to true
+	KnockoutAction  string   `json:"knockout_action" binding:"omitempty,oneof=NONE FLAG REJECT"`
+	KnockoutAnswers []string `json:"knockout_answers"`
 }
 
 // AdminCreateJobRequest represents a request for admin to create a job
@@ -67,6 +88,7 @@ type AdminCreateJobRequest struct {
 	CategoryIDs        []string `json:"category_ids"`
 	ApplicationURL     string   `json:"application_url"`
 	ApplicationEmail   string   `json:"application_email" binding:"omitempty,email"`
+	ScreeningQuestions []ScreeningQuestionRequest `json:"screening_questions" binding:"omitempty,max=20,dive"`
 	// Admin-specific fields
 	CompanyName    string `json:"company_name" binding:"required,min=2,max=255"`
 	CompanyLogoURL string `json:"company_logo_url"`
@@ -100,6 +122,11 @@ type UpdateJobRequest struct {
 	CategoryIDs        *[]string `json:"category_ids"`
 	ApplicationURL     *string   `json:"application_url"`
 	ApplicationEmail   *string   `json:"application_email" binding:"omitempty,email"`
+	ScreeningQuestions *[]ScreeningQuestionRequest `json:"screening_questions" binding:"omitempty,max=20,dive"`
+	// Hiring
+	Openings        *int    `json:"openings" binding:"omitempty,min=1,max=1000"`
+	RequisitionID   *string `json:"requisition_id" binding:"omitempty,max=100"`
+	HiringManagerID *string `json:"hiring_manager_id"` // Empty string removes the hiring manager
 }
 
 // AdminUpdateJobRequest represents a request for admin to update a job
@@ -129,6 +156,7 @@ type AdminUpdateJobRequest struct {
 	CategoryIDs        *[]string `json:"category_ids"`
 	ApplicationURL     *string   `json:"application_url"`
 	ApplicationEmail   *string   `json:"application_email" binding:"omitempty,email"`
+	ScreeningQuestions *[]ScreeningQuestionRequest `json:"screening_questions" binding:"omitempty,max=20,dive"`
 	// Admin-specific fields
 	CompanyName    *string `json:"company_name" binding:"omitempty,min=2,max=255"`
 	CompanyLogoURL *string `json:"company_logo_url"`
@@ -150,6 +178,16 @@ type RejectJobRequest struct {
 	Reason string `json:"reason" binding:"required,min=10"`
 }
 
+// PublishJobRequest represents a request to publish a draft, now or at a later time
+type PublishJobRequest struct {
+	PublishAt *time.Time `json:"publish_at"`
+}
+
+// RejectJobReviewRequest represents a company reviewer sending a job back to draft
+type RejectJobReviewRequest struct {
+	Note string `json:"note" binding:"required,max=2000"`
+}
+
 // ============================================================
 // SCRAPER DTOs
 // ============================================================
@@ -215,6 +253,9 @@ type BulkScrapeResult struct {
 	Success    bool                `json:"success"`
 	ScrapedJob *ScrapedJobResponse `json:"scraped_job,omitempty"`
 	Error      string              `json:"error,omitempty"`
+
+	// Existing job the scraped job duplicates, if any
+	DuplicateOf *DuplicateMatchResponse `json:"duplicate_of,omitempty"`
 }
 
 // ExtractLinksRequest represents a request to extract job links from a listing page
@@ -321,6 +362,7 @@ type JobResponse struct {
 	IsFeatured       bool               `json:"is_featured"`
 	ViewsCount       int                `json:"views_count"`
 	ApplicationsCount int               `json:"applications_count"`
+	Openings          int                `json:"openings"`
 	ApplicationURL   string             `json:"application_url,omitempty"`
 	ApplicationEmail string             `json:"application_email,omitempty"`
 	OriginalURL      *string            `json:"original_url,omitempty"`
@@ -330,12 +372,50 @@ type JobResponse struct {
 	CreatedAt        time.Time          `json:"created_at"`
 	UpdatedAt        time.Time          `json:"updated_at"`
 
+	ScreeningQuestions []ScreeningQuestionResponse `json:"screening_questions,omitempty"`
+
 	// For authenticated users
 	IsSaved    *bool `json:"is_saved,omitempty"`
 	HasApplied *bool `json:"has_applied,omitempty"`
 
 	// For employers/admin
-	Employer *EmployerResponse `json:"employer,omitempty"`
+	Employer *EmployerResponse   `json:"employer,omitempty"`
+	Hiring   *JobHiringResponse `json:"hiring,omitempty"`
+	Review   *JobReviewResponse `json:"review,omitempty"`
+}
+
+// JobReviewResponse represents the company review of a job, only shown to its employer
+type JobReviewResponse struct {
+	ReviewedBy string     `json:"reviewed_by,omitempty"`
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	Note       string     `json:"note,omitempty"`
+}
+
+// JobHiringResponse represents a job's internal hiring details, only shown to its employer
+type JobHiringResponse struct {
+	RequisitionID string                 `json:"requisition_id,omitempty"`
+	HiringManager *HiringManagerResponse `json:"hiring_manager,omitempty"`
+	FilledAt      *time.Time             `json:"filled_at,omitempty"`
+}
+
+// HiringManagerResponse represents the team member responsible for a job's hire
+type HiringManagerResponse struct {
+	TeamMemberID string `json:"team_member_id"`
+	Name         string `json:"name"`
+	Email        string `json:"email,omitempty"`
+}
+
+// ScreeningQuestionResponse represents a screening question in API responses
+type ScreeningQuestionResponse struct {
+	ID              string   `json:"id"`
+	Question        string   `json:"question"`
+	Type            string   `json:"type"`
+	Options         []string `json:"options,omitempty"`
+	MinValue        *float64 `json:"min_value,omitempty"`
+	MaxValue        *float64 `json:"max_value,omitempty"`
+	IsRequired      bool     `json:"is_required"`
+	KnockoutAction  string   `json:"knockout_action,omitempty"`
+	KnockoutAnswers []string `json:"knockout_answers,omitempty"`
 }
 
 // JobListResponse represents a paginated list of jobs
@@ -405,6 +485,7 @@ func ToJobResponse(job *domain.Job, userID *uuid.UUID) JobResponse {
 		IsFeatured:        job.IsFeatured,
 		ViewsCount:        job.ViewsCount,
 		ApplicationsCount: job.ApplicationsCount,
+		Openings:          job.Openings,
 		ApplicationURL:    job.ApplicationURL,
 		ApplicationEmail:  job.ApplicationEmail,
 		OriginalURL:       job.OriginalURL,
@@ -435,6 +516,27 @@ func ToJobResponse(job *domain.Job, userID *uuid.UUID) JobResponse {
 		response.Categories = categories
 	}
 
+	// Add screening questions
+	if len(job.ScreeningQuestions) > 0 {
+		questions := make([]ScreeningQuestionResponse, len(job.ScreeningQuestions))
+		for i, q := range job.ScreeningQuestions {
+			questions[i] = ScreeningQuestionResponse{
+				ID:         q.ID.String(),
+				Question:   q.Question,
+				Type:       string(q.Type),
+				Options:    q.Options,
+				MinValue:   q.MinValue,
+				MaxValue:   q.MaxValue,
+				IsRequired: q.IsRequired,
+			}
+			if q.HasKnockout() {
+				questions[i].KnockoutAction = string(q.KnockoutAction)
+				questions[i].KnockoutAnswers = q.KnockoutAnswers
+			}
+		}
+		response.ScreeningQuestions = questions
+	}
+
 	// Add employer info if available
 	if job.Employer.ID != uuid.Nil {
 		companyName := job.CompanyName
@@ -453,6 +555,46 @@ func ToJobResponse(job *domain.Job, userID *uuid.UUID) JobResponse {
 	return response
 }
 
+// ToEmployerJobResponse converts a domain.Job to JobResponse including its internal hiring details
+func ToEmployerJobResponse(job *domain.Job) JobResponse {
+	response := ToJobResponse(job, nil)
+	response.Hiring = ToJobHiringResponse(job)
+	if job.ReviewedBy != nil {
+		response.Review = &JobReviewResponse{
+			ReviewedBy: job.ReviewedBy.String(),
+			ReviewedAt: job.ReviewedAt,
+			Note:       job.ReviewNote,
+		}
+	}
+	return response
+}
+
+// ToJobHiringResponse converts a job's requisition and hiring manager to JobHiringResponse
+func ToJobHiringResponse(job *domain.Job) *JobHiringResponse {
+	hiring := &JobHiringResponse{
+		RequisitionID: job.RequisitionID,
+		FilledAt:      job.FilledAt,
+	}
+	if job.HiringManager != nil {
+		hiring.HiringManager = &HiringManagerResponse{
+			TeamMemberID: job.HiringManager.ID.String(),
+		}
+		if job.HiringManager.User != nil {
+			hiring.HiringManager.Name = job.HiringManager.User.FirstName + " " + job.HiringManager.User.LastName
+			hiring.HiringManager.Email = job.HiringManager.User.Email
+		}
+	}
+	return hiring
+}
+
+// HideScreeningRules removes knockout rules so they are not revealed to candidates
+func (r *JobResponse) HideScreeningRules() {
+	for i := range r.ScreeningQuestions {
+		r.ScreeningQuestions[i].KnockoutAction = ""
+		r.ScreeningQuestions[i].KnockoutAnswers = nil
+	}
+}
+
 // ToCategoryResponse converts a domain.JobCategory to CategoryResponse
 func ToCategoryResponse(category *domain.JobCategory) CategoryResponse {
 	response := CategoryResponse{
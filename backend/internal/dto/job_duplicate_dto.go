@@ -0,0 +1,109 @@
+package dto
+
+import (
+	"job-platform/internal/domain"
+	"time"
+)
+
+// ============================================================
+// REQUEST DTOs
+// ============================================================
+
+// MergeDuplicateRequest represents an admin merging two duplicate jobs
+type MergeDuplicateRequest struct {
+	KeepJobID *string `json:"keep_job_id" binding:"omitempty,uuid"` // Defaults to the older job
+}
+
+// ============================================================
+// RESPONSE DTOs
+// ============================================================
+
+// DuplicateMatchResponse represents an existing job that another job duplicates
+type DuplicateMatchResponse struct {
+	JobID string  `json:"job_id"`
+	Title string  `json:"title"`
+	Slug  string  `json:"slug"`
+	Score float64 `json:"score"`
+}
+
+// DuplicateJobSummary represents one side of a duplicate flag
+type DuplicateJobSummary struct {
+	ID          string    `json:"id"`
+	Title       string    `json:"title"`
+	Slug        string    `json:"slug"`
+	CompanyName string    `json:"company_name"`
+	Location    string    `json:"location"`
+	Status      string    `json:"status"`
+	OriginalURL *string   `json:"original_url,omitempty"`
+	IsDeleted   bool      `json:"is_deleted"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// JobDuplicateResponse represents a duplicate flag in API responses
+type JobDuplicateResponse struct {
+	ID          string               `json:"id"`
+	Status      string               `json:"status"`
+	Score       float64              `json:"score"`
+	Job         *DuplicateJobSummary `json:"job,omitempty"`
+	DuplicateOf *DuplicateJobSummary `json:"duplicate_of,omitempty"`
+	ResolvedBy  *string              `json:"resolved_by,omitempty"`
+	ResolvedAt  *time.Time           `json:"resolved_at,omitempty"`
+	CreatedAt   time.Time            `json:"created_at"`
+}
+
+// RebuildFingerprintsResponse represents the result of rebuilding job fingerprints
+type RebuildFingerprintsResponse struct {
+	Fingerprinted int `json:"fingerprinted"`
+}
+
+// ============================================================
+// HELPER FUNCTIONS
+// ============================================================
+
+// ToDuplicateJobSummary converts a domain.Job to DuplicateJobSummary
+func ToDuplicateJobSummary(job *domain.Job) DuplicateJobSummary {
+	return DuplicateJobSummary{
+		ID:          job.ID.String(),
+		Title:       job.Title,
+		Slug:        job.Slug,
+		CompanyName: job.CompanyName,
+		Location:    job.Location,
+		Status:      string(job.Status),
+		OriginalURL: job.OriginalURL,
+		IsDeleted:   job.DeletedAt != nil,
+		CreatedAt:   job.CreatedAt,
+	}
+}
+
+// ToJobDuplicateResponse converts a domain.JobDuplicate to JobDuplicateResponse
+func ToJobDuplicateResponse(flag *domain.JobDuplicate) JobDuplicateResponse {
+	response := JobDuplicateResponse{
+		ID:         flag.ID.String(),
+		Status:     string(flag.Status),
+		Score:      flag.Score,
+		ResolvedAt: flag.ResolvedAt,
+		CreatedAt:  flag.CreatedAt,
+	}
+	if flag.ResolvedBy != nil {
+		resolvedBy := flag.ResolvedBy.String()
+		response.ResolvedBy = &resolvedBy
+	}
+	if flag.Job != nil {
+		job := ToDuplicateJobSummary(flag.Job)
+		response.Job = &job
+	}
+	if flag.DuplicateOf != nil {
+		duplicateOf := ToDuplicateJobSummary(flag.DuplicateOf)
+		response.DuplicateOf = &duplicateOf
+	}
+	return response
+}
+
+// ToJobDuplicateResponses converts a list of duplicate flags
+func ToJobDuplicateResponses(flags []domain.JobDuplicate) []JobDuplicateResponse {
+	responses := make([]JobDuplicateResponse, len(flags))
+	for i := range flags {
+		responses[i] = ToJobDuplicateResponse(&flags[i])
+	}
+	return responses
+}
@@ -0,0 +1,124 @@
+package dto
+
+// ============================================================
+// SCHEMA.ORG JOBPOSTING (JSON-LD)
+// ============================================================
+
+// JobPostingSchema represents a schema.org JobPosting, as read by Google for Jobs
+type JobPostingSchema struct {
+	Context                       string                        `json:"@context"`
+	Type                          string                        `json:"@type"`
+	Title                         string                        `json:"title"`
+	Description                   string                        `json:"description"`
+	Identifier                    *SchemaPropertyValue          `json:"identifier,omitempty"`
+	URL                           string                        `json:"url,omitempty"`
+	DatePosted                    string                        `json:"datePosted"`
+	ValidThrough                  string                        `json:"validThrough,omitempty"`
+	EmploymentType                string                        `json:"employmentType,omitempty"`
+	HiringOrganization            SchemaOrganization            `json:"hiringOrganization"`
+	JobLocation                   *SchemaPlace                  `json:"jobLocation,omitempty"`
+	JobLocationType               string                        `json:"jobLocationType,omitempty"`
+	ApplicantLocationRequirements *SchemaCountry                `json:"applicantLocationRequirements,omitempty"`
+	BaseSalary                    *SchemaMonetaryAmount         `json:"baseSalary,omitempty"`
+	Skills                        string                        `json:"skills,omitempty"`
+	EducationRequirements         string                        `json:"educationRequirements,omitempty"`
+	ExperienceRequirements        *SchemaExperienceRequirements `json:"experienceRequirements,omitempty"`
+	JobBenefits                   string                        `json:"jobBenefits,omitempty"`
+	DirectApply                   bool                          `json:"directApply"`
+}
+
+// SchemaPropertyValue represents a schema.org PropertyValue
+type SchemaPropertyValue struct {
+	Type  string `json:"@type"`
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// SchemaOrganization represents a schema.org Organization
+type SchemaOrganization struct {
+	Type   string `json:"@type"`
+	Name   string `json:"name"`
+	SameAs string `json:"sameAs,omitempty"`
+	Logo   string `json:"logo,omitempty"`
+}
+
+// SchemaPlace represents a schema.org Place
+type SchemaPlace struct {
+	Type    string              `json:"@type"`
+	Address SchemaPostalAddress `json:"address"`
+	Geo     *SchemaGeo          `json:"geo,omitempty"`
+}
+
+// SchemaPostalAddress represents a schema.org PostalAddress
+type SchemaPostalAddress struct {
+	Type            string `json:"@type"`
+	StreetAddress   string `json:"streetAddress,omitempty"`
+	AddressLocality string `json:"addressLocality,omitempty"`
+	AddressRegion   string `json:"addressRegion,omitempty"`
+	AddressCountry  string `json:"addressCountry,omitempty"`
+}
+
+// SchemaGeo represents schema.org GeoCoordinates
+type SchemaGeo struct {
+	Type      string  `json:"@type"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// SchemaCountry represents a schema.org Country
+type SchemaCountry struct {
+	Type string `json:"@type"`
+	Name string `json:"name"`
+}
+
+// SchemaMonetaryAmount represents a schema.org MonetaryAmount
+type SchemaMonetaryAmount struct {
+	Type     string                  `json:"@type"`
+	Currency string                  `json:"currency"`
+	Value    SchemaQuantitativeValue `json:"value"`
+}
+
+// SchemaQuantitativeValue represents a schema.org QuantitativeValue
+type SchemaQuantitativeValue struct {
+	Type     string `json:"@type"`
+	Value    *int   `json:"value,omitempty"`
+	MinValue *int   `json:"minValue,omitempty"`
+	MaxValue *int   `json:"maxValue,omitempty"`
+	UnitText string `json:"unitText"`
+}
+
+// SchemaExperienceRequirements represents schema.org OccupationalExperienceRequirements
+type SchemaExperienceRequirements struct {
+	Type               string `json:"@type"`
+	MonthsOfExperience int    `json:"monthsOfExperience"`
+}
+
+// ============================================================
+// RESPONSE DTOs
+// ============================================================
+
+// StructuredDataIssue represents a problem found when validating a job's structured data
+type StructuredDataIssue struct {
+	Field    string `json:"field"`
+	Severity string `json:"severity"` // error (Google won't show the job) or warning (recommended field)
+	Message  string `json:"message"`
+}
+
+// JobStructuredDataResponse represents the JSON-LD of a job and its validation result
+type JobStructuredDataResponse struct {
+	JobID  string                `json:"job_id"`
+	Slug   string                `json:"slug"`
+	JSONLD *JobPostingSchema     `json:"json_ld"`
+	Valid  bool                  `json:"valid"`
+	Issues []StructuredDataIssue `json:"issues"`
+}
+
+// StructuredDataAuditItem represents an active job with structured data issues
+type StructuredDataAuditItem struct {
+	JobID       string                `json:"job_id"`
+	Title       string                `json:"title"`
+	Slug        string                `json:"slug"`
+	CompanyName string                `json:"company_name"`
+	Valid       bool                  `json:"valid"`
+	Issues      []StructuredDataIssue `json:"issues"`
+}
@@ -0,0 +1,48 @@
+package dto
+
+import (
+	"job-platform/internal/domain"
+	"time"
+)
+
+// JobRevisionResponse represents a job revision and what changed since the revision before it
+type JobRevisionResponse struct {
+	ID             string                  `json:"id"`
+	RevisionNumber int                     `json:"revision_number"`
+	Source         string                  `json:"source"`
+	ChangedBy      string                  `json:"changed_by,omitempty"`
+	ChangedByName  string                  `json:"changed_by_name,omitempty"`
+	RestoredFrom   *int                    `json:"restored_from,omitempty"`
+	Changes        []domain.JobFieldChange `json:"changes"`
+	Snapshot       domain.JobSnapshot      `json:"snapshot"`
+	CreatedAt      time.Time               `json:"created_at"`
+}
+
+// ToJobRevisionResponses converts revisions ordered oldest first to responses ordered newest first,
+// diffing each revision against the one before it
+func ToJobRevisionResponses(revisions []domain.JobRevision) []JobRevisionResponse {
+	responses := make([]JobRevisionResponse, len(revisions))
+	for i := range revisions {
+		revision := &revisions[i]
+		response := JobRevisionResponse{
+			ID:             revision.ID.String(),
+			RevisionNumber: revision.RevisionNumber,
+			Source:         string(revision.Source),
+			RestoredFrom:   revision.RestoredFrom,
+			Changes:        []domain.JobFieldChange{},
+			Snapshot:       revision.Snapshot,
+			CreatedAt:      revision.CreatedAt,
+		}
+		if revision.ChangedBy != nil {
+			response.ChangedBy = revision.ChangedBy.String()
+		}
+		if revision.ChangedByUser != nil {
+			response.ChangedByName = revision.ChangedByUser.FirstName + " " + revision.ChangedByUser.LastName
+		}
+		if i > 0 {
+			response.Changes = revision.Snapshot.Diff(revisions[i-1].Snapshot)
+		}
+		responses[len(revisions)-1-i] = response
+	}
+	return responses
+}
@@ -0,0 +1,26 @@
+package dto
+
+// ============================================================
+// REQUEST DTOs
+// ============================================================
+
+// CreateJobSourceRequest represents a request to register a recurring career-page source
+type CreateJobSourceRequest struct {
+	CompanyID          *string `json:"company_id"`
+	Name               string  `json:"name" binding:"max=255"`
+	URL                string  `json:"url" binding:"required,url"`
+	ExtractionStrategy string  `json:"extraction_strategy" binding:"omitempty,oneof=AUTO LISTING"`
+	CrawlIntervalHours int     `json:"crawl_interval_hours" binding:"omitempty,min=1,max=720"`
+	CloseMissingJobs   *bool   `json:"close_missing_jobs"`
+}
+
+// UpdateJobSourceRequest represents a request to update a job source
+type UpdateJobSourceRequest struct {
+	CompanyID          *string `json:"company_id"`
+	Name               *string `json:"name" binding:"omitempty,max=255"`
+	URL                *string `json:"url" binding:"omitempty,url"`
+	ExtractionStrategy *string `json:"extraction_strategy" binding:"omitempty,oneof=AUTO LISTING"`
+	CrawlIntervalHours *int    `json:"crawl_interval_hours" binding:"omitempty,min=1,max=720"`
+	CloseMissingJobs   *bool   `json:"close_missing_jobs"`
+	IsActive           *bool   `json:"is_active"`
+}
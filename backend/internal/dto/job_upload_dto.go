@@ -0,0 +1,100 @@
+package dto
+
+import (
+	"encoding/json"
+	"job-platform/internal/domain"
+	"time"
+)
+
+// ============================================================
+// RESPONSE DTOs
+// ============================================================
+
+// JobUploadResponse represents a bulk job upload with its validation report
+type JobUploadResponse struct {
+	ID             string                 `json:"id"`
+	Filename       string                 `json:"filename"`
+	Status         string                 `json:"status"`
+	TotalRows      int                    `json:"total_rows"`
+	ValidRows      int                    `json:"valid_rows"`
+	InvalidRows    int                    `json:"invalid_rows"`
+	CreatedJobs    int                    `json:"created_jobs"`
+	FailedRows     int                    `json:"failed_rows"`
+	IgnoredColumns []string               `json:"ignored_columns"`
+	ConfirmedAt    *time.Time             `json:"confirmed_at,omitempty"`
+	CompletedAt    *time.Time             `json:"completed_at,omitempty"`
+	CreatedAt      time.Time              `json:"created_at"`
+	Rows           []JobUploadRowResponse `json:"rows,omitempty"`
+}
+
+// JobUploadRowResponse represents the validation and creation result of a row of an upload
+type JobUploadRowResponse struct {
+	RowNumber int                          `json:"row_number"`
+	Title     string                       `json:"title"`
+	Status    string                       `json:"status"`
+	Errors    []domain.JobUploadFieldError `json:"errors,omitempty"`
+	Error     string                       `json:"error,omitempty"`
+	JobID     *string                      `json:"job_id,omitempty"`
+}
+
+// ============================================================
+// HELPER FUNCTIONS
+// ============================================================
+
+// ToJobUploadResponse converts a domain.JobUpload to JobUploadResponse
+func ToJobUploadResponse(upload *domain.JobUpload) JobUploadResponse {
+	ignored := []string(upload.IgnoredColumns)
+	if ignored == nil {
+		ignored = []string{}
+	}
+
+	resp := JobUploadResponse{
+		ID:             upload.ID.String(),
+		Filename:       upload.Filename,
+		Status:         string(upload.Status),
+		TotalRows:      upload.TotalRows,
+		ValidRows:      upload.ValidRows,
+		InvalidRows:    upload.TotalRows - upload.ValidRows,
+		CreatedJobs:    upload.CreatedJobs,
+		FailedRows:     upload.FailedRows,
+		IgnoredColumns: ignored,
+		ConfirmedAt:    upload.ConfirmedAt,
+		CompletedAt:    upload.CompletedAt,
+		CreatedAt:      upload.CreatedAt,
+	}
+
+	if len(upload.Rows) > 0 {
+		resp.Rows = make([]JobUploadRowResponse, len(upload.Rows))
+		for i := range upload.Rows {
+			resp.Rows[i] = ToJobUploadRowResponse(&upload.Rows[i])
+		}
+	}
+	return resp
+}
+
+// ToJobUploadResponses converts uploads to JobUploadResponses
+func ToJobUploadResponses(uploads []domain.JobUpload) []JobUploadResponse {
+	responses := make([]JobUploadResponse, len(uploads))
+	for i := range uploads {
+		responses[i] = ToJobUploadResponse(&uploads[i])
+	}
+	return responses
+}
+
+// ToJobUploadRowResponse converts a domain.JobUploadRow to JobUploadRowResponse
+func ToJobUploadRowResponse(row *domain.JobUploadRow) JobUploadRowResponse {
+	resp := JobUploadRowResponse{
+		RowNumber: row.RowNumber,
+		Title:     row.Title,
+		Status:    string(row.Status),
+		Error:     row.Error,
+	}
+	if len(row.Errors) > 0 {
+		_ = json.Unmarshal(row.Errors, &resp.Errors)
+	}
+	if row.JobID != nil {
+		jobID := row.JobID.String()
+		resp.JobID = &jobID
+	}
+	return resp
+}
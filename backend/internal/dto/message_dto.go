@@ -0,0 +1,136 @@
+package dto
+
+import (
+	"job-platform/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ============================================================
+// REQUEST DTOs
+// ============================================================
+
+// StartConversationRequest represents a request to open the conversation of an application
+type StartConversationRequest struct {
+	ApplicationID string `json:"application_id" binding:"required,uuid"`
+}
+
+// ============================================================
+// RESPONSE DTOs
+// ============================================================
+
+// ConversationParticipantResponse represents the other side of a conversation
+type ConversationParticipantResponse struct {
+	ID        string `json:"id"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Role      string `json:"role"`
+}
+
+// ConversationResponse represents a conversation about an application
+type ConversationResponse struct {
+	ID            string                           `json:"id"`
+	ApplicationID string                           `json:"application_id"`
+	JobID         string                           `json:"job_id,omitempty"`
+	JobTitle      string                           `json:"job_title,omitempty"`
+	Participant   *ConversationParticipantResponse `json:"participant,omitempty"`
+	LastMessage   *MessageResponse                 `json:"last_message,omitempty"`
+	UnreadCount   int64                            `json:"unread_count"`
+	LastMessageAt *time.Time                       `json:"last_message_at,omitempty"`
+	CreatedAt     time.Time                        `json:"created_at"`
+}
+
+// MessageAttachmentResponse represents a file attached to a message
+type MessageAttachmentResponse struct {
+	ID       string `json:"id"`
+	FileName string `json:"file_name"`
+	FileSize int64  `json:"file_size"`
+	MimeType string `json:"mime_type"`
+}
+
+// MessageResponse represents a message
+type MessageResponse struct {
+	ID             string                      `json:"id"`
+	ConversationID string                      `json:"conversation_id"`
+	SenderID       string                      `json:"sender_id"`
+	SenderName     string                      `json:"sender_name,omitempty"`
+	IsMine         bool                        `json:"is_mine"`
+	Body           string                      `json:"body"`
+	Attachments    []MessageAttachmentResponse `json:"attachments"`
+	IsRead         bool                        `json:"is_read"`
+	ReadAt         *time.Time                  `json:"read_at,omitempty"`
+	CreatedAt      time.Time                   `json:"created_at"`
+}
+
+// MessageAttachmentDownloadResponse represents a short-lived attachment download link
+type MessageAttachmentDownloadResponse struct {
+	DownloadURL string    `json:"download_url"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	FileName    string    `json:"file_name"`
+}
+
+// ============================================================
+// HELPER FUNCTIONS
+// ============================================================
+
+// ToConversationResponse converts a domain.Conversation to ConversationResponse for the given viewer
+func ToConversationResponse(conversation *domain.Conversation, viewerID uuid.UUID) ConversationResponse {
+	response := ConversationResponse{
+		ID:            conversation.ID.String(),
+		ApplicationID: conversation.ApplicationID.String(),
+		UnreadCount:   conversation.UnreadCount,
+		LastMessageAt: conversation.LastMessageAt,
+		CreatedAt:     conversation.CreatedAt,
+	}
+	if conversation.Application != nil {
+		response.JobID = conversation.Application.JobID.String()
+		response.JobTitle = conversation.Application.Job.Title
+	}
+
+	other := conversation.Employer
+	if viewerID == conversation.EmployerID {
+		other = conversation.Candidate
+	}
+	if other != nil {
+		response.Participant = &ConversationParticipantResponse{
+			ID:        other.ID.String(),
+			FirstName: other.FirstName,
+			LastName:  other.LastName,
+			Role:      string(other.Role),
+		}
+	}
+
+	if conversation.LastMessage != nil {
+		last := ToMessageResponse(conversation.LastMessage, viewerID)
+		response.LastMessage = &last
+	}
+	return response
+}
+
+// ToMessageResponse converts a domain.Message to MessageResponse for the given viewer
+func ToMessageResponse(message *domain.Message, viewerID uuid.UUID) MessageResponse {
+	response := MessageResponse{
+		ID:             message.ID.String(),
+		ConversationID: message.ConversationID.String(),
+		SenderID:       message.SenderID.String(),
+		IsMine:         message.SenderID == viewerID,
+		Body:           message.Body,
+		Attachments:    make([]MessageAttachmentResponse, len(message.Attachments)),
+		IsRead:         message.IsRead(),
+		ReadAt:         message.ReadAt,
+		CreatedAt:      message.CreatedAt,
+	}
+	if message.Sender != nil {
+		response.SenderName = message.Sender.FirstName + " " + message.Sender.LastName
+	}
+	for i, a := range message.Attachments {
+		response.Attachments[i] = MessageAttachmentResponse{
+			ID:       a.ID.String(),
+			FileName: a.FileName,
+			FileSize: a.FileSize,
+			MimeType: a.MimeType,
+		}
+	}
+	return response
+}
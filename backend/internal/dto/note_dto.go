@@ -0,0 +1,111 @@
+package dto
+
+import (
+	"job-platform/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ============================================================
+// REQUEST DTOs
+// ============================================================
+
+// CreateNoteRequest represents a request to add a note or reply
+type CreateNoteRequest struct {
+	Content          string   `json:"content" binding:"max=5000"`
+	Note             string   `json:"note" binding:"max=5000"`  // Alias of content sent by older clients
+	Notes            string   `json:"notes" binding:"max=5000"` // Alias sent to the legacy PATCH notes endpoint
+	ParentID         *string  `json:"parent_id" binding:"omitempty,uuid"`
+	MentionedUserIDs []string `json:"mentioned_user_ids" binding:"max=20,dive,uuid"`
+}
+
+// GetContent returns the note content, accepting the legacy "note" and "notes" fields
+func (r *CreateNoteRequest) GetContent() string {
+	if r.Content != "" {
+		return r.Content
+	}
+	if r.Note != "" {
+		return r.Note
+	}
+	return r.Notes
+}
+
+// UpdateNoteRequest represents a request to edit a note
+type UpdateNoteRequest struct {
+	Content          string   `json:"content" binding:"required,max=5000"`
+	MentionedUserIDs []string `json:"mentioned_user_ids" binding:"max=20,dive,uuid"`
+}
+
+// ============================================================
+// RESPONSE DTOs
+// ============================================================
+
+// NoteMentionResponse represents a team member mentioned in a note
+type NoteMentionResponse struct {
+	UserID string `json:"user_id"`
+	Name   string `json:"name"`
+}
+
+// NoteResponse represents a note with its replies
+type NoteResponse struct {
+	ID         string                `json:"id"`
+	ParentID   *string               `json:"parent_id,omitempty"`
+	Content    string                `json:"content"`
+	AuthorID   string                `json:"author_id"`
+	AuthorName string                `json:"author_name"`
+	Mentions   []NoteMentionResponse `json:"mentions"`
+	Replies    []NoteResponse        `json:"replies,omitempty"`
+	IsOwn      bool                  `json:"is_own"` // The current user wrote the note and may edit or delete it
+	IsEdited   bool                  `json:"is_edited"`
+	EditedAt   *time.Time            `json:"edited_at,omitempty"`
+	CreatedAt  time.Time             `json:"created_at"`
+}
+
+// ============================================================
+// HELPER FUNCTIONS
+// ============================================================
+
+// ToNoteResponse converts a domain.Note to NoteResponse for the given viewer
+func ToNoteResponse(note *domain.Note, viewerID uuid.UUID) NoteResponse {
+	response := NoteResponse{
+		ID:        note.ID.String(),
+		Content:   note.Content,
+		AuthorID:  note.AuthorID.String(),
+		Mentions:  make([]NoteMentionResponse, 0, len(note.Mentions)),
+		IsOwn:     note.IsAuthor(viewerID),
+		IsEdited:  note.EditedAt != nil,
+		EditedAt:  note.EditedAt,
+		CreatedAt: note.CreatedAt,
+	}
+	if note.Author != nil {
+		response.AuthorName = note.Author.FirstName + " " + note.Author.LastName
+	}
+	if note.ParentID != nil {
+		parentID := note.ParentID.String()
+		response.ParentID = &parentID
+	}
+	for _, m := range note.Mentions {
+		mention := NoteMentionResponse{UserID: m.UserID.String()}
+		if m.User != nil {
+			mention.Name = m.User.FirstName + " " + m.User.LastName
+		}
+		response.Mentions = append(response.Mentions, mention)
+	}
+	if len(note.Replies) > 0 {
+		response.Replies = make([]NoteResponse, len(note.Replies))
+		for i := range note.Replies {
+			response.Replies[i] = ToNoteResponse(&note.Replies[i], viewerID)
+		}
+	}
+	return response
+}
+
+// ToNotesResponse converts note threads to responses for the given viewer
+func ToNotesResponse(notes []domain.Note, viewerID uuid.UUID) []NoteResponse {
+	responses := make([]NoteResponse, len(notes))
+	for i := range notes {
+		responses[i] = ToNoteResponse(&notes[i], viewerID)
+	}
+	return responses
+}
@@ -0,0 +1,104 @@
+package dto
+
+import (
+	"job-platform/internal/domain"
+	"time"
+)
+
+// ============================================================
+// REQUEST DTOs
+// ============================================================
+
+// OfferRequest represents the terms of an offer when creating or updating a draft
+type OfferRequest struct {
+	Salary         int        `json:"salary" binding:"required,min=1"`
+	SalaryCurrency string     `json:"salary_currency" binding:"omitempty,len=3"`
+	SalaryPeriod   string     `json:"salary_period" binding:"omitempty,oneof=HOURLY DAILY WEEKLY MONTHLY YEARLY"`
+	StartDate      string     `json:"start_date" binding:"required"` // YYYY-MM-DD
+	ExpiresAt      *time.Time `json:"expires_at"`
+	Message        string     `json:"message" binding:"max=5000"`
+}
+
+// DeclineOfferRequest represents a candidate declining an offer
+type DeclineOfferRequest struct {
+	Reason string `json:"reason" binding:"max=2000"`
+}
+
+// ============================================================
+// RESPONSE DTOs
+// ============================================================
+
+// OfferResponse represents an offer in API responses
+type OfferResponse struct {
+	ID             string     `json:"id"`
+	ApplicationID  string     `json:"application_id"`
+	JobID          string     `json:"job_id,omitempty"`
+	JobTitle       string     `json:"job_title,omitempty"`
+	CompanyName    string     `json:"company_name,omitempty"`
+	CandidateName  string     `json:"candidate_name,omitempty"`
+	Status         string     `json:"status"`
+	IsExpired      bool       `json:"is_expired"`
+	Salary         int        `json:"salary"`
+	SalaryCurrency string     `json:"salary_currency"`
+	SalaryPeriod   string     `json:"salary_period"`
+	StartDate      string     `json:"start_date"`
+	ExpiresAt      *time.Time `json:"expires_at,omitempty"`
+	Message        string     `json:"message,omitempty"`
+	HasDocument    bool       `json:"has_document"`
+	DocumentName   string     `json:"document_name,omitempty"`
+	SentAt         *time.Time `json:"sent_at,omitempty"`
+	RespondedAt    *time.Time `json:"responded_at,omitempty"`
+	DeclineReason  string     `json:"decline_reason,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// OfferLetterDownloadResponse represents a short-lived offer letter download link
+type OfferLetterDownloadResponse struct {
+	DownloadURL string    `json:"download_url"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	FileName    string    `json:"file_name"`
+}
+
+// ============================================================
+// HELPER FUNCTIONS
+// ============================================================
+
+// ToOfferResponse converts a domain.Offer to OfferResponse
+func ToOfferResponse(offer *domain.Offer) OfferResponse {
+	response := OfferResponse{
+		ID:             offer.ID.String(),
+		ApplicationID:  offer.ApplicationID.String(),
+		Status:         string(offer.Status),
+		IsExpired:      offer.IsExpired(),
+		Salary:         offer.Salary,
+		SalaryCurrency: offer.SalaryCurrency,
+		SalaryPeriod:   offer.SalaryPeriod,
+		StartDate:      offer.StartDate.Format("2006-01-02"),
+		ExpiresAt:      offer.ExpiresAt,
+		Message:        offer.Message,
+		HasDocument:    offer.HasDocument(),
+		DocumentName:   offer.DocumentName,
+		SentAt:         offer.SentAt,
+		RespondedAt:    offer.RespondedAt,
+		DeclineReason:  offer.DeclineReason,
+		CreatedAt:      offer.CreatedAt,
+		UpdatedAt:      offer.UpdatedAt,
+	}
+	if offer.Application != nil {
+		response.JobID = offer.Application.JobID.String()
+		response.JobTitle = offer.Application.Job.Title
+		response.CompanyName = offer.Application.Job.CompanyName
+		response.CandidateName = offer.Application.Applicant.FirstName + " " + offer.Application.Applicant.LastName
+	}
+	return response
+}
+
+// ToOfferResponses converts a list of offers
+func ToOfferResponses(offers []domain.Offer) []OfferResponse {
+	responses := make([]OfferResponse, len(offers))
+	for i := range offers {
+		responses[i] = ToOfferResponse(&offers[i])
+	}
+	return responses
+}
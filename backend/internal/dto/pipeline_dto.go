@@ -0,0 +1,100 @@
+package dto
+
+import (
+	"job-platform/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// ============================================================
+// REQUEST DTOs
+// ============================================================
+
+// PipelineStageRequest represents a stage in a pipeline update request
+type PipelineStageRequest struct {
+	ID     *string `json:"id" binding:"omitempty,uuid"` // Set to keep an existing stage (and its applications)
+	Name   string  `json:"name" binding:"required,max=100"`
+	Status string  `json:"status" binding:"required,oneof=SUBMITTED REVIEWED SHORTLISTED INTERVIEW OFFERED HIRED"`
+}
+
+// UpdatePipelineRequest represents a request to replace a company's pipeline stages
+type UpdatePipelineRequest struct {
+	Stages []PipelineStageRequest `json:"stages" binding:"required,min=2,max=20,dive"`
+}
+
+// MoveApplicationRequest represents a request to move an application to another stage.
+// Either stage_id (custom pipeline stage) or status (first stage of that status, or REJECTED) is required.
+type MoveApplicationRequest struct {
+	StageID string `json:"stage_id" binding:"omitempty,uuid"`
+	Status  string `json:"status" binding:"omitempty,oneof=SUBMITTED REVIEWED SHORTLISTED INTERVIEW OFFERED HIRED REJECTED"`
+	Reason  string `json:"reason"`
+}
+
+// BulkMoveApplicationsRequest represents a request to move several applications to the same stage
+type BulkMoveApplicationsRequest struct {
+	ApplicationIDs []string `json:"application_ids" binding:"required,min=1,max=100,dive,uuid"`
+	StageID        string   `json:"stage_id" binding:"omitempty,uuid"`
+	Status         string   `json:"status" binding:"omitempty,oneof=SUBMITTED REVIEWED SHORTLISTED INTERVIEW OFFERED HIRED REJECTED"`
+	Reason         string   `json:"reason"`
+}
+
+// ============================================================
+// RESPONSE DTOs
+// ============================================================
+
+// PipelineStageResponse represents a pipeline stage in API responses
+type PipelineStageResponse struct {
+	ID       *string `json:"id"` // Null for stages of the default pipeline
+	Name     string  `json:"name"`
+	Status   string  `json:"status"`
+	Position int     `json:"position"`
+	Count    *int64  `json:"count,omitempty"`
+}
+
+// PipelineResponse represents a company's hiring pipeline
+type PipelineResponse struct {
+	IsCustom bool                    `json:"is_custom"`
+	Stages   []PipelineStageResponse `json:"stages"`
+}
+
+// BulkMoveFailureResponse represents an application that could not be moved
+type BulkMoveFailureResponse struct {
+	ApplicationID string `json:"application_id"`
+	Error         string `json:"error"`
+}
+
+// BulkMoveApplicationsResponse represents the outcome of a bulk move
+type BulkMoveApplicationsResponse struct {
+	Moved  []string                  `json:"moved"`
+	Failed []BulkMoveFailureResponse `json:"failed"`
+}
+
+// ============================================================
+// HELPER FUNCTIONS
+// ============================================================
+
+// ToPipelineStageResponse converts a domain.PipelineStage to PipelineStageResponse
+func ToPipelineStageResponse(stage *domain.PipelineStage) PipelineStageResponse {
+	response := PipelineStageResponse{
+		Name:     stage.Name,
+		Status:   string(stage.Status),
+		Position: stage.Position,
+	}
+	if stage.ID != uuid.Nil {
+		id := stage.ID.String()
+		response.ID = &id
+	}
+	return response
+}
+
+// ToPipelineResponse converts a domain.Pipeline to PipelineResponse
+func ToPipelineResponse(pipeline *domain.Pipeline) PipelineResponse {
+	stages := make([]PipelineStageResponse, len(pipeline.Stages))
+	for i := range pipeline.Stages {
+		stages[i] = ToPipelineStageResponse(&pipeline.Stages[i])
+	}
+	return PipelineResponse{
+		IsCustom: pipeline.IsCustom,
+		Stages:   stages,
+	}
+}
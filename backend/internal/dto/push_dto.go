@@ -0,0 +1,69 @@
+package dto
+
+import (
+	"job-platform/internal/domain"
+	"time"
+)
+
+// ============================================================
+// REQUEST DTOs
+// ============================================================
+
+// PushSubscriptionKeys represents the keys of a browser push subscription
+type PushSubscriptionKeys struct {
+	P256dh string `json:"p256dh" binding:"required"`
+	Auth   string `json:"auth" binding:"required"`
+}
+
+// SubscribePushRequest represents a browser push subscription, as serialized by PushSubscription.toJSON()
+type SubscribePushRequest struct {
+	Endpoint string               `json:"endpoint" binding:"required,url"`
+	Keys     PushSubscriptionKeys `json:"keys" binding:"required"`
+}
+
+// ============================================================
+// RESPONSE DTOs
+// ============================================================
+
+// PushSubscriptionResponse represents a subscribed browser in API responses
+type PushSubscriptionResponse struct {
+	ID            string     `json:"id"`
+	UserAgent     string     `json:"user_agent"`
+	LastSuccessAt *time.Time `json:"last_success_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// VAPIDPublicKeyResponse holds the key browsers pass as applicationServerKey when subscribing
+type VAPIDPublicKeyResponse struct {
+	PublicKey string `json:"public_key"`
+}
+
+// TestPushResponse reports how many browsers a test message reached
+type TestPushResponse struct {
+	Sent int `json:"sent"`
+}
+
+// ============================================================
+// HELPER FUNCTIONS
+// ============================================================
+
+// ToPushSubscriptionResponse converts a domain.PushSubscription to PushSubscriptionResponse
+func ToPushSubscriptionResponse(sub *domain.PushSubscription) PushSubscriptionResponse {
+	return PushSubscriptionResponse{
+		ID:            sub.ID.String(),
+		UserAgent:     sub.UserAgent,
+		LastSuccessAt: sub.LastSuccessAt,
+		CreatedAt:     sub.CreatedAt,
+		UpdatedAt:     sub.UpdatedAt,
+	}
+}
+
+// ToPushSubscriptionResponses converts push subscriptions to PushSubscriptionResponses
+func ToPushSubscriptionResponses(subs []domain.PushSubscription) []PushSubscriptionResponse {
+	responses := make([]PushSubscriptionResponse, len(subs))
+	for i := range subs {
+		responses[i] = ToPushSubscriptionResponse(&subs[i])
+	}
+	return responses
+}
@@ -0,0 +1,128 @@
+package dto
+
+import (
+	"job-platform/internal/domain"
+	"time"
+)
+
+// ============================================================
+// REQUEST DTOs
+// ============================================================
+
+// SavedSearchFiltersRequest represents the job search filters of a saved search.
+// Field names match the query parameters of GET /api/v1/jobs.
+type SavedSearchFiltersRequest struct {
+	Query            string   `json:"q" binding:"max=255"`
+	Location         string   `json:"location" binding:"max=255"`
+	Category         string   `json:"category" binding:"max=100"`
+	JobTypes         []string `json:"job_type" binding:"omitempty,dive,oneof=FULL_TIME PART_TIME CONTRACT FREELANCE INTERNSHIP"`
+	ExperienceLevels []string `json:"experience_level" binding:"omitempty,dive,oneof=ENTRY MID SENIOR LEAD EXECUTIVE"`
+	WorkplaceTypes   []string `json:"workplace_type" binding:"omitempty,dive,oneof=ONSITE REMOTE HYBRID"`
+	SalaryMin        *int     `json:"salary_min" binding:"omitempty,min=0"`
+	SalaryMax        *int     `json:"salary_max" binding:"omitempty,min=0"`
+}
+
+// CreateSavedSearchRequest represents a request to save a job search
+type CreateSavedSearchRequest struct {
+	Name         string                    `json:"name" binding:"max=255"`
+	Filters      SavedSearchFiltersRequest `json:"filters"`
+	Frequency    string                    `json:"frequency" binding:"omitempty,oneof=INSTANT DAILY WEEKLY"`
+	EmailEnabled *bool                     `json:"email_enabled"`
+}
+
+// UpdateSavedSearchRequest represents a request to update a saved search
+type UpdateSavedSearchRequest struct {
+	Name         *string                    `json:"name" binding:"omitempty,max=255"`
+	Filters      *SavedSearchFiltersRequest `json:"filters"`
+	Frequency    *string                    `json:"frequency" binding:"omitempty,oneof=INSTANT DAILY WEEKLY"`
+	EmailEnabled *bool                      `json:"email_enabled"`
+	IsActive     *bool                      `json:"is_active"`
+}
+
+// ToDomain converts the request to saved search filters
+func (r SavedSearchFiltersRequest) ToDomain() domain.SavedSearchFilters {
+	filters := domain.SavedSearchFilters{
+		Query:        r.Query,
+		Location:     r.Location,
+		CategorySlug: r.Category,
+		SalaryMin:    r.SalaryMin,
+		SalaryMax:    r.SalaryMax,
+	}
+	for _, jt := range r.JobTypes {
+		filters.JobTypes = append(filters.JobTypes, domain.JobType(jt))
+	}
+	for _, el := range r.ExperienceLevels {
+		filters.ExperienceLevels = append(filters.ExperienceLevels, domain.ExperienceLevel(el))
+	}
+	for _, wt := range r.WorkplaceTypes {
+		filters.WorkplaceTypes = append(filters.WorkplaceTypes, domain.WorkplaceType(wt))
+	}
+	return filters
+}
+
+// ============================================================
+// RESPONSE DTOs
+// ============================================================
+
+// SavedSearchResponse represents a saved search in API responses
+type SavedSearchResponse struct {
+	ID             string                    `json:"id"`
+	Name           string                    `json:"name"`
+	Filters        domain.SavedSearchFilters `json:"filters"`
+	Frequency      string                    `json:"frequency"`
+	EmailEnabled   bool                      `json:"email_enabled"`
+	IsActive       bool                      `json:"is_active"`
+	SearchURL      string                    `json:"search_url"`
+	LastNotifiedAt *time.Time                `json:"last_notified_at,omitempty"`
+	CreatedAt      time.Time                 `json:"created_at"`
+	UpdatedAt      time.Time                 `json:"updated_at"`
+}
+
+// SavedSearchMatchResponse represents a job matched for a saved search
+type SavedSearchMatchResponse struct {
+	Job        JobResponse `json:"job"`
+	MatchedAt  time.Time   `json:"matched_at"`
+	NotifiedAt *time.Time  `json:"notified_at,omitempty"`
+}
+
+// UnsubscribeSavedSearchResponse confirms that job alert emails of a saved search were turned off
+type UnsubscribeSavedSearchResponse struct {
+	Name string `json:"name"`
+}
+
+// ============================================================
+// HELPER FUNCTIONS
+// ============================================================
+
+// ToSavedSearchResponse converts a domain.SavedSearch to SavedSearchResponse.
+// searchURL is the frontend job list showing the results of the search.
+func ToSavedSearchResponse(search *domain.SavedSearch, searchURL string) SavedSearchResponse {
+	return SavedSearchResponse{
+		ID:             search.ID.String(),
+		Name:           search.Name,
+		Filters:        search.Filters,
+		Frequency:      string(search.Frequency),
+		EmailEnabled:   search.EmailEnabled,
+		IsActive:       search.IsActive,
+		SearchURL:      searchURL,
+		LastNotifiedAt: search.LastNotifiedAt,
+		CreatedAt:      search.CreatedAt,
+		UpdatedAt:      search.UpdatedAt,
+	}
+}
+
+// ToSavedSearchMatchResponses converts saved search matches to SavedSearchMatchResponses
+func ToSavedSearchMatchResponses(matches []domain.SavedSearchMatch) []SavedSearchMatchResponse {
+	responses := make([]SavedSearchMatchResponse, 0, len(matches))
+	for _, match := range matches {
+		if match.Job == nil {
+			continue
+		}
+		responses = append(responses, SavedSearchMatchResponse{
+			Job:        ToJobResponse(match.Job, nil),
+			MatchedAt:  match.MatchedAt,
+			NotifiedAt: match.NotifiedAt,
+		})
+	}
+	return responses
+}
@@ -0,0 +1,194 @@
+package dto
+
+import (
+	"job-platform/internal/domain"
+	"time"
+)
+
+// ============================================================
+// REQUEST DTOs
+// ============================================================
+
+// ScorecardCriterionRequest represents a criterion in a criteria update request
+type ScorecardCriterionRequest struct {
+	ID          *string `json:"id" binding:"omitempty,uuid"` // Set to keep an existing criterion (and its ratings)
+	Name        string  `json:"name" binding:"required,max=100"`
+	Description string  `json:"description" binding:"max=500"`
+}
+
+// UpdateScorecardCriteriaRequest represents a request to replace a job's scorecard criteria
+type UpdateScorecardCriteriaRequest struct {
+	Criteria []ScorecardCriterionRequest `json:"criteria" binding:"max=15,dive"`
+}
+
+// ScorecardRatingRequest represents the rating of one criterion
+type ScorecardRatingRequest struct {
+	CriterionID string `json:"criterion_id" binding:"required,uuid"`
+	Rating      int    `json:"rating" binding:"required,min=1,max=5"`
+	Comment     string `json:"comment"`
+}
+
+// SaveScorecardRequest represents a request to save (and optionally submit) the reviewer's scorecard
+type SaveScorecardRequest struct {
+	Ratings        []ScorecardRatingRequest `json:"ratings" binding:"dive"`
+	Recommendation string                   `json:"recommendation" binding:"omitempty,oneof=STRONG_NO_HIRE NO_HIRE HIRE STRONG_HIRE"`
+	Summary        string                   `json:"summary"`
+	Submit         bool                     `json:"submit"`
+}
+
+// ============================================================
+// RESPONSE DTOs
+// ============================================================
+
+// ScorecardCriterionResponse represents a scorecard criterion
+type ScorecardCriterionResponse struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Position    int    `json:"position"`
+}
+
+// ScorecardRatingResponse represents a criterion rating
+type ScorecardRatingResponse struct {
+	CriterionID   *string `json:"criterion_id,omitempty"`
+	CriterionName string  `json:"criterion_name"`
+	Rating        int     `json:"rating"`
+	Comment       string  `json:"comment,omitempty"`
+}
+
+// ScorecardResponse represents a reviewer's scorecard
+type ScorecardResponse struct {
+	ID             string                    `json:"id"`
+	ReviewerID     string                    `json:"reviewer_id"`
+	ReviewerName   string                    `json:"reviewer_name,omitempty"`
+	Recommendation string                    `json:"recommendation,omitempty"`
+	Summary        string                    `json:"summary,omitempty"`
+	Status         string                    `json:"status"`
+	AverageRating  *float64                  `json:"average_rating,omitempty"`
+	Ratings        []ScorecardRatingResponse `json:"ratings"`
+	SubmittedAt    *time.Time                `json:"submitted_at,omitempty"`
+	UpdatedAt      time.Time                 `json:"updated_at"`
+}
+
+// CriterionSummaryResponse represents the aggregated ratings of one criterion
+type CriterionSummaryResponse struct {
+	CriterionID   *string `json:"criterion_id,omitempty"`
+	Name          string  `json:"name"`
+	AverageRating float64 `json:"average_rating"`
+	Count         int     `json:"count"`
+}
+
+// FeedbackSummaryResponse represents aggregated feedback on an application
+type FeedbackSummaryResponse struct {
+	SubmittedCount  int                        `json:"submitted_count"`
+	DraftCount      int                        `json:"draft_count"`
+	AverageRating   *float64                   `json:"average_rating,omitempty"`
+	Recommendations map[string]int             `json:"recommendations"`
+	Criteria        []CriterionSummaryResponse `json:"criteria"`
+}
+
+// ApplicationFeedbackResponse represents the feedback on an application visible to the current reviewer
+type ApplicationFeedbackResponse struct {
+	Criteria    []ScorecardCriterionResponse `json:"criteria"`
+	MyScorecard *ScorecardResponse           `json:"my_scorecard"`
+	Scorecards  []ScorecardResponse          `json:"scorecards"`
+	Summary     *FeedbackSummaryResponse     `json:"summary"`
+	HiddenCount int                          `json:"hidden_count"` // Submit your own scorecard to see these
+}
+
+// ============================================================
+// HELPER FUNCTIONS
+// ============================================================
+
+// ToScorecardCriteriaResponse converts scorecard criteria to responses
+func ToScorecardCriteriaResponse(criteria []domain.ScorecardCriterion) []ScorecardCriterionResponse {
+	responses := make([]ScorecardCriterionResponse, len(criteria))
+	for i, c := range criteria {
+		responses[i] = ScorecardCriterionResponse{
+			ID:          c.ID.String(),
+			Name:        c.Name,
+			Description: c.Description,
+			Position:    c.Position,
+		}
+	}
+	return responses
+}
+
+// ToScorecardResponse converts a domain.Scorecard to ScorecardResponse
+func ToScorecardResponse(scorecard *domain.Scorecard) ScorecardResponse {
+	response := ScorecardResponse{
+		ID:             scorecard.ID.String(),
+		ReviewerID:     scorecard.ReviewerID.String(),
+		Recommendation: string(scorecard.Recommendation),
+		Summary:        scorecard.Summary,
+		Status:         string(scorecard.Status),
+		AverageRating:  scorecard.AverageRating(),
+		Ratings:        make([]ScorecardRatingResponse, 0, len(scorecard.Ratings)),
+		SubmittedAt:    scorecard.SubmittedAt,
+		UpdatedAt:      scorecard.UpdatedAt,
+	}
+	if scorecard.Reviewer != nil {
+		response.ReviewerName = scorecard.Reviewer.FirstName + " " + scorecard.Reviewer.LastName
+	}
+	for _, r := range scorecard.Ratings {
+		rating := ScorecardRatingResponse{
+			CriterionName: r.CriterionName,
+			Rating:        r.Rating,
+			Comment:       r.Comment,
+		}
+		if r.CriterionID != nil {
+			id := r.CriterionID.String()
+			rating.CriterionID = &id
+		}
+		response.Ratings = append(response.Ratings, rating)
+	}
+	return response
+}
+
+// ToFeedbackSummaryResponse converts a domain.FeedbackSummary to FeedbackSummaryResponse
+func ToFeedbackSummaryResponse(summary *domain.FeedbackSummary) *FeedbackSummaryResponse {
+	if summary == nil {
+		return nil
+	}
+	response := &FeedbackSummaryResponse{
+		SubmittedCount:  summary.SubmittedCount,
+		DraftCount:      summary.DraftCount,
+		AverageRating:   summary.AverageRating,
+		Recommendations: make(map[string]int, len(summary.Recommendations)),
+		Criteria:        make([]CriterionSummaryResponse, 0, len(summary.Criteria)),
+	}
+	for rec, count := range summary.Recommendations {
+		response.Recommendations[string(rec)] = count
+	}
+	for _, c := range summary.Criteria {
+		criterion := CriterionSummaryResponse{
+			Name:          c.Name,
+			AverageRating: c.AverageRating,
+			Count:         c.Count,
+		}
+		if c.CriterionID != nil {
+			id := c.CriterionID.String()
+			criterion.CriterionID = &id
+		}
+		response.Criteria = append(response.Criteria, criterion)
+	}
+	return response
+}
+
+// ToApplicationFeedbackResponse converts a domain.ApplicationFeedback to ApplicationFeedbackResponse
+func ToApplicationFeedbackResponse(feedback *domain.ApplicationFeedback) ApplicationFeedbackResponse {
+	response := ApplicationFeedbackResponse{
+		Criteria:    ToScorecardCriteriaResponse(feedback.Criteria),
+		Scorecards:  make([]ScorecardResponse, len(feedback.Scorecards)),
+		Summary:     ToFeedbackSummaryResponse(feedback.Summary),
+		HiddenCount: feedback.HiddenCount,
+	}
+	if feedback.MyScorecard != nil {
+		mine := ToScorecardResponse(feedback.MyScorecard)
+		response.MyScorecard = &mine
+	}
+	for i := range feedback.Scorecards {
+		response.Scorecards[i] = ToScorecardResponse(&feedback.Scorecards[i])
+	}
+	return response
+}
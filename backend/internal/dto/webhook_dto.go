@@ -0,0 +1,139 @@
+package dto
+
+import (
+	"encoding/json"
+	"job-platform/internal/domain"
+	"time"
+)
+
+// ============================================================
+// REQUEST DTOs
+// ============================================================
+
+// CreateWebhookRequest represents a request to register a webhook endpoint
+type CreateWebhookRequest struct {
+	URL         string   `json:"url" binding:"required,url"`
+	Description string   `json:"description" binding:"max=255"`
+	Events      []string `json:"events" binding:"required,min=1"`
+}
+
+// UpdateWebhookRequest represents a request to update a webhook endpoint
+type UpdateWebhookRequest struct {
+	URL         *string  `json:"url" binding:"omitempty,url"`
+	Description *string  `json:"description" binding:"omitempty,max=255"`
+	Events      []string `json:"events" binding:"omitempty,min=1"`
+	IsActive    *bool    `json:"is_active"`
+}
+
+// ============================================================
+// RESPONSE DTOs
+// ============================================================
+
+// WebhookResponse represents a webhook endpoint in API responses
+type WebhookResponse struct {
+	ID          string    `json:"id"`
+	URL         string    `json:"url"`
+	Description string    `json:"description"`
+	Events      []string  `json:"events"`
+	IsActive    bool      `json:"is_active"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// WebhookSecretResponse represents a webhook with its signing secret, returned only when the
+// webhook is created or its secret is rotated
+type WebhookSecretResponse struct {
+	WebhookResponse
+	Secret string `json:"secret"`
+}
+
+// WebhookDeliveryResponse represents an entry of a webhook's delivery log
+type WebhookDeliveryResponse struct {
+	ID             string          `json:"id"`
+	EventID        string          `json:"event_id"`
+	Event          string          `json:"event"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  *time.Time      `json:"next_attempt_at,omitempty"`
+	LastAttemptAt  *time.Time      `json:"last_attempt_at,omitempty"`
+	ResponseStatus *int            `json:"response_status,omitempty"`
+	ResponseBody   string          `json:"response_body,omitempty"`
+	Error          string          `json:"error,omitempty"`
+	DurationMs     *int            `json:"duration_ms,omitempty"`
+	DeliveredAt    *time.Time      `json:"delivered_at,omitempty"`
+	ReplayOf       *string         `json:"replay_of,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+// ============================================================
+// HELPER FUNCTIONS
+// ============================================================
+
+// ToWebhookResponse converts a domain.Webhook to WebhookResponse
+func ToWebhookResponse(webhook *domain.Webhook) WebhookResponse {
+	events := []string(webhook.Events)
+	if events == nil {
+		events = []string{}
+	}
+	return WebhookResponse{
+		ID:          webhook.ID.String(),
+		URL:         webhook.URL,
+		Description: webhook.Description,
+		Events:      events,
+		IsActive:    webhook.IsActive,
+		CreatedAt:   webhook.CreatedAt,
+		UpdatedAt:   webhook.UpdatedAt,
+	}
+}
+
+// ToWebhookResponses converts webhooks to WebhookResponses
+func ToWebhookResponses(webhooks []domain.Webhook) []WebhookResponse {
+	responses := make([]WebhookResponse, len(webhooks))
+	for i := range webhooks {
+		responses[i] = ToWebhookResponse(&webhooks[i])
+	}
+	return responses
+}
+
+// ToWebhookSecretResponse converts a domain.Webhook to WebhookSecretResponse
+func ToWebhookSecretResponse(webhook *domain.Webhook) WebhookSecretResponse {
+	return WebhookSecretResponse{
+		WebhookResponse: ToWebhookResponse(webhook),
+		Secret:          webhook.Secret,
+	}
+}
+
+// ToWebhookDeliveryResponse converts a domain.WebhookDelivery to WebhookDeliveryResponse
+func ToWebhookDeliveryResponse(delivery *domain.WebhookDelivery) WebhookDeliveryResponse {
+	resp := WebhookDeliveryResponse{
+		ID:             delivery.ID.String(),
+		EventID:        delivery.EventID.String(),
+		Event:          string(delivery.Event),
+		Payload:        json.RawMessage(delivery.Payload),
+		Status:         string(delivery.Status),
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastAttemptAt:  delivery.LastAttemptAt,
+		ResponseStatus: delivery.ResponseStatus,
+		ResponseBody:   delivery.ResponseBody,
+		Error:          delivery.Error,
+		DurationMs:     delivery.DurationMs,
+		DeliveredAt:    delivery.DeliveredAt,
+		CreatedAt:      delivery.CreatedAt,
+	}
+	if delivery.ReplayOf != nil {
+		replayOf := delivery.ReplayOf.String()
+		resp.ReplayOf = &replayOf
+	}
+	return resp
+}
+
+// ToWebhookDeliveryResponses converts webhook deliveries to WebhookDeliveryResponses
+func ToWebhookDeliveryResponses(deliveries []domain.WebhookDelivery) []WebhookDeliveryResponse {
+	responses := make([]WebhookDeliveryResponse, len(deliveries))
+	for i := range deliveries {
+		responses[i] = ToWebhookDeliveryResponse(&deliveries[i])
+	}
+	return responses
+}
@@ -0,0 +1,192 @@
+package handler
+
+import (
+	"job-platform/internal/domain"
+	"job-platform/internal/dto"
+	"job-platform/internal/middleware"
+	"job-platform/internal/service"
+	"job-platform/internal/util/response"
+	"log"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// AdminDuplicateHandler handles the review of duplicate jobs
+type AdminDuplicateHandler struct {
+	duplicateService *service.JobDuplicateService
+	jobService       *service.JobService
+	searchService    *service.SearchService
+}
+
+// NewAdminDuplicateHandler creates a new admin duplicate handler
+func NewAdminDuplicateHandler(
+	duplicateService *service.JobDuplicateService,
+	jobService *service.JobService,
+	searchService *service.SearchService,
+) *AdminDuplicateHandler {
+	return &AdminDuplicateHandler{
+		duplicateService: duplicateService,
+		jobService:       jobService,
+		searchService:    searchService,
+	}
+}
+
+// GetDuplicates lists duplicate flags, pending ones by default
+// GET /api/v1/admin/jobs/duplicates?status=PENDING|DISMISSED|MERGED|ALL
+func (h *AdminDuplicateHandler) GetDuplicates(c *gin.Context) {
+	page, limit := parsePageLimit(c, 20)
+
+	var status *domain.JobDuplicateStatus
+	switch statusParam := c.DefaultQuery("status", string(domain.JobDuplicateStatusPending)); statusParam {
+	case "ALL":
+	case string(domain.JobDuplicateStatusPending), string(domain.JobDuplicateStatusDismissed), string(domain.JobDuplicateStatusMerged):
+		s := domain.JobDuplicateStatus(statusParam)
+		status = &s
+	default:
+		response.BadRequest(c, domain.ErrInvalidInput)
+		return
+	}
+
+	flags, total, err := h.duplicateService.ListDuplicates(status, limit, (page-1)*limit)
+	if err != nil {
+		response.InternalError(c, err)
+		return
+	}
+
+	response.Paginated(c, "Duplicate jobs retrieved successfully", dto.ToJobDuplicateResponses(flags), paginationMeta(page, limit, total))
+}
+
+// GetJobDuplicates lists the duplicate flags of a job
+// GET /api/v1/admin/jobs/:id/duplicates
+func (h *AdminDuplicateHandler) GetJobDuplicates(c *gin.Context) {
+	jobID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidJobID)
+		return
+	}
+
+	flags, err := h.duplicateService.GetJobDuplicates(jobID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Duplicate jobs retrieved successfully", dto.ToJobDuplicateResponses(flags))
+}
+
+// DismissDuplicate marks a duplicate flag as a false positive
+// POST /api/v1/admin/jobs/duplicates/:id/dismiss
+func (h *AdminDuplicateHandler) DismissDuplicate(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	flagID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	flag, err := h.duplicateService.DismissDuplicate(flagID, user.ID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Duplicate dismissed successfully", dto.ToJobDuplicateResponse(flag))
+}
+
+// MergeDuplicate merges the two jobs of a duplicate flag into one
+// POST /api/v1/admin/jobs/duplicates/:id/merge
+func (h *AdminDuplicateHandler) MergeDuplicate(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	flagID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	var req dto.MergeDuplicateRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.BadRequest(c, err)
+			return
+		}
+	}
+
+	var keepJobID *uuid.UUID
+	if req.KeepJobID != nil {
+		id, err := uuid.Parse(*req.KeepJobID)
+		if err != nil {
+			response.BadRequest(c, domain.ErrInvalidJobID)
+			return
+		}
+		keepJobID = &id
+	}
+
+	flag, err := h.duplicateService.MergeDuplicate(flagID, user.ID, keepJobID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	h.syncSearchIndex(flag)
+
+	response.OK(c, "Jobs merged successfully", dto.ToJobDuplicateResponse(flag))
+}
+
+// RebuildFingerprints recomputes the duplicate fingerprints of all jobs
+// POST /api/v1/admin/jobs/duplicates/rebuild
+func (h *AdminDuplicateHandler) RebuildFingerprints(c *gin.Context) {
+	count, err := h.duplicateService.RebuildFingerprints()
+	if err != nil {
+		response.InternalError(c, err)
+		return
+	}
+
+	response.OK(c, "Fingerprints rebuilt successfully", dto.RebuildFingerprintsResponse{
+		Fingerprinted: count,
+	})
+}
+
+// syncSearchIndex removes the merged-away job from search and refreshes the kept job
+func (h *AdminDuplicateHandler) syncSearchIndex(flag *domain.JobDuplicate) {
+	if h.searchService == nil || !h.searchService.IsAvailable() {
+		return
+	}
+
+	kept, removed := flag.MergedJobs()
+	if removed != nil {
+		if err := h.searchService.DeleteJob(removed); err != nil {
+			log.Printf("Failed to remove merged job %s from search: %v", removed.ID, err)
+		}
+	}
+	if kept != nil {
+		job, err := h.jobService.GetJobByID(kept.ID)
+		if err == nil && job.Status == domain.JobStatusActive {
+			if err := h.searchService.IndexJob(job); err != nil {
+				log.Printf("Failed to reindex job %s: %v", job.ID, err)
+			}
+		}
+	}
+}
+
+// handleError maps duplicate review errors to HTTP responses
+func (h *AdminDuplicateHandler) handleError(c *gin.Context, err error) {
+	switch err {
+	case domain.ErrJobDuplicateNotFound, domain.ErrJobNotFound:
+		response.NotFound(c, err)
+	case domain.ErrJobDuplicateResolved, domain.ErrInvalidMergeTarget, domain.ErrMergeAcrossEmployers:
+		response.BadRequest(c, err)
+	default:
+		response.InternalError(c, err)
+	}
+}
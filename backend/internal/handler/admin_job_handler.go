@@ -379,6 +379,7 @@ func (h *AdminJobHandler) CreateJob(c *gin.Context) {
 			CategoryIDs:        categoryIDs,
 			ApplicationURL:     req.ApplicationURL,
 			ApplicationEmail:   req.ApplicationEmail,
+			ScreeningQuestions: toScreeningQuestionInputs(req.ScreeningQuestions),
 		},
 		CompanyName:    req.CompanyName,
 		CompanyLogoURL: req.CompanyLogoURL,
@@ -392,6 +393,10 @@ func (h *AdminJobHandler) CreateJob(c *gin.Context) {
 			response.Forbidden(c, err)
 			return
 		}
+		if isScreeningQuestionError(err) {
+			response.BadRequest(c, err)
+			return
+		}
 		response.InternalError(c, err)
 		return
 	}
@@ -405,6 +410,13 @@ func (h *AdminJobHandler) CreateJob(c *gin.Context) {
 // UpdateJob updates an existing job (admin only)
 // PUT /api/v1/admin/jobs/:id
 func (h *AdminJobHandler) UpdateJob(c *gin.Context) {
+	// Get current user (admin)
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
 	// Parse job ID
 	jobIDStr := c.Param("id")
 	jobID, err := uuid.Parse(jobIDStr)
@@ -494,13 +506,22 @@ func (h *AdminJobHandler) UpdateJob(c *gin.Context) {
 		input.CategoryIDs = categoryIDs
 	}
 
+	// Handle screening questions
+	if req.ScreeningQuestions != nil {
+		input.ScreeningQuestions = toScreeningQuestionInputs(*req.ScreeningQuestions)
+	}
+
 	// Update job
-	job, err := h.jobService.AdminUpdateJob(jobID, input)
+	job, err := h.jobService.AdminUpdateJob(jobID, user.ID, input)
 	if err != nil {
 		if err == domain.ErrJobNotFound {
 			response.NotFound(c, err)
 			return
 		}
+		if isScreeningQuestionError(err) {
+			response.BadRequest(c, err)
+			return
+		}
 		response.InternalError(c, err)
 		return
 	}
@@ -511,6 +532,66 @@ func (h *AdminJobHandler) UpdateJob(c *gin.Context) {
 	response.OK(c, "Job updated successfully", jobResponse)
 }
 
+// GetJobRevisions retrieves the revision history of a job, newest first
+// GET /api/v1/admin/jobs/:id/revisions
+func (h *AdminJobHandler) GetJobRevisions(c *gin.Context) {
+	jobID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidJobID)
+		return
+	}
+
+	revisions, err := h.jobService.AdminGetJobRevisions(jobID)
+	if err != nil {
+		if err == domain.ErrJobNotFound {
+			response.NotFound(c, err)
+			return
+		}
+		response.InternalError(c, err)
+		return
+	}
+
+	response.OK(c, "Job revisions retrieved successfully", dto.ToJobRevisionResponses(revisions))
+}
+
+// RestoreJobRevision restores a job to a previous revision
+// POST /api/v1/admin/jobs/:id/revisions/:revisionId/restore
+func (h *AdminJobHandler) RestoreJobRevision(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	jobID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidJobID)
+		return
+	}
+
+	revisionID, err := uuid.Parse(c.Param("revisionId"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	job, err := h.jobService.AdminRestoreJobRevision(jobID, revisionID, user.ID)
+	if err != nil {
+		if err == domain.ErrJobNotFound || err == domain.ErrJobRevisionNotFound {
+			response.NotFound(c, err)
+			return
+		}
+		if isScreeningQuestionError(err) {
+			response.BadRequest(c, err)
+			return
+		}
+		response.InternalError(c, err)
+		return
+	}
+
+	response.OK(c, "Job revision restored successfully", dto.ToJobResponse(job, nil))
+}
+
 // GetPendingJobs retrieves all pending jobs awaiting approval
 // GET /api/v1/admin/jobs/pending
 func (h *AdminJobHandler) GetPendingJobs(c *gin.Context) {
@@ -0,0 +1,224 @@
+package handler
+
+import (
+	"job-platform/internal/domain"
+	"job-platform/internal/dto"
+	"job-platform/internal/middleware"
+	"job-platform/internal/service"
+	"job-platform/internal/util/response"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// AdminJobSourceHandler handles admin management of recurring career-page sources
+type AdminJobSourceHandler struct {
+	jobSourceService *service.JobSourceService
+}
+
+// NewAdminJobSourceHandler creates a new admin job source handler
+func NewAdminJobSourceHandler(jobSourceService *service.JobSourceService) *AdminJobSourceHandler {
+	return &AdminJobSourceHandler{
+		jobSourceService: jobSourceService,
+	}
+}
+
+// ListSources retrieves job sources
+// GET /api/v1/admin/job-sources
+func (h *AdminJobSourceHandler) ListSources(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	var companyID *uuid.UUID
+	if companyIDStr := c.Query("company_id"); companyIDStr != "" {
+		if id, err := uuid.Parse(companyIDStr); err == nil {
+			companyID = &id
+		}
+	}
+
+	sources, total, err := h.jobSourceService.ListSources(companyID, limit, (page-1)*limit)
+	if err != nil {
+		response.InternalError(c, err)
+		return
+	}
+
+	totalPages := int(total) / limit
+	if int(total)%limit > 0 {
+		totalPages++
+	}
+
+	response.Paginated(c, "Job sources retrieved successfully", sources, response.PaginationMeta{
+		CurrentPage: page,
+		PerPage:     limit,
+		Total:       total,
+		TotalPages:  totalPages,
+	})
+}
+
+// GetSource retrieves a job source
+// GET /api/v1/admin/job-sources/:id
+func (h *AdminJobSourceHandler) GetSource(c *gin.Context) {
+	sourceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrJobSourceNotFound)
+		return
+	}
+
+	source, err := h.jobSourceService.GetSource(sourceID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Job source retrieved successfully", source)
+}
+
+// CreateSource registers a new recurring career-page source
+// POST /api/v1/admin/job-sources
+func (h *AdminJobSourceHandler) CreateSource(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	var req dto.CreateJobSourceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	companyID, err := parseOptionalCompanyID(req.CompanyID)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	source, err := h.jobSourceService.CreateSource(user.ID, service.CreateJobSourceInput{
+		CompanyID:          companyID,
+		Name:               req.Name,
+		URL:                req.URL,
+		ExtractionStrategy: domain.JobSourceStrategy(req.ExtractionStrategy),
+		CrawlIntervalHours: req.CrawlIntervalHours,
+		CloseMissingJobs:   req.CloseMissingJobs,
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.Created(c, "Job source created successfully", source)
+}
+
+// UpdateSource updates a job source
+// PUT /api/v1/admin/job-sources/:id
+func (h *AdminJobSourceHandler) UpdateSource(c *gin.Context) {
+	sourceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrJobSourceNotFound)
+		return
+	}
+
+	var req dto.UpdateJobSourceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	companyID, err := parseOptionalCompanyID(req.CompanyID)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	input := service.UpdateJobSourceInput{
+		CompanyID:          companyID,
+		Name:               req.Name,
+		URL:                req.URL,
+		CrawlIntervalHours: req.CrawlIntervalHours,
+		CloseMissingJobs:   req.CloseMissingJobs,
+		IsActive:           req.IsActive,
+	}
+	if req.ExtractionStrategy != nil {
+		strategy := domain.JobSourceStrategy(*req.ExtractionStrategy)
+		input.ExtractionStrategy = &strategy
+	}
+
+	source, err := h.jobSourceService.UpdateSource(sourceID, input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Job source updated successfully", source)
+}
+
+// DeleteSource deletes a job source
+// DELETE /api/v1/admin/job-sources/:id
+func (h *AdminJobSourceHandler) DeleteSource(c *gin.Context) {
+	sourceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrJobSourceNotFound)
+		return
+	}
+
+	if err := h.jobSourceService.DeleteSource(sourceID); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Job source deleted successfully", nil)
+}
+
+// SyncSource crawls a job source immediately
+// POST /api/v1/admin/job-sources/:id/sync
+func (h *AdminJobSourceHandler) SyncSource(c *gin.Context) {
+	sourceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrJobSourceNotFound)
+		return
+	}
+
+	source, result, err := h.jobSourceService.SyncSource(c.Request.Context(), sourceID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Job source synced successfully", gin.H{
+		"source": source,
+		"result": result,
+	})
+}
+
+// handleError maps job source errors to responses
+func (h *AdminJobSourceHandler) handleError(c *gin.Context, err error) {
+	switch err {
+	case domain.ErrJobSourceNotFound, domain.ErrCompanyNotFound:
+		response.NotFound(c, err)
+	case domain.ErrInvalidJobSourceURL, domain.ErrInvalidExtractionStrategy, domain.ErrInvalidCrawlInterval:
+		response.BadRequest(c, err)
+	default:
+		response.InternalError(c, err)
+	}
+}
+
+// parseOptionalCompanyID parses an optional company ID from a request
+func parseOptionalCompanyID(value *string) (*uuid.UUID, error) {
+	if value == nil || *value == "" {
+		return nil, nil
+	}
+	id, err := uuid.Parse(*value)
+	if err != nil {
+		return nil, domain.ErrCompanyNotFound
+	}
+	return &id, nil
+}
@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"job-platform/internal/cron"
+	"job-platform/internal/util/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdminSchedulerHandler exposes the state of background schedulers to admins
+type AdminSchedulerHandler struct {
+	jobCronScheduler *cron.JobCronScheduler
+}
+
+// NewAdminSchedulerHandler creates a new admin scheduler handler
+func NewAdminSchedulerHandler(jobCronScheduler *cron.JobCronScheduler) *AdminSchedulerHandler {
+	return &AdminSchedulerHandler{
+		jobCronScheduler: jobCronScheduler,
+	}
+}
+
+// GetJobSchedulerStatus returns the job cron scheduler status and run history
+// GET /api/v1/admin/jobs/scheduler-status
+func (h *AdminSchedulerHandler) GetJobSchedulerStatus(c *gin.Context) {
+	if h.jobCronScheduler == nil {
+		response.OK(c, "Scheduler status retrieved successfully", gin.H{"status": "not_configured"})
+		return
+	}
+
+	response.OK(c, "Scheduler status retrieved successfully", h.jobCronScheduler.GetSchedulerStatus())
+}
@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"net/http"
+
+	"job-platform/internal/domain"
+	"job-platform/internal/dto"
+	"job-platform/internal/middleware"
+	"job-platform/internal/service"
+	"job-platform/internal/util/response"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// EmployerAPIKeyHandler handles the API keys of a company
+type EmployerAPIKeyHandler struct {
+	apiKeyService *service.APIKeyService
+}
+
+// NewEmployerAPIKeyHandler creates a new employer API key handler
+func NewEmployerAPIKeyHandler(apiKeyService *service.APIKeyService) *EmployerAPIKeyHandler {
+	return &EmployerAPIKeyHandler{
+		apiKeyService: apiKeyService,
+	}
+}
+
+// GetAPIKeys retrieves the company's API keys
+// GET /api/v1/employer/company/api-keys
+func (h *EmployerAPIKeyHandler) GetAPIKeys(c *gin.Context) {
+	companyID, _ := c.Get("company_id")
+	cid := companyID.(uuid.UUID)
+
+	keys, err := h.apiKeyService.GetAPIKeys(cid)
+	if err != nil {
+		response.InternalError(c, err)
+		return
+	}
+
+	response.OK(c, "API keys retrieved successfully", dto.ToAPIKeyResponses(keys))
+}
+
+// GetScopes lists the scopes API keys can be granted
+// GET /api/v1/employer/company/api-keys/scopes
+func (h *EmployerAPIKeyHandler) GetScopes(c *gin.Context) {
+	response.OK(c, "API key scopes retrieved successfully", domain.APIKeyScopes)
+}
+
+// CreateAPIKey creates an API key acting as the current user. The key is only returned here.
+// POST /api/v1/employer/company/api-keys
+func (h *EmployerAPIKeyHandler) CreateAPIKey(c *gin.Context) {
+	companyID, _ := c.Get("company_id")
+	cid := companyID.(uuid.UUID)
+
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	var req dto.CreateAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	key, rawKey, err := h.apiKeyService.CreateAPIKey(cid, user.ID, service.CreateAPIKeyInput{
+		Name:      req.Name,
+		Scopes:    req.Scopes,
+		ExpiresAt: req.ExpiresAt,
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.Created(c, "API key created successfully", dto.CreatedAPIKeyResponse{
+		APIKeyResponse: dto.ToAPIKeyResponse(key),
+		Key:            rawKey,
+	})
+}
+
+// RevokeAPIKey revokes an API key
+// DELETE /api/v1/employer/company/api-keys/:id
+func (h *EmployerAPIKeyHandler) RevokeAPIKey(c *gin.Context) {
+	companyID, _ := c.Get("company_id")
+	cid := companyID.(uuid.UUID)
+
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	keyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	key, err := h.apiKeyService.RevokeAPIKey(keyID, cid, user.ID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "API key revoked successfully", dto.ToAPIKeyResponse(key))
+}
+
+// handleError maps API key errors to HTTP responses
+func (h *EmployerAPIKeyHandler) handleError(c *gin.Context, err error) {
+	switch err {
+	case domain.ErrAPIKeyNotFound:
+		response.NotFound(c, err)
+	case domain.ErrInvalidAPIKeyScopes, domain.ErrInvalidAPIKeyExpiry:
+		response.BadRequest(c, err)
+	case domain.ErrAPIKeyLimitReached:
+		response.Error(c, http.StatusConflict, err, nil)
+	default:
+		response.InternalError(c, err)
+	}
+}
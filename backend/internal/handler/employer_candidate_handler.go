@@ -512,117 +512,6 @@ func (h *EmployerCandidateHandler) UpdateSavedCandidate(c *gin.Context) {
 	})
 }
 
-// GetCandidateNotes godoc
-// @Summary Get candidate notes
-// @Description Get internal notes for a candidate
-// @Tags Employer Candidates
-// @Accept json
-// @Produce json
-// @Param id path string true "Candidate User ID"
-// @Success 200 {object} map[string]interface{}
-// @Security BearerAuth
-// @Router /employer/candidates/{id}/notes [get]
-func (h *EmployerCandidateHandler) GetCandidateNotes(c *gin.Context) {
-	user, err := middleware.GetUserFromContext(c)
-	if err != nil {
-		response.Unauthorized(c, err)
-		return
-	}
-
-	candidateIDStr := c.Param("id")
-	candidateID, err := uuid.Parse(candidateIDStr)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid candidate id"})
-		return
-	}
-
-	// Get notes from saved candidate record
-	saved, err := h.candidateService.IsCandidateSaved(user.ID, candidateID)
-	if err != nil || !saved {
-		// Return empty notes if candidate is not saved
-		c.JSON(http.StatusOK, gin.H{"notes": []string{}})
-		return
-	}
-
-	// Get the saved candidate to retrieve notes
-	savedCandidates, _, err := h.candidateService.GetSavedCandidates(user.ID, 100, 0)
-	if err != nil {
-		c.JSON(http.StatusOK, gin.H{"notes": []string{}})
-		return
-	}
-
-	notes := []string{}
-	for _, sc := range savedCandidates {
-		if sc.CandidateID == candidateID && sc.Notes != nil && *sc.Notes != "" {
-			notes = append(notes, *sc.Notes)
-		}
-	}
-
-	c.JSON(http.StatusOK, gin.H{"notes": notes})
-}
-
-// AddCandidateNoteRequest represents the request for adding a note
-type AddCandidateNoteRequest struct {
-	Note string `json:"note" binding:"required"`
-}
-
-// AddCandidateNote godoc
-// @Summary Add candidate note
-// @Description Add an internal note for a candidate
-// @Tags Employer Candidates
-// @Accept json
-// @Produce json
-// @Param id path string true "Candidate User ID"
-// @Param request body AddCandidateNoteRequest true "Note content"
-// @Success 200 {object} map[string]interface{}
-// @Security BearerAuth
-// @Router /employer/candidates/{id}/notes [post]
-func (h *EmployerCandidateHandler) AddCandidateNote(c *gin.Context) {
-	user, err := middleware.GetUserFromContext(c)
-	if err != nil {
-		response.Unauthorized(c, err)
-		return
-	}
-
-	candidateIDStr := c.Param("id")
-	candidateID, err := uuid.Parse(candidateIDStr)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid candidate id"})
-		return
-	}
-
-	var req AddCandidateNoteRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
-	}
-
-	// Check if candidate is saved, if not save them first
-	saved, _ := h.candidateService.IsCandidateSaved(user.ID, candidateID)
-	if !saved {
-		// Auto-save the candidate when adding a note
-		_, err := h.candidateService.SaveCandidate(user.ID, service.SaveCandidateInput{
-			CandidateID: candidateID,
-			Notes:       req.Note,
-		})
-		if err != nil && err != domain.ErrCandidateAlreadySaved {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to save candidate"})
-			return
-		}
-	} else {
-		// Update existing notes
-		_, err := h.candidateService.UpdateSavedCandidate(user.ID, candidateID, service.UpdateSavedCandidateInput{
-			Notes: &req.Note,
-		})
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update notes"})
-			return
-		}
-	}
-
-	c.JSON(http.StatusOK, gin.H{"note": req.Note})
-}
-
 // Helper functions
 func stringPtrToString(s *string) string {
 	if s == nil {
@@ -252,6 +252,14 @@ func (h *EmployerCompanyHandler) UpdateCompany(c *gin.Context) {
 		return
 	}
 
+	if req.RequireJobApproval != nil && *req.RequireJobApproval != company.RequireJobApproval {
+		company, err = h.companyService.SetRequireJobApproval(cid, *req.RequireJobApproval)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
 	companyResponse := dto.ToCompanyResponse(company)
 	response.OK(c, "Company updated successfully", companyResponse)
 }
@@ -19,6 +19,7 @@ import (
 type EmployerJobHandler struct {
 	jobService         *service.JobService
 	applicationService *service.ApplicationService
+	scorecardService   *service.ScorecardService
 	cacheService       *cache.CacheService
 }
 
@@ -26,11 +27,13 @@ type EmployerJobHandler struct {
 func NewEmployerJobHandler(
 	jobService *service.JobService,
 	applicationService *service.ApplicationService,
+	scorecardService *service.ScorecardService,
 	cacheService *cache.CacheService,
 ) *EmployerJobHandler {
 	return &EmployerJobHandler{
 		jobService:         jobService,
 		applicationService: applicationService,
+		scorecardService:   scorecardService,
 		cacheService:       cacheService,
 	}
 }
@@ -107,12 +110,26 @@ func (h *EmployerJobHandler) CreateJob(c *gin.Context) {
 		CategoryIDs:        categoryIDs,
 		ApplicationURL:     req.ApplicationURL,
 		ApplicationEmail:   req.ApplicationEmail,
+		ScreeningQuestions: toScreeningQuestionInputs(req.ScreeningQuestions),
+		Openings:           req.Openings,
+		RequisitionID:      req.RequisitionID,
+		SaveAsDraft:        req.SaveAsDraft,
+		PublishAt:          req.PublishAt,
+	}
+
+	if req.HiringManagerID != "" {
+		managerID, err := uuid.Parse(req.HiringManagerID)
+		if err != nil {
+			response.BadRequest(c, domain.ErrInvalidHiringManager)
+			return
+		}
+		input.HiringManagerID = &managerID
 	}
 
 	// Create job
 	job, err := h.jobService.CreateJob(user.ID, input)
 	if err != nil {
-		if err == domain.ErrMaxJobsReached {
+		if err == domain.ErrMaxJobsReached || isScreeningQuestionError(err) || isJobHiringError(err) {
 			response.BadRequest(c, err)
 			return
 		}
@@ -124,7 +141,7 @@ func (h *EmployerJobHandler) CreateJob(c *gin.Context) {
 	h.invalidateJobCaches()
 
 	// Convert to response
-	jobResponse := dto.ToJobResponse(job, nil)
+	jobResponse := dto.ToEmployerJobResponse(job)
 
 	response.Created(c, "Job created successfully", jobResponse)
 }
@@ -138,6 +155,46 @@ func (h *EmployerJobHandler) invalidateJobCaches() {
 	}
 }
 
+// toScreeningQuestionInputs converts screening question requests to service inputs.
+// The result is never nil so an empty request list clears the questions on update.
+func toScreeningQuestionInputs(reqs []dto.ScreeningQuestionRequest) []service.ScreeningQuestionInput {
+	inputs := make([]service.ScreeningQuestionInput, 0, len(reqs))
+	for _, req := range reqs {
+		isRequired := true
+		if req.IsRequired != nil {
+			isRequired = *req.IsRequired
+		}
+		var id *uuid.UUID
+		if req.ID != nil {
+			if parsed, err := uuid.Parse(*req.ID); err == nil {
+				id = &parsed
+			}
+		}
+		inputs = append(inputs, service.ScreeningQuestionInput{
+			ID:              id,
+			Question:        req.Question,
+			Type:            domain.ScreeningQuestionType(req.Type),
+			Options:         req.Options,
+			MinValue:        req.MinValue,
+			MaxValue:        req.MaxValue,
+			IsRequired:      isRequired,
+			KnockoutAction:  domain.KnockoutAction(req.KnockoutAction),
+			KnockoutAnswers: req.KnockoutAnswers,
+		})
+	}
+	return inputs
+}
+
+// isJobHiringError checks if err is caused by invalid openings or hiring manager
+func isJobHiringError(err error) bool {
+	return err == domain.ErrInvalidOpenings || err == domain.ErrInvalidHiringManager
+}
+
+// isScreeningQuestionError checks if err is caused by an invalid screening question definition
+func isScreeningQuestionError(err error) bool {
+	return err == domain.ErrInvalidScreeningQuestion || err == domain.ErrTooManyScreeningQuestions
+}
+
 // UpdateJob updates an existing job
 // PUT /api/v1/employer/jobs/:id
 func (h *EmployerJobHandler) UpdateJob(c *gin.Context) {
@@ -184,6 +241,22 @@ func (h *EmployerJobHandler) UpdateJob(c *gin.Context) {
 		YearsExperienceMax: req.YearsExperienceMax,
 		ApplicationURL:     req.ApplicationURL,
 		ApplicationEmail:   req.ApplicationEmail,
+		Openings:           req.Openings,
+		RequisitionID:      req.RequisitionID,
+	}
+
+	// Parse hiring manager if provided (empty string removes it)
+	if req.HiringManagerID != nil {
+		if *req.HiringManagerID == "" {
+			input.ClearHiringManager = true
+		} else {
+			managerID, err := uuid.Parse(*req.HiringManagerID)
+			if err != nil {
+				response.BadRequest(c, domain.ErrInvalidHiringManager)
+				return
+			}
+			input.HiringManagerID = &managerID
+		}
 	}
 
 	// Handle Skills (pointer to slice)
@@ -228,6 +301,11 @@ func (h *EmployerJobHandler) UpdateJob(c *gin.Context) {
 		input.CategoryIDs = categoryIDs
 	}
 
+	// Replace screening questions if provided
+	if req.ScreeningQuestions != nil {
+		input.ScreeningQuestions = toScreeningQuestionInputs(*req.ScreeningQuestions)
+	}
+
 	// Update job
 	job, err := h.jobService.UpdateJob(jobID, user.ID, input)
 	if err != nil {
@@ -239,6 +317,10 @@ func (h *EmployerJobHandler) UpdateJob(c *gin.Context) {
 			response.Forbidden(c, err)
 			return
 		}
+		if isScreeningQuestionError(err) || isJobHiringError(err) {
+			response.BadRequest(c, err)
+			return
+		}
 		response.InternalError(c, err)
 		return
 	}
@@ -247,7 +329,7 @@ func (h *EmployerJobHandler) UpdateJob(c *gin.Context) {
 	h.invalidateJobCaches()
 
 	// Convert to response
-	jobResponse := dto.ToJobResponse(job, nil)
+	jobResponse := dto.ToEmployerJobResponse(job)
 
 	response.OK(c, "Job updated successfully", jobResponse)
 }
@@ -323,6 +405,9 @@ func (h *EmployerJobHandler) GetMyJobs(c *gin.Context) {
 
 	// Convert to response
 	jobsResponse := dto.ToJobListResponse(jobs, total, page, limit, nil)
+	for i := range jobs {
+		jobsResponse.Jobs[i].Hiring = dto.ToJobHiringResponse(&jobs[i])
+	}
 
 	response.OK(c, "Jobs retrieved successfully", jobsResponse)
 }
@@ -363,7 +448,7 @@ func (h *EmployerJobHandler) GetMyJobByID(c *gin.Context) {
 	}
 
 	// Convert to response
-	jobResponse := dto.ToJobResponse(job, nil)
+	jobResponse := dto.ToEmployerJobResponse(job)
 
 	response.OK(c, "Job retrieved successfully", jobResponse)
 }
@@ -445,6 +530,10 @@ func (h *EmployerJobHandler) RenewJob(c *gin.Context) {
 			response.Forbidden(c, err)
 			return
 		}
+		if err == domain.ErrJobNotPublished {
+			response.BadRequest(c, err)
+			return
+		}
 		response.InternalError(c, err)
 		return
 	}
@@ -460,11 +549,234 @@ func (h *EmployerJobHandler) RenewJob(c *gin.Context) {
 	}
 
 	// Convert to response
-	jobResponse := dto.ToJobResponse(job, nil)
+	jobResponse := dto.ToEmployerJobResponse(job)
 
 	response.OK(c, "Job renewed successfully", jobResponse)
 }
 
+// PublishJob submits a draft for publishing, immediately or at publish_at
+// POST /api/v1/employer/jobs/:id/publish
+func (h *EmployerJobHandler) PublishJob(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	jobID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidJobID)
+		return
+	}
+
+	var req dto.PublishJobRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.BadRequest(c, err)
+			return
+		}
+	}
+
+	job, err := h.jobService.PublishJob(jobID, user.ID, req.PublishAt)
+	if err != nil {
+		h.handlePublishingError(c, err)
+		return
+	}
+
+	h.invalidateJobCaches()
+
+	response.OK(c, "Job submitted successfully", dto.ToEmployerJobResponse(job))
+}
+
+// RevertJobToDraft takes a job waiting for review, moderation or its publish time back to draft
+// POST /api/v1/employer/jobs/:id/draft
+func (h *EmployerJobHandler) RevertJobToDraft(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	jobID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidJobID)
+		return
+	}
+
+	job, err := h.jobService.RevertJobToDraft(jobID, user.ID)
+	if err != nil {
+		h.handlePublishingError(c, err)
+		return
+	}
+
+	response.OK(c, "Job moved back to draft", dto.ToEmployerJobResponse(job))
+}
+
+// GetJobsPendingReview retrieves the jobs waiting for approval in the companies the user administers
+// GET /api/v1/employer/job-reviews
+func (h *EmployerJobHandler) GetJobsPendingReview(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	page, limit := parsePageLimit(c, 20)
+
+	jobs, total, err := h.jobService.GetJobsPendingReview(user.ID, limit, (page-1)*limit)
+	if err != nil {
+		response.InternalError(c, err)
+		return
+	}
+
+	jobResponses := make([]dto.JobResponse, len(jobs))
+	for i := range jobs {
+		jobResponses[i] = dto.ToEmployerJobResponse(&jobs[i])
+	}
+
+	response.Paginated(c, "Jobs pending review retrieved successfully", jobResponses, paginationMeta(page, limit, total))
+}
+
+// ApproveJobReview approves a recruiter's job on behalf of the company
+// POST /api/v1/employer/job-reviews/:id/approve
+func (h *EmployerJobHandler) ApproveJobReview(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	jobID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidJobID)
+		return
+	}
+
+	job, err := h.jobService.ApproveJobReview(jobID, user.ID)
+	if err != nil {
+		h.handlePublishingError(c, err)
+		return
+	}
+
+	h.invalidateJobCaches()
+
+	response.OK(c, "Job approved successfully", dto.ToEmployerJobResponse(job))
+}
+
+// RejectJobReview sends a recruiter's job back to draft with a note
+// POST /api/v1/employer/job-reviews/:id/reject
+func (h *EmployerJobHandler) RejectJobReview(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	jobID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidJobID)
+		return
+	}
+
+	var req dto.RejectJobReviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	job, err := h.jobService.RejectJobReview(jobID, user.ID, req.Note)
+	if err != nil {
+		h.handlePublishingError(c, err)
+		return
+	}
+
+	response.OK(c, "Job sent back to draft", dto.ToEmployerJobResponse(job))
+}
+
+// handlePublishingError maps job publishing and review errors to HTTP responses
+func (h *EmployerJobHandler) handlePublishingError(c *gin.Context, err error) {
+	switch err {
+	case domain.ErrJobNotFound:
+		response.NotFound(c, err)
+	case domain.ErrJobNotOwnedByEmployer, domain.ErrNotJobReviewer:
+		response.Forbidden(c, err)
+	case domain.ErrInvalidJobStatus:
+		response.BadRequest(c, err)
+	default:
+		response.InternalError(c, err)
+	}
+}
+
+// GetJobRevisions retrieves the revision history of a job, newest first
+// GET /api/v1/employer/jobs/:id/revisions
+func (h *EmployerJobHandler) GetJobRevisions(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	jobID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidJobID)
+		return
+	}
+
+	revisions, err := h.jobService.GetJobRevisions(jobID, user.ID)
+	if err != nil {
+		h.handleRevisionError(c, err)
+		return
+	}
+
+	response.OK(c, "Job revisions retrieved successfully", dto.ToJobRevisionResponses(revisions))
+}
+
+// RestoreJobRevision restores a job to a previous revision
+// POST /api/v1/employer/jobs/:id/revisions/:revisionId/restore
+func (h *EmployerJobHandler) RestoreJobRevision(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	jobID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidJobID)
+		return
+	}
+
+	revisionID, err := uuid.Parse(c.Param("revisionId"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	job, err := h.jobService.RestoreJobRevision(jobID, revisionID, user.ID)
+	if err != nil {
+		h.handleRevisionError(c, err)
+		return
+	}
+
+	h.invalidateJobCaches()
+
+	response.OK(c, "Job revision restored successfully", dto.ToEmployerJobResponse(job))
+}
+
+// handleRevisionError maps job revision errors to HTTP responses
+func (h *EmployerJobHandler) handleRevisionError(c *gin.Context, err error) {
+	switch {
+	case err == domain.ErrJobNotFound, err == domain.ErrJobRevisionNotFound:
+		response.NotFound(c, err)
+	case err == domain.ErrJobNotOwnedByEmployer:
+		response.Forbidden(c, err)
+	case isScreeningQuestionError(err), isJobHiringError(err):
+		response.BadRequest(c, err)
+	default:
+		response.InternalError(c, err)
+	}
+}
+
 // GetJobApplications retrieves all applications for a specific job
 // GET /api/v1/employer/jobs/:id/applications
 func (h *EmployerJobHandler) GetJobApplications(c *gin.Context) {
@@ -563,6 +875,11 @@ func (h *EmployerJobHandler) GetApplicationDetail(c *gin.Context) {
 	// Convert to response (include both job and applicant info)
 	appResponse := dto.ToApplicationResponse(application, true, true)
 
+	// Include aggregated interview feedback
+	if summary, err := h.scorecardService.GetFeedbackSummary(application); err == nil {
+		appResponse.Feedback = dto.ToFeedbackSummaryResponse(summary)
+	}
+
 	response.OK(c, "Application retrieved successfully", appResponse)
 }
 
@@ -599,15 +916,7 @@ func (h *EmployerJobHandler) UpdateApplicationStatus(c *gin.Context) {
 		req.Reason,
 	)
 	if err != nil {
-		if err == domain.ErrApplicationNotFound {
-			response.NotFound(c, err)
-			return
-		}
-		if err == domain.ErrInvalidStatusTransition {
-			response.BadRequest(c, err)
-			return
-		}
-		response.InternalError(c, err)
+		h.handleMoveError(c, err)
 		return
 	}
 
@@ -624,9 +933,9 @@ func (h *EmployerJobHandler) UpdateApplicationStatus(c *gin.Context) {
 	response.OK(c, "Application status updated successfully", appResponse)
 }
 
-// AddApplicationNotes adds or updates employer notes for an application
-// PATCH /api/v1/employer/applications/:id/notes
-func (h *EmployerJobHandler) AddApplicationNotes(c *gin.Context) {
+// MoveApplication moves an application to another pipeline stage
+// PATCH /api/v1/employer/applications/:id/stage
+func (h *EmployerJobHandler) MoveApplication(c *gin.Context) {
 	// Get current user
 	user, err := middleware.GetUserFromContext(c)
 	if err != nil {
@@ -635,32 +944,162 @@ func (h *EmployerJobHandler) AddApplicationNotes(c *gin.Context) {
 	}
 
 	// Parse application ID
-	appIDStr := c.Param("id")
-	appID, err := uuid.Parse(appIDStr)
+	appID, err := uuid.Parse(c.Param("id"))
 	if err != nil {
 		response.BadRequest(c, domain.ErrInvalidID)
 		return
 	}
 
 	// Parse request
-	var req dto.AddApplicationNotesRequest
+	var req dto.MoveApplicationRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		response.BadRequest(c, err)
 		return
 	}
 
-	// Add notes
-	err = h.applicationService.AddEmployerNotes(appID, user.ID, req.Notes)
+	input, err := toMoveApplicationInput(req.StageID, req.Status, req.Reason)
 	if err != nil {
-		if err == domain.ErrApplicationNotFound {
+		response.BadRequest(c, err)
+		return
+	}
+
+	application, err := h.applicationService.MoveApplication(appID, user.ID, input)
+	if err != nil {
+		h.handleMoveError(c, err)
+		return
+	}
+
+	response.OK(c, "Application moved successfully", dto.ToApplicationResponse(application, true, true))
+}
+
+// BulkMoveApplications moves several applications to the same pipeline stage
+// POST /api/v1/employer/applications/bulk-move
+func (h *EmployerJobHandler) BulkMoveApplications(c *gin.Context) {
+	// Get current user
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	// Parse request
+	var req dto.BulkMoveApplicationsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	input, err := toMoveApplicationInput(req.StageID, req.Status, req.Reason)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	appIDs := make([]uuid.UUID, 0, len(req.ApplicationIDs))
+	for _, idStr := range req.ApplicationIDs {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			response.BadRequest(c, domain.ErrInvalidID)
+			return
+		}
+		appIDs = append(appIDs, id)
+	}
+
+	result, err := h.applicationService.BulkMoveApplications(user.ID, appIDs, input)
+	if err != nil {
+		h.handleMoveError(c, err)
+		return
+	}
+
+	resp := dto.BulkMoveApplicationsResponse{
+		Moved:  make([]string, len(result.Moved)),
+		Failed: make([]dto.BulkMoveFailureResponse, len(result.Failed)),
+	}
+	for i, id := range result.Moved {
+		resp.Moved[i] = id.String()
+	}
+	for i, failure := range result.Failed {
+		resp.Failed[i] = dto.BulkMoveFailureResponse{
+			ApplicationID: failure.ApplicationID.String(),
+			Error:         failure.Err.Error(),
+		}
+	}
+
+	response.OK(c, "Applications moved", resp)
+}
+
+// GetJobPipeline retrieves a job's pipeline stages with application counts
+// GET /api/v1/employer/jobs/:id/pipeline
+func (h *EmployerJobHandler) GetJobPipeline(c *gin.Context) {
+	// Get current user
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	// Parse job ID
+	jobID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidJobID)
+		return
+	}
+
+	counts, err := h.applicationService.GetJobPipeline(jobID, user.ID)
+	if err != nil {
+		if err == domain.ErrJobNotFound {
 			response.NotFound(c, err)
 			return
 		}
+		if err == domain.ErrJobNotOwnedByEmployer {
+			response.Forbidden(c, err)
+			return
+		}
 		response.InternalError(c, err)
 		return
 	}
 
-	response.OK(c, "Notes added successfully", nil)
+	stages := make([]dto.PipelineStageResponse, len(counts))
+	for i := range counts {
+		stages[i] = dto.ToPipelineStageResponse(&counts[i].Stage)
+		count := counts[i].Count
+		stages[i].Count = &count
+	}
+
+	response.OK(c, "Job pipeline retrieved successfully", stages)
+}
+
+// toMoveApplicationInput builds a move input from a stage ID or status
+func toMoveApplicationInput(stageIDStr, status, reason string) (service.MoveApplicationInput, error) {
+	input := service.MoveApplicationInput{
+		Status: domain.ApplicationStatus(status),
+		Reason: reason,
+	}
+	if stageIDStr != "" {
+		stageID, err := uuid.Parse(stageIDStr)
+		if err != nil {
+			return input, domain.ErrPipelineStageNotFound
+		}
+		input.StageID = &stageID
+	} else if status == "" {
+		return input, domain.ErrInvalidApplicationStatus
+	}
+	return input, nil
+}
+
+// handleMoveError maps application move errors to responses
+func (h *EmployerJobHandler) handleMoveError(c *gin.Context, err error) {
+	switch err {
+	case domain.ErrApplicationNotFound, domain.ErrPipelineStageNotFound:
+		response.NotFound(c, err)
+	case domain.ErrJobNotOwnedByEmployer:
+		response.Forbidden(c, err)
+	case domain.ErrInvalidApplicationStatus, domain.ErrApplicationClosed,
+		domain.ErrApplicationAlreadyInStage, domain.ErrTooManyApplications, domain.ErrHireRequiresOffer:
+		response.BadRequest(c, err)
+	default:
+		response.InternalError(c, err)
+	}
 }
 
 // RateApplicant rates an applicant
@@ -765,6 +1204,25 @@ func (h *EmployerJobHandler) GetOverviewAnalytics(c *gin.Context) {
 	response.OK(c, "Analytics retrieved successfully", analytics)
 }
 
+// GetRequisitionAnalytics retrieves time-to-fill per requisition for the employer
+// GET /api/v1/employer/analytics/requisitions
+func (h *EmployerJobHandler) GetRequisitionAnalytics(c *gin.Context) {
+	// Get current user
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	analytics, err := h.jobService.GetRequisitionAnalytics(user.ID, c.Query("requisition_id"))
+	if err != nil {
+		response.InternalError(c, err)
+		return
+	}
+
+	response.OK(c, "Requisition analytics retrieved successfully", analytics)
+}
+
 // GetJobAnalytics retrieves analytics for a specific job
 // GET /api/v1/employer/jobs/:id/analytics
 func (h *EmployerJobHandler) GetJobAnalytics(c *gin.Context) {
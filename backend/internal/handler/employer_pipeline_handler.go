@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"job-platform/internal/domain"
+	"job-platform/internal/dto"
+	"job-platform/internal/service"
+	"job-platform/internal/util/response"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// EmployerPipelineHandler handles company hiring pipeline configuration
+type EmployerPipelineHandler struct {
+	pipelineService *service.PipelineService
+}
+
+// NewEmployerPipelineHandler creates a new employer pipeline handler
+func NewEmployerPipelineHandler(pipelineService *service.PipelineService) *EmployerPipelineHandler {
+	return &EmployerPipelineHandler{
+		pipelineService: pipelineService,
+	}
+}
+
+// GetPipeline retrieves the company's pipeline stages
+// GET /api/v1/employer/company/pipeline
+func (h *EmployerPipelineHandler) GetPipeline(c *gin.Context) {
+	companyID, _ := c.Get("company_id")
+	cid := companyID.(uuid.UUID)
+
+	pipeline, err := h.pipelineService.GetCompanyPipeline(cid)
+	if err != nil {
+		response.InternalError(c, err)
+		return
+	}
+
+	response.OK(c, "Pipeline retrieved successfully", dto.ToPipelineResponse(pipeline))
+}
+
+// UpdatePipeline replaces the company's pipeline stages
+// PUT /api/v1/employer/company/pipeline
+func (h *EmployerPipelineHandler) UpdatePipeline(c *gin.Context) {
+	companyID, _ := c.Get("company_id")
+	cid := companyID.(uuid.UUID)
+
+	var req dto.UpdatePipelineRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	inputs := make([]service.PipelineStageInput, 0, len(req.Stages))
+	for _, stage := range req.Stages {
+		input := service.PipelineStageInput{
+			Name:   stage.Name,
+			Status: domain.ApplicationStatus(stage.Status),
+		}
+		if stage.ID != nil {
+			id, err := uuid.Parse(*stage.ID)
+			if err != nil {
+				response.BadRequest(c, domain.ErrPipelineStageNotFound)
+				return
+			}
+			input.ID = &id
+		}
+		inputs = append(inputs, input)
+	}
+
+	pipeline, err := h.pipelineService.UpdateCompanyPipeline(cid, inputs)
+	if err != nil {
+		switch err {
+		case domain.ErrInvalidPipeline, domain.ErrInvalidPipelineStatus, domain.ErrPipelineStageNotFound, domain.ErrPipelineMissingStages:
+			response.BadRequest(c, err)
+		default:
+			response.InternalError(c, err)
+		}
+		return
+	}
+
+	response.OK(c, "Pipeline updated successfully", dto.ToPipelineResponse(pipeline))
+}
+
+// ResetPipeline reverts the company to the default pipeline
+// DELETE /api/v1/employer/company/pipeline
+func (h *EmployerPipelineHandler) ResetPipeline(c *gin.Context) {
+	companyID, _ := c.Get("company_id")
+	cid := companyID.(uuid.UUID)
+
+	pipeline, err := h.pipelineService.ResetCompanyPipeline(cid)
+	if err != nil {
+		response.InternalError(c, err)
+		return
+	}
+
+	response.OK(c, "Pipeline reset to default", dto.ToPipelineResponse(pipeline))
+}
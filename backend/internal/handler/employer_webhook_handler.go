@@ -0,0 +1,274 @@
+package handler
+
+import (
+	"net/http"
+
+	"job-platform/internal/domain"
+	"job-platform/internal/dto"
+	"job-platform/internal/middleware"
+	"job-platform/internal/service"
+	"job-platform/internal/util/response"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// EmployerWebhookHandler handles the outbound webhooks of a company
+type EmployerWebhookHandler struct {
+	webhookService *service.WebhookService
+}
+
+// NewEmployerWebhookHandler creates a new employer webhook handler
+func NewEmployerWebhookHandler(webhookService *service.WebhookService) *EmployerWebhookHandler {
+	return &EmployerWebhookHandler{
+		webhookService: webhookService,
+	}
+}
+
+// GetWebhooks retrieves the company's webhooks
+// GET /api/v1/employer/company/webhooks
+func (h *EmployerWebhookHandler) GetWebhooks(c *gin.Context) {
+	companyID, _ := c.Get("company_id")
+	cid := companyID.(uuid.UUID)
+
+	webhooks, err := h.webhookService.GetWebhooks(cid)
+	if err != nil {
+		response.InternalError(c, err)
+		return
+	}
+
+	response.OK(c, "Webhooks retrieved successfully", dto.ToWebhookResponses(webhooks))
+}
+
+// GetWebhookEvents lists the events webhooks can subscribe to
+// GET /api/v1/employer/company/webhooks/events
+func (h *EmployerWebhookHandler) GetWebhookEvents(c *gin.Context) {
+	response.OK(c, "Webhook events retrieved successfully", domain.WebhookEvents)
+}
+
+// CreateWebhook registers a webhook. The signing secret is only returned here and on rotation.
+// POST /api/v1/employer/company/webhooks
+func (h *EmployerWebhookHandler) CreateWebhook(c *gin.Context) {
+	companyID, _ := c.Get("company_id")
+	cid := companyID.(uuid.UUID)
+
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	var req dto.CreateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	webhook, err := h.webhookService.CreateWebhook(cid, user.ID, service.WebhookInput{
+		URL:         &req.URL,
+		Description: &req.Description,
+		Events:      req.Events,
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.Created(c, "Webhook created successfully", dto.ToWebhookSecretResponse(webhook))
+}
+
+// GetWebhook retrieves a webhook
+// GET /api/v1/employer/company/webhooks/:id
+func (h *EmployerWebhookHandler) GetWebhook(c *gin.Context) {
+	companyID, _ := c.Get("company_id")
+	cid := companyID.(uuid.UUID)
+
+	webhookID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	webhook, err := h.webhookService.GetWebhook(webhookID, cid)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Webhook retrieved successfully", dto.ToWebhookResponse(webhook))
+}
+
+// UpdateWebhook updates a webhook's URL, description, events or active state
+// PUT /api/v1/employer/company/webhooks/:id
+func (h *EmployerWebhookHandler) UpdateWebhook(c *gin.Context) {
+	companyID, _ := c.Get("company_id")
+	cid := companyID.(uuid.UUID)
+
+	webhookID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	var req dto.UpdateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	webhook, err := h.webhookService.UpdateWebhook(webhookID, cid, service.WebhookInput{
+		URL:         req.URL,
+		Description: req.Description,
+		Events:      req.Events,
+		IsActive:    req.IsActive,
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Webhook updated successfully", dto.ToWebhookResponse(webhook))
+}
+
+// DeleteWebhook deletes a webhook and its delivery log
+// DELETE /api/v1/employer/company/webhooks/:id
+func (h *EmployerWebhookHandler) DeleteWebhook(c *gin.Context) {
+	companyID, _ := c.Get("company_id")
+	cid := companyID.(uuid.UUID)
+
+	webhookID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	if err := h.webhookService.DeleteWebhook(webhookID, cid); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Webhook deleted successfully", nil)
+}
+
+// RotateSecret replaces a webhook's signing secret
+// POST /api/v1/employer/company/webhooks/:id/rotate-secret
+func (h *EmployerWebhookHandler) RotateSecret(c *gin.Context) {
+	companyID, _ := c.Get("company_id")
+	cid := companyID.(uuid.UUID)
+
+	webhookID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	webhook, err := h.webhookService.RotateSecret(webhookID, cid)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Webhook secret rotated successfully", dto.ToWebhookSecretResponse(webhook))
+}
+
+// GetDeliveries retrieves a webhook's delivery log, optionally filtered by status
+// GET /api/v1/employer/company/webhooks/:id/deliveries
+func (h *EmployerWebhookHandler) GetDeliveries(c *gin.Context) {
+	companyID, _ := c.Get("company_id")
+	cid := companyID.(uuid.UUID)
+
+	webhookID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	var status *domain.WebhookDeliveryStatus
+	if s := c.Query("status"); s != "" {
+		deliveryStatus := domain.WebhookDeliveryStatus(s)
+		if !deliveryStatus.IsValid() {
+			response.BadRequest(c, domain.ErrInvalidInput)
+			return
+		}
+		status = &deliveryStatus
+	}
+
+	page, limit := parsePageLimit(c, 20)
+
+	deliveries, total, err := h.webhookService.GetDeliveries(webhookID, cid, status, limit, (page-1)*limit)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.Paginated(c, "Webhook deliveries retrieved successfully", dto.ToWebhookDeliveryResponses(deliveries), paginationMeta(page, limit, total))
+}
+
+// GetDelivery retrieves a delivery of a webhook
+// GET /api/v1/employer/company/webhooks/:id/deliveries/:delivery_id
+func (h *EmployerWebhookHandler) GetDelivery(c *gin.Context) {
+	companyID, _ := c.Get("company_id")
+	cid := companyID.(uuid.UUID)
+
+	webhookID, deliveryID, ok := h.parseDeliveryIDs(c)
+	if !ok {
+		return
+	}
+
+	delivery, err := h.webhookService.GetDelivery(webhookID, deliveryID, cid)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Webhook delivery retrieved successfully", dto.ToWebhookDeliveryResponse(delivery))
+}
+
+// ReplayDelivery sends a delivery's event again as a new delivery
+// POST /api/v1/employer/company/webhooks/:id/deliveries/:delivery_id/replay
+func (h *EmployerWebhookHandler) ReplayDelivery(c *gin.Context) {
+	companyID, _ := c.Get("company_id")
+	cid := companyID.(uuid.UUID)
+
+	webhookID, deliveryID, ok := h.parseDeliveryIDs(c)
+	if !ok {
+		return
+	}
+
+	delivery, err := h.webhookService.ReplayDelivery(webhookID, deliveryID, cid)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.Created(c, "Webhook delivery replayed", dto.ToWebhookDeliveryResponse(delivery))
+}
+
+// parseDeliveryIDs parses the webhook and delivery IDs of a delivery route
+func (h *EmployerWebhookHandler) parseDeliveryIDs(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	webhookID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return uuid.Nil, uuid.Nil, false
+	}
+	deliveryID, err := uuid.Parse(c.Param("delivery_id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return uuid.Nil, uuid.Nil, false
+	}
+	return webhookID, deliveryID, true
+}
+
+// handleError maps webhook errors to HTTP responses
+func (h *EmployerWebhookHandler) handleError(c *gin.Context, err error) {
+	switch err {
+	case domain.ErrWebhookNotFound, domain.ErrWebhookDeliveryNotFound:
+		response.NotFound(c, err)
+	case domain.ErrInvalidWebhookURL, domain.ErrInvalidWebhookEvents:
+		response.BadRequest(c, err)
+	case domain.ErrWebhookLimitReached:
+		response.Error(c, http.StatusConflict, err, nil)
+	default:
+		response.InternalError(c, err)
+	}
+}
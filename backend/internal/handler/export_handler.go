@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+	"strings"
+
+	"job-platform/internal/domain"
+	"job-platform/internal/middleware"
+	"job-platform/internal/service"
+	"job-platform/internal/util/response"
+	"job-platform/internal/util/spreadsheet"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ExportHandler handles CSV and XLSX exports of applications and saved candidates
+type ExportHandler struct {
+	exportService *service.ExportService
+}
+
+// NewExportHandler creates a new export handler
+func NewExportHandler(exportService *service.ExportService) *ExportHandler {
+	return &ExportHandler{
+		exportService: exportService,
+	}
+}
+
+// ExportJobApplications downloads the applications of a job, optionally filtered by status
+// GET /api/v1/employer/jobs/:id/applications/export?format=csv|xlsx&status=
+func (h *ExportHandler) ExportJobApplications(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	jobID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidJobID)
+		return
+	}
+
+	format, ok := parseExportFormat(c)
+	if !ok {
+		return
+	}
+
+	export, err := h.exportService.ExportJobApplications(jobID, user.ID, strings.ToUpper(c.Query("status")))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	h.stream(c, format, export)
+}
+
+// ExportCompanyApplications downloads the applications to all jobs of the company, optionally
+// filtered by status
+// GET /api/v1/employer/company/applications/export?format=csv|xlsx&status=
+func (h *ExportHandler) ExportCompanyApplications(c *gin.Context) {
+	companyID, _ := c.Get("company_id")
+	cid := companyID.(uuid.UUID)
+
+	format, ok := parseExportFormat(c)
+	if !ok {
+		return
+	}
+
+	export, err := h.exportService.ExportCompanyApplications(cid, strings.ToUpper(c.Query("status")))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	h.stream(c, format, export)
+}
+
+// ExportSavedCandidates downloads the saved candidates, optionally of one folder. An empty
+// folder exports the candidates without a folder.
+// GET /api/v1/employer/saved-candidates/export?format=csv|xlsx&folder=
+func (h *ExportHandler) ExportSavedCandidates(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	format, ok := parseExportFormat(c)
+	if !ok {
+		return
+	}
+
+	var folder *string
+	if f, exists := c.GetQuery("folder"); exists {
+		f = strings.TrimSpace(f)
+		folder = &f
+	}
+
+	export, err := h.exportService.ExportSavedCandidates(user.ID, folder)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	h.stream(c, format, export)
+}
+
+// stream writes an export as the response. Rows are loaded while the file is written, so once
+// streaming started errors can only be logged.
+func (h *ExportHandler) stream(c *gin.Context, format spreadsheet.Format, export *service.Export) {
+	c.Header("Content-Disposition", "attachment; filename="+export.Filename+"."+string(format))
+	c.Header("Content-Type", spreadsheet.ContentType(format))
+	c.Status(http.StatusOK)
+
+	w, err := spreadsheet.NewWriter(format, c.Writer)
+	if err == nil {
+		err = export.Write(w)
+	}
+	if err != nil {
+		log.Printf("Failed to write export %s: %v", export.Filename, err)
+	}
+}
+
+// handleError maps export errors to HTTP responses
+func (h *ExportHandler) handleError(c *gin.Context, err error) {
+	switch err {
+	case domain.ErrJobNotFound:
+		response.NotFound(c, err)
+	case domain.ErrJobNotOwnedByEmployer:
+		response.Forbidden(c, err)
+	default:
+		response.InternalError(c, err)
+	}
+}
+
+// parseExportFormat reads the format query parameter, csv by default. It responds with an
+// error and returns false for an unknown format.
+func parseExportFormat(c *gin.Context) (spreadsheet.Format, bool) {
+	switch strings.ToLower(c.DefaultQuery("format", "csv")) {
+	case "csv":
+		return spreadsheet.FormatCSV, true
+	case "xlsx":
+		return spreadsheet.FormatXLSX, true
+	default:
+		response.BadRequest(c, domain.ErrInvalidExportFormat)
+		return "", false
+	}
+}
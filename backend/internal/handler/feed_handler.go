@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"job-platform/internal/cache"
+	"job-platform/internal/domain"
+	"job-platform/internal/repository"
+	"job-platform/internal/service"
+	"job-platform/internal/util/response"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxCachedFeedSize is the largest rendered feed kept in Redis, bigger feeds are streamed on every request
+const maxCachedFeedSize = 32 << 20
+
+// FeedHandler serves XML job feeds for aggregators and feed readers
+type FeedHandler struct {
+	feedService  *service.FeedService
+	cacheService *cache.CacheService
+	baseURL      string // Public URL the feeds are served from
+}
+
+// NewFeedHandler creates a new feed handler. Feeds link to themselves under baseURL, which comes
+// from configuration so request headers can't change cached feeds.
+func NewFeedHandler(feedService *service.FeedService, cacheService *cache.CacheService, baseURL string) *FeedHandler {
+	return &FeedHandler{
+		feedService:  feedService,
+		cacheService: cacheService,
+		baseURL:      baseURL,
+	}
+}
+
+// GetFeed renders active jobs as an Indeed, LinkedIn, RSS or Atom feed.
+// Query: category (slug), company (slug), location, limit (RSS/Atom only)
+// GET /api/v1/feeds/:format
+func (h *FeedHandler) GetFeed(c *gin.Context) {
+	format := service.FeedFormat(strings.TrimSuffix(strings.ToLower(c.Param("format")), ".xml"))
+	if !format.IsValid() {
+		response.BadRequest(c, domain.ErrInvalidFeedFormat)
+		return
+	}
+
+	filters := repository.FeedFilters{
+		CategorySlug: c.Query("category"),
+		CompanySlug:  c.Query("company"),
+		Location:     strings.TrimSpace(c.Query("location")),
+	}
+	// Aggregators get every active job, feed readers only the latest ones
+	if format.IsSyndication() {
+		filters.Limit = service.DefaultFeedItems
+		if limit, err := strconv.Atoi(c.Query("limit")); err == nil && limit > 0 && limit <= service.MaxFeedItems {
+			filters.Limit = limit
+		}
+	}
+
+	ctx := context.Background()
+	cacheKey := fmt.Sprintf("%s:%s:%s:%d", format, filters.CategorySlug, filters.CompanySlug, filters.Limit)
+	cacheAvailable := h.cacheService != nil && h.cacheService.IsAvailable() &&
+		h.feedService.IsCacheable(format, filters)
+
+	if cacheAvailable {
+		if data, err := h.cacheService.GetCachedFeed(ctx, cacheKey); err == nil {
+			c.Data(http.StatusOK, format.ContentType(), data)
+			return
+		}
+	}
+
+	// Stream the feed to the client and keep a copy for the cache
+	buf := &feedBuffer{limit: maxCachedFeedSize}
+	var w io.Writer = c.Writer
+	if cacheAvailable {
+		w = io.MultiWriter(c.Writer, buf)
+	}
+
+	c.Header("Content-Type", format.ContentType())
+	c.Status(http.StatusOK)
+	if err := h.feedService.WriteFeed(w, format, filters, h.feedSelfURL(format, filters)); err != nil {
+		// Once the feed started the status can't change, the client gets a truncated document
+		if !c.Writer.Written() {
+			response.InternalError(c, err)
+		}
+		return
+	}
+
+	if cacheAvailable && !buf.overflow {
+		_ = h.cacheService.CacheFeed(ctx, cacheKey, buf.Bytes())
+	}
+}
+
+// feedSelfURL returns the public URL of a feed. It is built from the parsed filters, the same
+// values the cache key is built from, so a cached feed links to itself for every request it serves.
+// Feeds filtered by location are never cached.
+func (h *FeedHandler) feedSelfURL(format service.FeedFormat, filters repository.FeedFilters) string {
+	query := url.Values{}
+	if filters.CategorySlug != "" {
+		query.Set("category", filters.CategorySlug)
+	}
+	if filters.CompanySlug != "" {
+		query.Set("company", filters.CompanySlug)
+	}
+	if filters.Location != "" {
+		query.Set("location", filters.Location)
+	}
+	if format.IsSyndication() && filters.Limit != service.DefaultFeedItems {
+		query.Set("limit", strconv.Itoa(filters.Limit))
+	}
+
+	selfURL := h.baseURL + "/" + string(format)
+	if len(query) > 0 {
+		selfURL += "?" + query.Encode()
+	}
+	return selfURL
+}
+
+// feedBuffer buffers a feed for caching and stops once it grows past its limit
+type feedBuffer struct {
+	bytes.Buffer
+	limit    int
+	overflow bool
+}
+
+// Write buffers p unless the limit was reached. It never fails, so the response stream isn't interrupted.
+func (b *feedBuffer) Write(p []byte) (int, error) {
+	if b.overflow {
+		return len(p), nil
+	}
+	if b.Len()+len(p) > b.limit {
+		b.overflow = true
+		b.Reset()
+		return len(p), nil
+	}
+	return b.Buffer.Write(p)
+}
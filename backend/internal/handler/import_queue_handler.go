@@ -1,9 +1,9 @@
 package handler
 
 import (
-	"context"
 	"net/http"
 
+	"job-platform/internal/domain"
 	"job-platform/internal/service"
 
 	"github.com/gin-gonic/gin"
@@ -56,11 +56,14 @@ func (h *ImportQueueHandler) CreateQueue(c *gin.Context) {
 	}
 
 	// Create the queue with admin ID
-	queue := h.importQueueService.CreateQueue(adminID, req.SourceURL, req.URLs, req.Titles)
+	queue, err := h.importQueueService.CreateQueue(adminID, req.SourceURL, req.URLs, req.Titles)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create queue"})
+		return
+	}
 
 	// Start processing in background
-	ctx := context.Background()
-	h.importQueueService.StartQueue(ctx, queue.ID)
+	h.importQueueService.StartQueue(queue.ID.String())
 
 	c.JSON(http.StatusCreated, gin.H{
 		"success": true,
@@ -76,9 +79,13 @@ func (h *ImportQueueHandler) GetQueue(c *gin.Context) {
 		return
 	}
 
-	queue := h.importQueueService.GetQueue(queueID)
-	if queue == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Queue not found"})
+	queue, err := h.importQueueService.GetQueue(queueID)
+	if err != nil {
+		if err == domain.ErrImportQueueNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Queue not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get queue"})
 		return
 	}
 
@@ -90,7 +97,12 @@ func (h *ImportQueueHandler) GetQueue(c *gin.Context) {
 
 // GetAllQueues returns all queues
 func (h *ImportQueueHandler) GetAllQueues(c *gin.Context) {
-	queues := h.importQueueService.GetAllQueues()
+	queues, err := h.importQueueService.GetAllQueues()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get queues"})
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{
 		"success": true,
 		"queues":  queues,
@@ -167,8 +179,7 @@ func (h *ImportQueueHandler) RetryJob(c *gin.Context) {
 		return
 	}
 
-	ctx := context.Background()
-	if h.importQueueService.RetryJob(ctx, queueID, req.JobID) {
+	if h.importQueueService.RetryJob(queueID, req.JobID) {
 		c.JSON(http.StatusOK, gin.H{
 			"success": true,
 			"message": "Job queued for retry",
@@ -188,8 +199,7 @@ func (h *ImportQueueHandler) RetryFailedJobs(c *gin.Context) {
 		return
 	}
 
-	ctx := context.Background()
-	if h.importQueueService.RetryFailedJobs(ctx, queueID) {
+	if h.importQueueService.RetryFailedJobs(queueID) {
 		c.JSON(http.StatusOK, gin.H{
 			"success": true,
 			"message": "Failed jobs queued for retry",
@@ -240,7 +250,11 @@ func (h *ImportQueueHandler) StartExtraction(c *gin.Context) {
 	}
 
 	// Start extraction in background
-	task := h.importQueueService.StartLinkExtraction(req.URL)
+	task, err := h.importQueueService.StartLinkExtraction(req.URL)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to start extraction"})
+		return
+	}
 
 	c.JSON(http.StatusCreated, gin.H{
 		"success": true,
@@ -256,9 +270,13 @@ func (h *ImportQueueHandler) GetExtractionTask(c *gin.Context) {
 		return
 	}
 
-	task := h.importQueueService.GetExtractionTask(taskID)
-	if task == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
+	task, err := h.importQueueService.GetExtractionTask(taskID)
+	if err != nil {
+		if err == domain.ErrExtractionTaskNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get task"})
 		return
 	}
 
@@ -270,7 +288,12 @@ func (h *ImportQueueHandler) GetExtractionTask(c *gin.Context) {
 
 // GetAllExtractionTasks returns all extraction tasks
 func (h *ImportQueueHandler) GetAllExtractionTasks(c *gin.Context) {
-	tasks := h.importQueueService.GetAllExtractionTasks()
+	tasks, err := h.importQueueService.GetAllExtractionTasks()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get tasks"})
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{
 		"success": true,
 		"tasks":   tasks,
@@ -0,0 +1,315 @@
+package handler
+
+import (
+	"job-platform/internal/domain"
+	"job-platform/internal/dto"
+	"job-platform/internal/middleware"
+	"job-platform/internal/service"
+	"job-platform/internal/util/response"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// InterviewHandler handles interview scheduling for employers and candidates
+type InterviewHandler struct {
+	interviewService *service.InterviewService
+}
+
+// NewInterviewHandler creates a new interview handler
+func NewInterviewHandler(interviewService *service.InterviewService) *InterviewHandler {
+	return &InterviewHandler{
+		interviewService: interviewService,
+	}
+}
+
+// ============================================================
+// EMPLOYER ENDPOINTS
+// ============================================================
+
+// CreateInterview schedules an interview for an application
+// POST /api/v1/employer/applications/:id/interviews
+func (h *InterviewHandler) CreateInterview(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	appID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	var req dto.CreateInterviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	interviewerIDs := make([]uuid.UUID, 0, len(req.InterviewerIDs))
+	for _, idStr := range req.InterviewerIDs {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			response.BadRequest(c, domain.ErrInvalidInterviewer)
+			return
+		}
+		interviewerIDs = append(interviewerIDs, id)
+	}
+
+	interview, err := h.interviewService.CreateInterview(appID, user.ID, service.CreateInterviewInput{
+		Title:           req.Title,
+		Type:            domain.InterviewType(req.Type),
+		DurationMinutes: req.DurationMinutes,
+		Location:        req.Location,
+		MeetingURL:      req.MeetingURL,
+		Notes:           req.Notes,
+		Timezone:        req.Timezone,
+		InterviewerIDs:  interviewerIDs,
+		Slots:           req.Slots,
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.Created(c, "Interview created successfully", dto.ToInterviewResponse(interview, true))
+}
+
+// GetApplicationInterviews retrieves the interviews of an application
+// GET /api/v1/employer/applications/:id/interviews
+func (h *InterviewHandler) GetApplicationInterviews(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	appID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	interviews, err := h.interviewService.GetApplicationInterviews(appID, user.ID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Interviews retrieved successfully", dto.ToInterviewResponses(interviews, true))
+}
+
+// GetInterview retrieves an interview
+// GET /api/v1/employer/interviews/:id
+func (h *InterviewHandler) GetInterview(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	interviewID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	interview, err := h.interviewService.GetInterview(interviewID, user.ID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Interview retrieved successfully", dto.ToInterviewResponse(interview, true))
+}
+
+// RescheduleInterview proposes new times for an interview
+// POST /api/v1/employer/interviews/:id/reschedule
+func (h *InterviewHandler) RescheduleInterview(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	interviewID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	var req dto.RescheduleInterviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	interview, err := h.interviewService.RescheduleInterview(interviewID, user.ID, service.RescheduleInterviewInput{
+		Slots:  req.Slots,
+		Reason: req.Reason,
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Interview rescheduled successfully", dto.ToInterviewResponse(interview, true))
+}
+
+// CancelInterview cancels an interview
+// POST /api/v1/employer/interviews/:id/cancel
+func (h *InterviewHandler) CancelInterview(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	interviewID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	var req dto.CancelInterviewRequest
+	_ = c.ShouldBindJSON(&req) // Reason is optional
+
+	interview, err := h.interviewService.CancelInterview(interviewID, user.ID, req.Reason)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Interview cancelled successfully", dto.ToInterviewResponse(interview, true))
+}
+
+// CompleteInterview marks an interview as held
+// POST /api/v1/employer/interviews/:id/complete
+func (h *InterviewHandler) CompleteInterview(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	interviewID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	interview, err := h.interviewService.CompleteInterview(interviewID, user.ID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Interview marked as completed", dto.ToInterviewResponse(interview, true))
+}
+
+// ============================================================
+// JOB SEEKER ENDPOINTS
+// ============================================================
+
+// GetMyApplicationInterviews retrieves the interviews of one of the candidate's applications
+// GET /api/v1/jobseeker/me/applications/:id/interviews
+func (h *InterviewHandler) GetMyApplicationInterviews(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	appID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	interviews, err := h.interviewService.GetCandidateInterviews(appID, user.ID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Interviews retrieved successfully", dto.ToInterviewResponses(interviews, false))
+}
+
+// SelectSlot confirms one of the proposed interview times
+// POST /api/v1/jobseeker/me/interviews/:id/select-slot
+func (h *InterviewHandler) SelectSlot(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	interviewID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	var req dto.SelectInterviewSlotRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+	slotID, _ := uuid.Parse(req.SlotID)
+
+	interview, err := h.interviewService.SelectSlot(interviewID, user.ID, slotID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Interview scheduled successfully", dto.ToInterviewResponse(interview, false))
+}
+
+// ============================================================
+// SHARED ENDPOINTS
+// ============================================================
+
+// DownloadCalendarInvite downloads the .ics file of a scheduled interview
+// GET /api/v1/interviews/:id/calendar.ics
+func (h *InterviewHandler) DownloadCalendarInvite(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	interviewID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	invite, err := h.interviewService.GetCalendarInvite(interviewID, user.ID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", "attachment; filename="+invite.Filename)
+	c.Data(http.StatusOK, invite.ContentType, invite.Content)
+}
+
+// handleError maps interview errors to HTTP responses
+func (h *InterviewHandler) handleError(c *gin.Context, err error) {
+	switch err {
+	case domain.ErrApplicationNotFound, domain.ErrInterviewNotFound, domain.ErrInterviewSlotNotFound:
+		response.NotFound(c, err)
+	case domain.ErrJobNotOwnedByEmployer:
+		response.Forbidden(c, err)
+	case domain.ErrInvalidInterviewSlots, domain.ErrInvalidInterviewer, domain.ErrInvalidTimezone,
+		domain.ErrInterviewNotOpen, domain.ErrInterviewAlreadyBooked, domain.ErrInterviewNotScheduled,
+		domain.ErrApplicationClosed, domain.ErrInvalidApplicationStatus:
+		response.BadRequest(c, err)
+	default:
+		response.InternalError(c, err)
+	}
+}
@@ -218,6 +218,12 @@ func (h *JobHandler) GetJobBySlug(c *gin.Context) {
 		return
 	}
 
+	// Drafts and jobs waiting for approval or their publish time are not public yet
+	if job.IsUnpublished() {
+		response.NotFound(c, domain.ErrJobNotFound)
+		return
+	}
+
 	// Increment view count via Redis cache (faster, batched sync to DB later)
 	if h.cacheService != nil && h.cacheService.IsAvailable() {
 		_, _ = h.cacheService.IncrementViewCount(ctx, "job", job.ID.String())
@@ -231,6 +237,7 @@ func (h *JobHandler) GetJobBySlug(c *gin.Context) {
 
 	// Convert to response
 	jobResponse := dto.ToJobResponse(job, userID)
+	jobResponse.HideScreeningRules()
 
 	// Check if saved by user
 	if userID != nil {
@@ -405,7 +412,8 @@ func (h *JobHandler) GetLocations(c *gin.Context) {
 	})
 }
 
-// GetJobsForSitemap retrieves all active jobs for sitemap generation
+// GetJobsForSitemap retrieves all active jobs for sitemap generation.
+// Deprecated: crawlers should use the XML sitemaps served at /api/v1/sitemaps/sitemap.xml.
 // GET /api/v1/jobs/sitemap
 func (h *JobHandler) GetJobsForSitemap(c *gin.Context) {
 	// Get all active jobs with minimal data for sitemap
@@ -104,6 +104,10 @@ func (h *JobSeekerHandler) ApplyToJob(c *gin.Context) {
 			response.BadRequest(c, err)
 			return
 		}
+		if err == domain.ErrScreeningAnswerRequired || err == domain.ErrInvalidScreeningAnswer {
+			response.BadRequest(c, err)
+			return
+		}
 		response.InternalError(c, err)
 		return
 	}
@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"net/http"
+
+	"job-platform/internal/domain"
+	"job-platform/internal/dto"
+	"job-platform/internal/middleware"
+	"job-platform/internal/service"
+	"job-platform/internal/util/response"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// JobUploadHandler handles bulk job uploads. The same handler serves employers and admins,
+// with the mode deciding which columns apply and who the jobs are created as.
+type JobUploadHandler struct {
+	jobUploadService *service.JobUploadService
+	mode             domain.JobUploadMode
+}
+
+// NewJobUploadHandler creates a new job upload handler for a mode
+func NewJobUploadHandler(jobUploadService *service.JobUploadService, mode domain.JobUploadMode) *JobUploadHandler {
+	return &JobUploadHandler{
+		jobUploadService: jobUploadService,
+		mode:             mode,
+	}
+}
+
+// GetColumns documents the columns of upload files
+// GET /api/v1/employer/jobs/bulk-upload/columns
+// GET /api/v1/admin/jobs/bulk-upload/columns
+func (h *JobUploadHandler) GetColumns(c *gin.Context) {
+	response.OK(c, "Job upload columns retrieved successfully", service.GetJobUploadColumns(h.mode))
+}
+
+// DownloadTemplate downloads an empty CSV upload file with the header row
+// GET /api/v1/employer/jobs/bulk-upload/template
+// GET /api/v1/admin/jobs/bulk-upload/template
+func (h *JobUploadHandler) DownloadTemplate(c *gin.Context) {
+	template, err := service.JobUploadTemplate(h.mode)
+	if err != nil {
+		response.InternalError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", "attachment; filename=job-upload-template.csv")
+	c.Data(http.StatusOK, "text/csv; charset=utf-8", template)
+}
+
+// UploadJobs validates a CSV or XLSX file of jobs and returns a report of every row.
+// No jobs are created until the upload is confirmed.
+// POST /api/v1/employer/jobs/bulk-upload
+// POST /api/v1/admin/jobs/bulk-upload
+func (h *JobUploadHandler) UploadJobs(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidJobUploadFile)
+		return
+	}
+
+	upload, err := h.jobUploadService.ValidateUpload(user.ID, h.mode, file)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.Created(c, "Job upload validated successfully", dto.ToJobUploadResponse(upload))
+}
+
+// GetUploads retrieves the current user's uploads
+// GET /api/v1/employer/jobs/bulk-upload
+// GET /api/v1/admin/jobs/bulk-upload
+func (h *JobUploadHandler) GetUploads(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	page, limit := parsePageLimit(c, 20)
+
+	uploads, total, err := h.jobUploadService.GetUploads(user.ID, h.mode, limit, (page-1)*limit)
+	if err != nil {
+		response.InternalError(c, err)
+		return
+	}
+
+	response.Paginated(c, "Job uploads retrieved successfully", dto.ToJobUploadResponses(uploads), paginationMeta(page, limit, total))
+}
+
+// GetUpload retrieves an upload with the validation and creation result of every row
+// GET /api/v1/employer/jobs/bulk-upload/:id
+// GET /api/v1/admin/jobs/bulk-upload/:id
+func (h *JobUploadHandler) GetUpload(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	uploadID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	upload, err := h.jobUploadService.GetUpload(uploadID, user.ID, h.mode)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Job upload retrieved successfully", dto.ToJobUploadResponse(upload))
+}
+
+// ConfirmUpload starts creating the jobs of the valid rows of an upload in the background
+// POST /api/v1/employer/jobs/bulk-upload/:id/confirm
+// POST /api/v1/admin/jobs/bulk-upload/:id/confirm
+func (h *JobUploadHandler) ConfirmUpload(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	uploadID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	upload, err := h.jobUploadService.ConfirmUpload(uploadID, user.ID, h.mode)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusAccepted, "Job upload confirmed, jobs are being created", dto.ToJobUploadResponse(upload))
+}
+
+// handleError maps job upload errors to HTTP responses
+func (h *JobUploadHandler) handleError(c *gin.Context, err error) {
+	switch err {
+	case domain.ErrJobUploadNotFound:
+		response.NotFound(c, err)
+	case domain.ErrInvalidJobUploadFile, domain.ErrJobUploadTooManyRows, domain.ErrJobUploadHasNoValidRows:
+		response.BadRequest(c, err)
+	case domain.ErrJobUploadTooLarge:
+		response.Error(c, http.StatusRequestEntityTooLarge, err, nil)
+	case domain.ErrJobUploadNotValidated:
+		response.Error(c, http.StatusConflict, err, nil)
+	default:
+		response.InternalError(c, err)
+	}
+}
@@ -0,0 +1,296 @@
+package handler
+
+import (
+	"job-platform/internal/domain"
+	"job-platform/internal/dto"
+	"job-platform/internal/middleware"
+	"job-platform/internal/service"
+	"job-platform/internal/util/response"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// MessageHandler handles messaging between employers and applicants
+type MessageHandler struct {
+	messageService *service.MessageService
+}
+
+// NewMessageHandler creates a new message handler
+func NewMessageHandler(messageService *service.MessageService) *MessageHandler {
+	return &MessageHandler{
+		messageService: messageService,
+	}
+}
+
+// GetConversations lists the current user's conversations
+// GET /api/v1/me/conversations
+func (h *MessageHandler) GetConversations(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	page, limit := parsePageLimit(c, 20)
+
+	conversations, total, err := h.messageService.GetConversations(user.ID, page, limit)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	responses := make([]dto.ConversationResponse, len(conversations))
+	for i := range conversations {
+		responses[i] = dto.ToConversationResponse(&conversations[i], user.ID)
+	}
+
+	response.Paginated(c, "Conversations retrieved successfully", responses, paginationMeta(page, limit, total))
+}
+
+// StartConversation opens (or creates) the conversation of an application
+// POST /api/v1/me/conversations
+func (h *MessageHandler) StartConversation(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	var req dto.StartConversationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	appID, err := uuid.Parse(req.ApplicationID)
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	conversation, err := h.messageService.StartConversation(appID, user.ID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Conversation retrieved successfully", dto.ToConversationResponse(conversation, user.ID))
+}
+
+// GetUnreadCount retrieves the number of unread messages
+// GET /api/v1/me/conversations/unread
+func (h *MessageHandler) GetUnreadCount(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	count, err := h.messageService.GetUnreadCount(user.ID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Unread count retrieved successfully", gin.H{"unread_count": count})
+}
+
+// GetConversation retrieves a conversation
+// GET /api/v1/me/conversations/:id
+func (h *MessageHandler) GetConversation(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	conversationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	conversation, err := h.messageService.GetConversation(conversationID, user.ID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Conversation retrieved successfully", dto.ToConversationResponse(conversation, user.ID))
+}
+
+// GetMessages retrieves a page of messages, newest first, and marks them as read
+// GET /api/v1/me/conversations/:id/messages
+func (h *MessageHandler) GetMessages(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	conversationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	page, limit := parsePageLimit(c, 50)
+
+	messages, total, err := h.messageService.GetMessages(conversationID, user.ID, page, limit)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	responses := make([]dto.MessageResponse, len(messages))
+	for i := range messages {
+		responses[i] = dto.ToMessageResponse(&messages[i], user.ID)
+	}
+
+	response.Paginated(c, "Messages retrieved successfully", responses, paginationMeta(page, limit, total))
+}
+
+// SendMessage sends a message. Attachments are sent as multipart "attachments" files.
+// POST /api/v1/me/conversations/:id/messages
+func (h *MessageHandler) SendMessage(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	conversationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	var input service.SendMessageInput
+	if strings.HasPrefix(c.ContentType(), "multipart/") {
+		form, err := c.MultipartForm()
+		if err != nil {
+			response.BadRequest(c, err)
+			return
+		}
+		input.Body = c.PostForm("body")
+		input.Files = form.File["attachments"]
+	} else {
+		var req struct {
+			Body string `json:"body"`
+		}
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.BadRequest(c, err)
+			return
+		}
+		input.Body = req.Body
+	}
+
+	message, err := h.messageService.SendMessage(conversationID, user.ID, input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.Created(c, "Message sent successfully", dto.ToMessageResponse(message, user.ID))
+}
+
+// MarkRead marks the messages received in a conversation as read
+// PUT /api/v1/me/conversations/:id/read
+func (h *MessageHandler) MarkRead(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	conversationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	if err := h.messageService.MarkRead(conversationID, user.ID); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Conversation marked as read", nil)
+}
+
+// DownloadAttachment generates a download URL for a message attachment
+// GET /api/v1/me/conversations/:id/attachments/:attachmentId
+func (h *MessageHandler) DownloadAttachment(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	conversationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+	attachmentID, err := uuid.Parse(c.Param("attachmentId"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	attachment, url, err := h.messageService.GetAttachmentURL(conversationID, attachmentID, user.ID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Download URL generated successfully", dto.MessageAttachmentDownloadResponse{
+		DownloadURL: url,
+		ExpiresAt:   time.Now().Add(service.MessageAttachmentURLExpiry),
+		FileName:    attachment.FileName,
+	})
+}
+
+// handleError maps messaging errors to HTTP responses
+func (h *MessageHandler) handleError(c *gin.Context, err error) {
+	switch err {
+	case domain.ErrApplicationNotFound, domain.ErrConversationNotFound, domain.ErrAttachmentNotFound:
+		response.NotFound(c, err)
+	case domain.ErrNotConversationMember:
+		response.Forbidden(c, err)
+	case domain.ErrMessageEmpty, domain.ErrMessageTooLong, domain.ErrTooManyAttachments, domain.ErrInvalidAttachment:
+		response.BadRequest(c, err)
+	default:
+		response.InternalError(c, err)
+	}
+}
+
+// parsePageLimit reads page and limit query parameters
+func parsePageLimit(c *gin.Context, defaultLimit int) (int, int) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(defaultLimit)))
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = defaultLimit
+	}
+	return page, limit
+}
+
+// paginationMeta builds pagination metadata
+func paginationMeta(page, limit int, total int64) response.PaginationMeta {
+	totalPages := int(total) / limit
+	if int(total)%limit > 0 {
+		totalPages++
+	}
+	return response.PaginationMeta{
+		CurrentPage: page,
+		PerPage:     limit,
+		Total:       total,
+		TotalPages:  totalPages,
+	}
+}
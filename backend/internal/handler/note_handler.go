@@ -0,0 +1,248 @@
+package handler
+
+import (
+	"job-platform/internal/domain"
+	"job-platform/internal/dto"
+	"job-platform/internal/middleware"
+	"job-platform/internal/service"
+	"job-platform/internal/util/response"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// NoteHandler handles team notes on applications and candidates
+type NoteHandler struct {
+	noteService *service.NoteService
+}
+
+// NewNoteHandler creates a new note handler
+func NewNoteHandler(noteService *service.NoteService) *NoteHandler {
+	return &NoteHandler{
+		noteService: noteService,
+	}
+}
+
+// GetApplicationNotes retrieves the team's notes on an application
+// GET /api/v1/employer/applications/:id/notes
+func (h *NoteHandler) GetApplicationNotes(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	appID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	notes, err := h.noteService.GetApplicationNotes(appID, user.ID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Notes retrieved successfully", gin.H{"notes": dto.ToNotesResponse(notes, user.ID)})
+}
+
+// AddApplicationNote adds a note or reply on an application
+// POST /api/v1/employer/applications/:id/notes
+// PATCH /api/v1/employer/applications/:id/notes (legacy, with the content in "notes")
+func (h *NoteHandler) AddApplicationNote(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	appID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	input, ok := bindCreateNoteInput(c)
+	if !ok {
+		return
+	}
+
+	note, err := h.noteService.AddApplicationNote(appID, user.ID, input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.Created(c, "Note added successfully", gin.H{"note": dto.ToNoteResponse(note, user.ID)})
+}
+
+// GetCandidateNotes retrieves the team's notes on a candidate profile
+// GET /api/v1/employer/candidates/:id/notes
+func (h *NoteHandler) GetCandidateNotes(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	candidateID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	notes, err := h.noteService.GetCandidateNotes(candidateID, user.ID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Notes retrieved successfully", gin.H{"notes": dto.ToNotesResponse(notes, user.ID)})
+}
+
+// AddCandidateNote adds a note or reply on a candidate profile
+// POST /api/v1/employer/candidates/:id/notes
+func (h *NoteHandler) AddCandidateNote(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	candidateID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	input, ok := bindCreateNoteInput(c)
+	if !ok {
+		return
+	}
+
+	note, err := h.noteService.AddCandidateNote(candidateID, user.ID, input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.Created(c, "Note added successfully", gin.H{"note": dto.ToNoteResponse(note, user.ID)})
+}
+
+// UpdateNote edits a note written by the current user
+// PUT /api/v1/employer/notes/:id
+func (h *NoteHandler) UpdateNote(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	noteID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	var req dto.UpdateNoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	mentionIDs, err := parseMentionIDs(req.MentionedUserIDs)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	note, err := h.noteService.UpdateNote(noteID, user.ID, service.UpdateNoteInput{
+		Content:    req.Content,
+		MentionIDs: mentionIDs,
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Note updated successfully", gin.H{"note": dto.ToNoteResponse(note, user.ID)})
+}
+
+// DeleteNote deletes a note written by the current user
+// DELETE /api/v1/employer/notes/:id
+func (h *NoteHandler) DeleteNote(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	noteID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	if err := h.noteService.DeleteNote(noteID, user.ID); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Note deleted successfully", nil)
+}
+
+// bindCreateNoteInput binds a create note request, writing the error response on failure
+func bindCreateNoteInput(c *gin.Context) (service.CreateNoteInput, bool) {
+	var req dto.CreateNoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err)
+		return service.CreateNoteInput{}, false
+	}
+
+	input := service.CreateNoteInput{Content: req.GetContent()}
+	if req.ParentID != nil {
+		parentID, err := uuid.Parse(*req.ParentID)
+		if err != nil {
+			response.BadRequest(c, domain.ErrInvalidID)
+			return service.CreateNoteInput{}, false
+		}
+		input.ParentID = &parentID
+	}
+
+	mentionIDs, err := parseMentionIDs(req.MentionedUserIDs)
+	if err != nil {
+		response.BadRequest(c, err)
+		return service.CreateNoteInput{}, false
+	}
+	input.MentionIDs = mentionIDs
+
+	return input, true
+}
+
+// parseMentionIDs parses the user IDs of mentioned team members
+func parseMentionIDs(ids []string) ([]uuid.UUID, error) {
+	mentionIDs := make([]uuid.UUID, 0, len(ids))
+	for _, idStr := range ids {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			return nil, domain.ErrInvalidNoteMention
+		}
+		mentionIDs = append(mentionIDs, id)
+	}
+	return mentionIDs, nil
+}
+
+// handleError maps note errors to HTTP responses
+func (h *NoteHandler) handleError(c *gin.Context, err error) {
+	switch err {
+	case domain.ErrApplicationNotFound, domain.ErrUserNotFound, domain.ErrNoteNotFound, domain.ErrCompanyNotFound:
+		response.NotFound(c, err)
+	case domain.ErrNotCompanyMember, domain.ErrNotNoteAuthor:
+		response.Forbidden(c, err)
+	case domain.ErrNoteEmpty, domain.ErrNoteTooLong, domain.ErrInvalidNoteMention:
+		response.BadRequest(c, err)
+	default:
+		response.InternalError(c, err)
+	}
+}
@@ -14,12 +14,14 @@ import (
 // NotificationHandler handles notification HTTP requests
 type NotificationHandler struct {
 	notificationService *service.NotificationService
+	messageService      *service.MessageService
 }
 
 // NewNotificationHandler creates a new notification handler
-func NewNotificationHandler(notificationService *service.NotificationService) *NotificationHandler {
+func NewNotificationHandler(notificationService *service.NotificationService, messageService *service.MessageService) *NotificationHandler {
 	return &NotificationHandler{
 		notificationService: notificationService,
+		messageService:      messageService,
 	}
 }
 
@@ -109,10 +111,23 @@ func (h *NotificationHandler) GetUnreadCount(c *gin.Context) {
 		return
 	}
 
+	var unreadMessages int64
+	if h.messageService != nil {
+		unreadMessages, err = h.messageService.GetUnreadCount(uid)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"success": false,
+				"error":   "Failed to get unread count",
+			})
+			return
+		}
+	}
+
 	c.JSON(http.StatusOK, gin.H{
 		"success": true,
 		"data": gin.H{
-			"unread_count": count,
+			"unread_count":    count,
+			"unread_messages": unreadMessages,
 		},
 	})
 }
@@ -328,6 +343,9 @@ type UpdatePreferencesInput struct {
 	EmailTeamInvitation      *bool `json:"email_team_invitation"`
 	EmailJobModeration       *bool `json:"email_job_moderation"`
 	EmailCompanyVerification *bool `json:"email_company_verification"`
+	EmailNewMessage          *bool `json:"email_new_message"`
+	EmailJobAlert            *bool `json:"email_job_alert"`
+	EmailDailyDigest         *bool `json:"email_daily_digest"`
 
 	// In-app notifications
 	AppApplicationStatus   *bool `json:"app_application_status"`
@@ -339,6 +357,21 @@ type UpdatePreferencesInput struct {
 	AppTeamInvitation      *bool `json:"app_team_invitation"`
 	AppJobModeration       *bool `json:"app_job_moderation"`
 	AppCompanyVerification *bool `json:"app_company_verification"`
+	AppNewMessage          *bool `json:"app_new_message"`
+	AppJobAlert            *bool `json:"app_job_alert"`
+
+	// Push notifications
+	PushApplicationStatus   *bool `json:"push_application_status"`
+	PushNewApplication      *bool `json:"push_new_application"`
+	PushNewJob              *bool `json:"push_new_job"`
+	PushJobExpiring         *bool `json:"push_job_expiring"`
+	PushProfileViewed       *bool `json:"push_profile_viewed"`
+	PushCompanyReview       *bool `json:"push_company_review"`
+	PushTeamInvitation      *bool `json:"push_team_invitation"`
+	PushJobModeration       *bool `json:"push_job_moderation"`
+	PushCompanyVerification *bool `json:"push_company_verification"`
+	PushNewMessage          *bool `json:"push_new_message"`
+	PushJobAlert            *bool `json:"push_job_alert"`
 }
 
 // UpdatePreferences updates notification preferences
@@ -410,6 +443,15 @@ func (h *NotificationHandler) UpdatePreferences(c *gin.Context) {
 	if input.EmailCompanyVerification != nil {
 		prefs.EmailCompanyVerification = *input.EmailCompanyVerification
 	}
+	if input.EmailNewMessage != nil {
+		prefs.EmailNewMessage = *input.EmailNewMessage
+	}
+	if input.EmailJobAlert != nil {
+		prefs.EmailJobAlert = *input.EmailJobAlert
+	}
+	if input.EmailDailyDigest != nil {
+		prefs.EmailDailyDigest = *input.EmailDailyDigest
+	}
 
 	if input.AppApplicationStatus != nil {
 		prefs.AppApplicationStatus = *input.AppApplicationStatus
@@ -438,6 +480,46 @@ func (h *NotificationHandler) UpdatePreferences(c *gin.Context) {
 	if input.AppCompanyVerification != nil {
 		prefs.AppCompanyVerification = *input.AppCompanyVerification
 	}
+	if input.AppNewMessage != nil {
+		prefs.AppNewMessage = *input.AppNewMessage
+	}
+	if input.AppJobAlert != nil {
+		prefs.AppJobAlert = *input.AppJobAlert
+	}
+
+	if input.PushApplicationStatus != nil {
+		prefs.PushApplicationStatus = *input.PushApplicationStatus
+	}
+	if input.PushNewApplication != nil {
+		prefs.PushNewApplication = *input.PushNewApplication
+	}
+	if input.PushNewJob != nil {
+		prefs.PushNewJob = *input.PushNewJob
+	}
+	if input.PushJobExpiring != nil {
+		prefs.PushJobExpiring = *input.PushJobExpiring
+	}
+	if input.PushProfileViewed != nil {
+		prefs.PushProfileViewed = *input.PushProfileViewed
+	}
+	if input.PushCompanyReview != nil {
+		prefs.PushCompanyReview = *input.PushCompanyReview
+	}
+	if input.PushTeamInvitation != nil {
+		prefs.PushTeamInvitation = *input.PushTeamInvitation
+	}
+	if input.PushJobModeration != nil {
+		prefs.PushJobModeration = *input.PushJobModeration
+	}
+	if input.PushCompanyVerification != nil {
+		prefs.PushCompanyVerification = *input.PushCompanyVerification
+	}
+	if input.PushNewMessage != nil {
+		prefs.PushNewMessage = *input.PushNewMessage
+	}
+	if input.PushJobAlert != nil {
+		prefs.PushJobAlert = *input.PushJobAlert
+	}
 
 	if err := h.notificationService.UpdatePreferences(c.Request.Context(), prefs); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
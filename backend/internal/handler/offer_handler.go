@@ -0,0 +1,387 @@
+package handler
+
+import (
+	"job-platform/internal/domain"
+	"job-platform/internal/dto"
+	"job-platform/internal/middleware"
+	"job-platform/internal/service"
+	"job-platform/internal/util/response"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// OfferHandler handles offer letters for employers and candidates
+type OfferHandler struct {
+	offerService *service.OfferService
+}
+
+// NewOfferHandler creates a new offer handler
+func NewOfferHandler(offerService *service.OfferService) *OfferHandler {
+	return &OfferHandler{
+		offerService: offerService,
+	}
+}
+
+// ============================================================
+// EMPLOYER ENDPOINTS
+// ============================================================
+
+// CreateOffer drafts an offer for an application
+// POST /api/v1/employer/applications/:id/offers
+func (h *OfferHandler) CreateOffer(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	appID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	input, err := bindOfferRequest(c)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	offer, err := h.offerService.CreateOffer(appID, user.ID, input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.Created(c, "Offer created successfully", dto.ToOfferResponse(offer))
+}
+
+// GetApplicationOffers retrieves the offers of an application
+// GET /api/v1/employer/applications/:id/offers
+func (h *OfferHandler) GetApplicationOffers(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	appID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	offers, err := h.offerService.GetApplicationOffers(appID, user.ID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Offers retrieved successfully", dto.ToOfferResponses(offers))
+}
+
+// GetOffer retrieves an offer
+// GET /api/v1/employer/offers/:id
+func (h *OfferHandler) GetOffer(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	offerID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	offer, err := h.offerService.GetOffer(offerID, user.ID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Offer retrieved successfully", dto.ToOfferResponse(offer))
+}
+
+// UpdateOffer changes the terms of a draft offer
+// PUT /api/v1/employer/offers/:id
+func (h *OfferHandler) UpdateOffer(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	offerID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	input, err := bindOfferRequest(c)
+	if err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	offer, err := h.offerService.UpdateOffer(offerID, user.ID, input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Offer updated successfully", dto.ToOfferResponse(offer))
+}
+
+// UploadOfferLetter attaches the offer letter PDF (multipart field "document") to a draft offer
+// POST /api/v1/employer/offers/:id/document
+func (h *OfferHandler) UploadOfferLetter(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	offerID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	file, err := c.FormFile("document")
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidOfferDocument)
+		return
+	}
+
+	offer, err := h.offerService.UploadOfferLetter(offerID, user.ID, file)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Offer letter uploaded successfully", dto.ToOfferResponse(offer))
+}
+
+// SendOffer sends a draft offer to the candidate
+// POST /api/v1/employer/offers/:id/send
+func (h *OfferHandler) SendOffer(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	offerID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	offer, err := h.offerService.SendOffer(offerID, user.ID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Offer sent successfully", dto.ToOfferResponse(offer))
+}
+
+// WithdrawOffer withdraws an offer the candidate has not answered yet
+// POST /api/v1/employer/offers/:id/withdraw
+func (h *OfferHandler) WithdrawOffer(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	offerID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	offer, err := h.offerService.WithdrawOffer(offerID, user.ID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Offer withdrawn successfully", dto.ToOfferResponse(offer))
+}
+
+// ============================================================
+// CANDIDATE ENDPOINTS
+// ============================================================
+
+// GetMyOffers retrieves the offers the candidate received
+// GET /api/v1/jobseeker/me/offers
+func (h *OfferHandler) GetMyOffers(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	offers, err := h.offerService.GetCandidateOffers(user.ID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Offers retrieved successfully", dto.ToOfferResponses(offers))
+}
+
+// GetMyOffer retrieves an offer the candidate received
+// GET /api/v1/jobseeker/me/offers/:id
+func (h *OfferHandler) GetMyOffer(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	offerID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	offer, err := h.offerService.GetCandidateOffer(offerID, user.ID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Offer retrieved successfully", dto.ToOfferResponse(offer))
+}
+
+// AcceptOffer accepts an offer
+// POST /api/v1/jobseeker/me/offers/:id/accept
+func (h *OfferHandler) AcceptOffer(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	offerID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	offer, err := h.offerService.AcceptOffer(offerID, user.ID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Offer accepted successfully", dto.ToOfferResponse(offer))
+}
+
+// DeclineOffer declines an offer
+// POST /api/v1/jobseeker/me/offers/:id/decline
+func (h *OfferHandler) DeclineOffer(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	offerID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	var req dto.DeclineOfferRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.BadRequest(c, err)
+			return
+		}
+	}
+
+	offer, err := h.offerService.DeclineOffer(offerID, user.ID, req.Reason)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Offer declined successfully", dto.ToOfferResponse(offer))
+}
+
+// ============================================================
+// SHARED ENDPOINTS
+// ============================================================
+
+// DownloadOfferLetter generates a download URL for an offer letter
+// GET /api/v1/offers/:id/document
+func (h *OfferHandler) DownloadOfferLetter(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	offerID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	offer, url, err := h.offerService.GetOfferLetterURL(offerID, user.ID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Download URL generated successfully", dto.OfferLetterDownloadResponse{
+		DownloadURL: url,
+		ExpiresAt:   time.Now().Add(service.OfferLetterURLExpiry),
+		FileName:    offer.DocumentName,
+	})
+}
+
+// bindOfferRequest binds and converts an offer request
+func bindOfferRequest(c *gin.Context) (service.OfferInput, error) {
+	var req dto.OfferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		return service.OfferInput{}, err
+	}
+
+	startDate, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		return service.OfferInput{}, domain.ErrInvalidOfferDates
+	}
+
+	return service.OfferInput{
+		Salary:         req.Salary,
+		SalaryCurrency: req.SalaryCurrency,
+		SalaryPeriod:   req.SalaryPeriod,
+		StartDate:      startDate,
+		ExpiresAt:      req.ExpiresAt,
+		Message:        req.Message,
+	}, nil
+}
+
+// handleError maps offer errors to HTTP responses
+func (h *OfferHandler) handleError(c *gin.Context, err error) {
+	switch err {
+	case domain.ErrApplicationNotFound, domain.ErrOfferNotFound:
+		response.NotFound(c, err)
+	case domain.ErrJobNotOwnedByEmployer:
+		response.Forbidden(c, err)
+	case domain.ErrOfferAlreadyOpen, domain.ErrOfferNotDraft, domain.ErrOfferNotSent, domain.ErrOfferExpired,
+		domain.ErrInvalidOfferSalary, domain.ErrInvalidOfferDates, domain.ErrInvalidOfferDocument,
+		domain.ErrApplicationClosed, domain.ErrInvalidApplicationStatus, domain.ErrOfferJobFilled:
+		response.BadRequest(c, err)
+	default:
+		response.InternalError(c, err)
+	}
+}
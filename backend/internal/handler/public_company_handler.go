@@ -451,7 +451,7 @@ func (h *PublicCompanyHandler) SearchCompanies(c *gin.Context) {
 
 // GetCompaniesForSitemap godoc
 // @Summary Get companies for sitemap
-// @Description Get all active companies for sitemap generation
+// @Description Get all active companies for sitemap generation. Deprecated, crawlers should use /sitemaps/sitemap.xml
 // @Tags Public Company
 // @Accept json
 // @Produce json
@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"net/http"
+
+	"job-platform/internal/domain"
+	"job-platform/internal/dto"
+	"job-platform/internal/middleware"
+	"job-platform/internal/service"
+	"job-platform/internal/util/response"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// PushHandler handles browser push notification subscriptions
+type PushHandler struct {
+	pushService *service.PushService
+}
+
+// NewPushHandler creates a new push handler
+func NewPushHandler(pushService *service.PushService) *PushHandler {
+	return &PushHandler{
+		pushService: pushService,
+	}
+}
+
+// GetVAPIDPublicKey returns the key browsers pass as applicationServerKey to PushManager.subscribe()
+// GET /api/v1/push/vapid-public-key
+func (h *PushHandler) GetVAPIDPublicKey(c *gin.Context) {
+	publicKey, err := h.pushService.PublicKey()
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "VAPID public key retrieved successfully", dto.VAPIDPublicKeyResponse{PublicKey: publicKey})
+}
+
+// Subscribe registers the browser's push subscription for the current user
+// POST /api/v1/me/push-subscriptions
+func (h *PushHandler) Subscribe(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	var req dto.SubscribePushRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	sub, err := h.pushService.Subscribe(user.ID, service.SubscribeInput{
+		Endpoint:  req.Endpoint,
+		P256dh:    req.Keys.P256dh,
+		Auth:      req.Keys.Auth,
+		UserAgent: c.Request.UserAgent(),
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.Created(c, "Push notifications enabled", dto.ToPushSubscriptionResponse(sub))
+}
+
+// GetSubscriptions retrieves the browsers the current user receives push notifications on
+// GET /api/v1/me/push-subscriptions
+func (h *PushHandler) GetSubscriptions(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	subs, err := h.pushService.GetSubscriptions(user.ID)
+	if err != nil {
+		response.InternalError(c, err)
+		return
+	}
+
+	response.OK(c, "Push subscriptions retrieved successfully", dto.ToPushSubscriptionResponses(subs))
+}
+
+// Unsubscribe stops push notifications to a browser
+// DELETE /api/v1/me/push-subscriptions/:id
+func (h *PushHandler) Unsubscribe(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	subID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	if err := h.pushService.Unsubscribe(subID, user.ID); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Push notifications disabled", nil)
+}
+
+// SendTest sends a test push notification to every browser of the current user
+// POST /api/v1/me/push-subscriptions/test
+func (h *PushHandler) SendTest(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	sent, err := h.pushService.SendTest(c.Request.Context(), user.ID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Test notification sent", dto.TestPushResponse{Sent: sent})
+}
+
+// handleError maps push errors to HTTP responses
+func (h *PushHandler) handleError(c *gin.Context, err error) {
+	switch err {
+	case domain.ErrPushNotConfigured:
+		response.Error(c, http.StatusServiceUnavailable, err, nil)
+	case domain.ErrPushSubscriptionNotFound:
+		response.NotFound(c, err)
+	case domain.ErrInvalidPushSubscription:
+		response.BadRequest(c, err)
+	default:
+		response.InternalError(c, err)
+	}
+}
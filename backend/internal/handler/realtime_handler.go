@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"job-platform/internal/middleware"
+	"job-platform/internal/realtime"
+	"job-platform/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// streamHeartbeatInterval keeps idle streams open through proxies that close silent connections
+const streamHeartbeatInterval = 25 * time.Second
+
+// RealtimeHandler streams real-time events to connected users
+type RealtimeHandler struct {
+	hub                 *realtime.Hub
+	notificationService *service.NotificationService
+}
+
+// NewRealtimeHandler creates a new real-time handler
+func NewRealtimeHandler(hub *realtime.Hub, notificationService *service.NotificationService) *RealtimeHandler {
+	return &RealtimeHandler{
+		hub:                 hub,
+		notificationService: notificationService,
+	}
+}
+
+// Stream pushes new notifications, unread counts and application status updates as server-sent events
+// @Summary Stream notifications
+// @Description Server-sent event stream of notification, unread_count and application_status events for the current user.
+// @Description The access token may be passed in the access_token query parameter since EventSource can't set headers.
+// @Tags Notifications
+// @Produce text/event-stream
+// @Param access_token query string false "Access token"
+// @Success 200 {string} string "Event stream"
+// @Failure 401 {object} map[string]interface{}
+// @Router /me/notifications/stream [get]
+func (h *RealtimeHandler) Stream(c *gin.Context) {
+	uid, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "Invalid user ID",
+		})
+		return
+	}
+
+	// Subscribe before reading the unread count so no change is missed in between
+	sub := h.hub.Subscribe(uid)
+	defer h.hub.Unsubscribe(sub)
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+
+	// Start with the current unread count so clients don't need a separate request
+	count, err := h.notificationService.GetUnreadCount(c.Request.Context(), uid)
+	if err == nil {
+		c.SSEvent(string(realtime.EventUnreadCount), realtime.UnreadCountData{UnreadCount: count})
+	}
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(streamHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case event, ok := <-sub.Events():
+			if !ok {
+				return false
+			}
+			c.SSEvent(string(event.Type), event.Data)
+			return true
+		case <-heartbeat.C:
+			_, err := io.WriteString(w, ": ping\n\n")
+			return err == nil
+		case <-c.Request.Context().Done():
+			return false
+		}
+	})
+}
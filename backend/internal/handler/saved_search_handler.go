@@ -0,0 +1,222 @@
+package handler
+
+import (
+	"job-platform/internal/domain"
+	"job-platform/internal/dto"
+	"job-platform/internal/middleware"
+	"job-platform/internal/service"
+	"job-platform/internal/util/response"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// SavedSearchHandler handles saved searches and job alerts of job seekers
+type SavedSearchHandler struct {
+	savedSearchService *service.SavedSearchService
+}
+
+// NewSavedSearchHandler creates a new saved search handler
+func NewSavedSearchHandler(savedSearchService *service.SavedSearchService) *SavedSearchHandler {
+	return &SavedSearchHandler{
+		savedSearchService: savedSearchService,
+	}
+}
+
+// CreateSavedSearch saves a job search and subscribes to alerts for new matching jobs
+// POST /api/v1/jobseeker/me/saved-searches
+func (h *SavedSearchHandler) CreateSavedSearch(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	var req dto.CreateSavedSearchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	search, err := h.savedSearchService.CreateSavedSearch(user.ID, service.CreateSavedSearchInput{
+		Name:         req.Name,
+		Filters:      req.Filters.ToDomain(),
+		Frequency:    domain.SavedSearchFrequency(req.Frequency),
+		EmailEnabled: req.EmailEnabled,
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.Created(c, "Search saved successfully", h.toResponse(search))
+}
+
+// GetSavedSearches retrieves the saved searches of the job seeker
+// GET /api/v1/jobseeker/me/saved-searches
+func (h *SavedSearchHandler) GetSavedSearches(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	searches, err := h.savedSearchService.GetSavedSearches(user.ID)
+	if err != nil {
+		response.InternalError(c, err)
+		return
+	}
+
+	responses := make([]dto.SavedSearchResponse, len(searches))
+	for i := range searches {
+		responses[i] = h.toResponse(&searches[i])
+	}
+
+	response.OK(c, "Saved searches retrieved successfully", responses)
+}
+
+// GetSavedSearch retrieves a saved search
+// GET /api/v1/jobseeker/me/saved-searches/:id
+func (h *SavedSearchHandler) GetSavedSearch(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	searchID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	search, err := h.savedSearchService.GetSavedSearch(searchID, user.ID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Saved search retrieved successfully", h.toResponse(search))
+}
+
+// UpdateSavedSearch changes the filters, name or alert settings of a saved search
+// PUT /api/v1/jobseeker/me/saved-searches/:id
+func (h *SavedSearchHandler) UpdateSavedSearch(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	searchID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	var req dto.UpdateSavedSearchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	input := service.UpdateSavedSearchInput{
+		Name:         req.Name,
+		EmailEnabled: req.EmailEnabled,
+		IsActive:     req.IsActive,
+	}
+	if req.Filters != nil {
+		filters := req.Filters.ToDomain()
+		input.Filters = &filters
+	}
+	if req.Frequency != nil {
+		frequency := domain.SavedSearchFrequency(*req.Frequency)
+		input.Frequency = &frequency
+	}
+
+	search, err := h.savedSearchService.UpdateSavedSearch(searchID, user.ID, input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Saved search updated successfully", h.toResponse(search))
+}
+
+// DeleteSavedSearch deletes a saved search and stops its alerts
+// DELETE /api/v1/jobseeker/me/saved-searches/:id
+func (h *SavedSearchHandler) DeleteSavedSearch(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	searchID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	if err := h.savedSearchService.DeleteSavedSearch(searchID, user.ID); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Saved search deleted successfully", nil)
+}
+
+// GetSavedSearchMatches retrieves the new jobs found for a saved search, newest first
+// GET /api/v1/jobseeker/me/saved-searches/:id/matches
+func (h *SavedSearchHandler) GetSavedSearchMatches(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	searchID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	page, limit := parsePageLimit(c, 20)
+
+	matches, total, err := h.savedSearchService.GetSavedSearchMatches(searchID, user.ID, page, limit)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.Paginated(c, "Matches retrieved successfully", dto.ToSavedSearchMatchResponses(matches), paginationMeta(page, limit, total))
+}
+
+// Unsubscribe turns off job alert emails of a saved search from the link in an alert email
+// GET /api/v1/saved-searches/unsubscribe?token=
+func (h *SavedSearchHandler) Unsubscribe(c *gin.Context) {
+	search, err := h.savedSearchService.Unsubscribe(c.Query("token"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Successfully unsubscribed from job alert", dto.UnsubscribeSavedSearchResponse{Name: search.Name})
+}
+
+// toResponse converts a saved search to its API response
+func (h *SavedSearchHandler) toResponse(search *domain.SavedSearch) dto.SavedSearchResponse {
+	return dto.ToSavedSearchResponse(search, h.savedSearchService.SearchURL(search.Filters))
+}
+
+// handleError maps saved search errors to HTTP responses
+func (h *SavedSearchHandler) handleError(c *gin.Context, err error) {
+	switch err {
+	case domain.ErrSavedSearchNotFound, domain.ErrInvalidUnsubscribeToken:
+		response.NotFound(c, err)
+	case domain.ErrSavedSearchLimitReached, domain.ErrInvalidSavedSearchFrequency, domain.ErrSavedSearchFiltersRequired:
+		response.BadRequest(c, err)
+	default:
+		response.InternalError(c, err)
+	}
+}
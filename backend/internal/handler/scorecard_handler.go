@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"job-platform/internal/domain"
+	"job-platform/internal/dto"
+	"job-platform/internal/middleware"
+	"job-platform/internal/service"
+	"job-platform/internal/util/response"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ScorecardHandler handles scorecard criteria and interview feedback endpoints
+type ScorecardHandler struct {
+	scorecardService *service.ScorecardService
+}
+
+// NewScorecardHandler creates a new scorecard handler
+func NewScorecardHandler(scorecardService *service.ScorecardService) *ScorecardHandler {
+	return &ScorecardHandler{
+		scorecardService: scorecardService,
+	}
+}
+
+// GetJobCriteria retrieves a job's scorecard criteria
+// GET /api/v1/employer/jobs/:id/scorecard
+func (h *ScorecardHandler) GetJobCriteria(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	jobID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidJobID)
+		return
+	}
+
+	criteria, err := h.scorecardService.GetJobCriteria(jobID, user.ID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Scorecard criteria retrieved successfully", dto.ToScorecardCriteriaResponse(criteria))
+}
+
+// UpdateJobCriteria replaces a job's scorecard criteria
+// PUT /api/v1/employer/jobs/:id/scorecard
+func (h *ScorecardHandler) UpdateJobCriteria(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	jobID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidJobID)
+		return
+	}
+
+	var req dto.UpdateScorecardCriteriaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	inputs := make([]service.ScorecardCriterionInput, 0, len(req.Criteria))
+	for _, criterion := range req.Criteria {
+		input := service.ScorecardCriterionInput{
+			Name:        criterion.Name,
+			Description: criterion.Description,
+		}
+		if criterion.ID != nil {
+			id, err := uuid.Parse(*criterion.ID)
+			if err != nil {
+				response.BadRequest(c, domain.ErrInvalidScorecardCriteria)
+				return
+			}
+			input.ID = &id
+		}
+		inputs = append(inputs, input)
+	}
+
+	criteria, err := h.scorecardService.UpdateJobCriteria(jobID, user.ID, inputs)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Scorecard criteria updated successfully", dto.ToScorecardCriteriaResponse(criteria))
+}
+
+// GetApplicationFeedback retrieves the scorecards of an application visible to the current user
+// GET /api/v1/employer/applications/:id/scorecards
+func (h *ScorecardHandler) GetApplicationFeedback(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	appID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	feedback, err := h.scorecardService.GetApplicationFeedback(appID, user.ID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Scorecards retrieved successfully", dto.ToApplicationFeedbackResponse(feedback))
+}
+
+// SaveMyScorecard saves (and optionally submits) the current user's scorecard for an application
+// PUT /api/v1/employer/applications/:id/scorecards/me
+func (h *ScorecardHandler) SaveMyScorecard(c *gin.Context) {
+	user, err := middleware.GetUserFromContext(c)
+	if err != nil {
+		response.Unauthorized(c, err)
+		return
+	}
+
+	appID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidID)
+		return
+	}
+
+	var req dto.SaveScorecardRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err)
+		return
+	}
+
+	ratings := make([]service.ScorecardRatingInput, 0, len(req.Ratings))
+	for _, rating := range req.Ratings {
+		criterionID, err := uuid.Parse(rating.CriterionID)
+		if err != nil {
+			response.BadRequest(c, domain.ErrInvalidScorecardRating)
+			return
+		}
+		ratings = append(ratings, service.ScorecardRatingInput{
+			CriterionID: criterionID,
+			Rating:      rating.Rating,
+			Comment:     rating.Comment,
+		})
+	}
+
+	scorecard, err := h.scorecardService.SaveMyScorecard(appID, user.ID, service.SaveScorecardInput{
+		Ratings:        ratings,
+		Recommendation: domain.HireRecommendation(req.Recommendation),
+		Summary:        req.Summary,
+		Submit:         req.Submit,
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	message := "Scorecard saved successfully"
+	if scorecard.IsSubmitted() {
+		message = "Scorecard submitted successfully"
+	}
+	response.OK(c, message, dto.ToScorecardResponse(scorecard))
+}
+
+// handleError maps scorecard errors to HTTP responses
+func (h *ScorecardHandler) handleError(c *gin.Context, err error) {
+	switch err {
+	case domain.ErrApplicationNotFound, domain.ErrJobNotFound:
+		response.NotFound(c, err)
+	case domain.ErrJobNotOwnedByEmployer, domain.ErrNotApplicationReviewer:
+		response.Forbidden(c, err)
+	case domain.ErrInvalidScorecardCriteria, domain.ErrInvalidScorecardRating, domain.ErrScorecardIncomplete,
+		domain.ErrScorecardAlreadySubmitted, domain.ErrInvalidHireRecommendation:
+		response.BadRequest(c, err)
+	default:
+		response.InternalError(c, err)
+	}
+}
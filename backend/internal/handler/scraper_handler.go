@@ -175,10 +175,43 @@ func (h *ScraperHandler) BulkScrapeJobs(c *gin.Context) {
 
 	// Perform bulk scraping
 	results := h.scraperService.BulkScrapeJobs(c.Request.Context(), req.URLs)
+	h.markDuplicates(results)
 
 	response.OK(c, "Bulk scraping completed", results)
 }
 
+// markDuplicates flags scraped jobs that already exist so admins don't import them twice
+func (h *ScraperHandler) markDuplicates(results *dto.BulkScrapeResponse) {
+	for i := range results.Results {
+		scraped := results.Results[i].ScrapedJob
+		if !results.Results[i].Success || scraped == nil {
+			continue
+		}
+
+		match, err := h.jobService.FindDuplicateJob(&domain.Job{
+			Title:       scraped.Title,
+			CompanyName: scraped.Company,
+			Location:    scraped.Location,
+			Description: scraped.Description,
+			OriginalURL: &scraped.OriginalURL,
+		})
+		if err != nil || match == nil {
+			continue
+		}
+
+		existing, err := h.jobService.GetJobByID(match.JobID)
+		if err != nil {
+			continue
+		}
+		results.Results[i].DuplicateOf = &dto.DuplicateMatchResponse{
+			JobID: existing.ID.String(),
+			Title: existing.Title,
+			Slug:  existing.Slug,
+			Score: match.Score,
+		}
+	}
+}
+
 // ExtractJobLinks handles POST /admin/jobs/scrape/extract-links
 // @Summary Extract job links from a listing page
 // @Description Scrape a job listing page and extract all individual job links
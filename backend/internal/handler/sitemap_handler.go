@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"job-platform/internal/cache"
+	"job-platform/internal/domain"
+	"job-platform/internal/service"
+	"job-platform/internal/util/response"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// sitemapIndexFile is the file name of the sitemap index
+const sitemapIndexFile = "sitemap.xml"
+
+// SitemapHandler serves XML sitemaps for search engines
+type SitemapHandler struct {
+	sitemapService *service.SitemapService
+	cacheService   *cache.CacheService
+	baseURL        string // Public URL the sitemap files are served from
+}
+
+// NewSitemapHandler creates a new sitemap handler. The index links the sitemap files under baseURL,
+// which comes from configuration so request headers can't change the cached index.
+func NewSitemapHandler(sitemapService *service.SitemapService, cacheService *cache.CacheService, baseURL string) *SitemapHandler {
+	return &SitemapHandler{
+		sitemapService: sitemapService,
+		cacheService:   cacheService,
+		baseURL:        baseURL,
+	}
+}
+
+// GetSitemap serves the sitemap index (sitemap.xml) or one of the sitemap files it lists,
+// e.g. sitemap-pages.xml, sitemap-jobs-1.xml, sitemap-companies-1.xml or sitemap-blogs-1.xml
+// GET /api/v1/sitemaps/:file
+func (h *SitemapHandler) GetSitemap(c *gin.Context) {
+	file := strings.ToLower(c.Param("file"))
+
+	var cacheKey string
+	var write func(buf *bytes.Buffer) error
+	if file == sitemapIndexFile {
+		cacheKey = "index"
+		write = func(buf *bytes.Buffer) error {
+			return h.sitemapService.WriteIndex(buf, h.baseURL)
+		}
+	} else {
+		section, page, err := service.ParseSitemapFileName(file)
+		if err != nil {
+			response.NotFound(c, err)
+			return
+		}
+		// Keys are grouped by section so a change only clears the files of that section
+		cacheKey = fmt.Sprintf("%s:%d", section, page)
+		write = func(buf *bytes.Buffer) error {
+			return h.sitemapService.WriteSitemap(buf, section, page)
+		}
+	}
+
+	ctx := context.Background()
+	cacheAvailable := h.cacheService != nil && h.cacheService.IsAvailable()
+
+	if cacheAvailable {
+		if data, err := h.cacheService.GetCachedSitemap(ctx, cacheKey); err == nil {
+			c.Data(http.StatusOK, "application/xml; charset=utf-8", data)
+			return
+		}
+	}
+
+	// Sitemaps are capped at 50,000 URLs, so they are rendered in memory and errors can still be reported
+	var buf bytes.Buffer
+	if err := write(&buf); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	if cacheAvailable {
+		_ = h.cacheService.CacheSitemap(ctx, cacheKey, buf.Bytes())
+	}
+
+	c.Data(http.StatusOK, "application/xml; charset=utf-8", buf.Bytes())
+}
+
+// handleError maps sitemap errors to HTTP responses
+func (h *SitemapHandler) handleError(c *gin.Context, err error) {
+	switch err {
+	case domain.ErrSitemapNotFound:
+		response.NotFound(c, err)
+	default:
+		response.InternalError(c, err)
+	}
+}
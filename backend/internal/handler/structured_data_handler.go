@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"encoding/json"
+	"job-platform/internal/domain"
+	"job-platform/internal/service"
+	"job-platform/internal/util/response"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// StructuredDataHandler serves schema.org JobPosting structured data for jobs
+type StructuredDataHandler struct {
+	structuredDataService *service.StructuredDataService
+}
+
+// NewStructuredDataHandler creates a new structured data handler
+func NewStructuredDataHandler(structuredDataService *service.StructuredDataService) *StructuredDataHandler {
+	return &StructuredDataHandler{
+		structuredDataService: structuredDataService,
+	}
+}
+
+// GetJobStructuredData returns the JobPosting JSON-LD of a published job.
+// With ?format=jsonld only the JSON-LD document is returned, ready to embed in a script tag.
+// GET /api/v1/jobs/view/:slug/structured-data
+func (h *StructuredDataHandler) GetJobStructuredData(c *gin.Context) {
+	result, err := h.structuredDataService.GetJobStructuredData(c.Param("slug"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	if c.Query("format") == "jsonld" {
+		data, err := json.Marshal(result.JSONLD)
+		if err != nil {
+			response.InternalError(c, err)
+			return
+		}
+		c.Data(http.StatusOK, "application/ld+json; charset=utf-8", data)
+		return
+	}
+
+	response.OK(c, "Structured data generated successfully", result)
+}
+
+// AdminGetJobStructuredData returns the JobPosting JSON-LD of any job with its validation result
+// GET /api/v1/admin/jobs/:id/structured-data
+func (h *StructuredDataHandler) AdminGetJobStructuredData(c *gin.Context) {
+	jobID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, domain.ErrInvalidJobID)
+		return
+	}
+
+	result, err := h.structuredDataService.AdminGetJobStructuredData(jobID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.OK(c, "Structured data generated successfully", result)
+}
+
+// AuditJobs lists active jobs whose structured data has issues.
+// ?severity=error leaves out jobs that only miss recommended fields.
+// GET /api/v1/admin/jobs/structured-data/audit
+func (h *StructuredDataHandler) AuditJobs(c *gin.Context) {
+	page, limit := parsePageLimit(c, 20)
+
+	items, err := h.structuredDataService.AuditActiveJobs(c.Query("severity") == service.StructuredDataSeverityError)
+	if err != nil {
+		response.InternalError(c, err)
+		return
+	}
+
+	total := int64(len(items))
+	start := (page - 1) * limit
+	if start > len(items) {
+		start = len(items)
+	}
+	end := start + limit
+	if end > len(items) {
+		end = len(items)
+	}
+
+	response.Paginated(c, "Structured data audit completed", items[start:end], paginationMeta(page, limit, total))
+}
+
+// handleError maps structured data errors to HTTP responses
+func (h *StructuredDataHandler) handleError(c *gin.Context, err error) {
+	switch err {
+	case domain.ErrJobNotFound:
+		response.NotFound(c, err)
+	default:
+		response.InternalError(c, err)
+	}
+}
@@ -71,6 +71,20 @@ func AuthMiddleware(tokenService *service.TokenService, userService *service.Use
 	}
 }
 
+// StreamAuthMiddleware validates JWT tokens of streaming endpoints. Browsers can't set headers on
+// EventSource requests, so the access token may also be passed in the access_token query parameter.
+func StreamAuthMiddleware(tokenService *service.TokenService, userService *service.UserService) gin.HandlerFunc {
+	auth := AuthMiddleware(tokenService, userService)
+	return func(c *gin.Context) {
+		if c.GetHeader("Authorization") == "" {
+			if token := c.Query("access_token"); token != "" {
+				c.Request.Header.Set("Authorization", "Bearer "+token)
+			}
+		}
+		auth(c)
+	}
+}
+
 // GetUserFromContext retrieves user from gin context
 func GetUserFromContext(c *gin.Context) (*domain.User, error) {
 	userVal, exists := c.Get("user")
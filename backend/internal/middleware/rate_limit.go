@@ -9,19 +9,41 @@ import (
 	"github.com/redis/go-redis/v9"
 )
 
-// RateLimitMiddleware implements rate limiting using Redis
-func RateLimitMiddleware(redisClient *redis.Client, limit int, window time.Duration) gin.HandlerFunc {
-	return func(c *gin.Context) {
-		// Get client identifier (IP address or user ID if authenticated)
-		identifier := c.ClientIP()
+// RateLimitKeyFunc returns the Redis key a request is counted under. Requests for which it
+// returns an empty key are not rate limited.
+type RateLimitKeyFunc func(c *gin.Context) string
+
+// ClientRateLimitKey counts requests per endpoint and client: the user ID if authenticated, the IP address otherwise
+func ClientRateLimitKey(c *gin.Context) string {
+	// Get client identifier (IP address or user ID if authenticated)
+	identifier := c.ClientIP()
+
+	// If user is authenticated, use user ID for better tracking
+	if userID, exists := c.Get("user_id"); exists {
+		identifier = fmt.Sprintf("user:%v", userID)
+	}
 
-		// If user is authenticated, use user ID for better tracking
-		if userID, exists := c.Get("user_id"); exists {
-			identifier = fmt.Sprintf("user:%v", userID)
-		}
+	return fmt.Sprintf("rate_limit:%s:%s", c.Request.URL.Path, identifier)
+}
 
-		// Create rate limit key
-		key := fmt.Sprintf("rate_limit:%s:%s", c.Request.URL.Path, identifier)
+// APIKeyRateLimitKey counts requests per API key across all endpoints. Requests authenticated
+// with a user token are not counted.
+func APIKeyRateLimitKey(c *gin.Context) string {
+	apiKeyID, exists := c.Get("api_key_id")
+	if !exists {
+		return ""
+	}
+	return fmt.Sprintf("rate_limit:api_key:%v", apiKeyID)
+}
+
+// RateLimitMiddleware implements rate limiting using Redis, counting requests under the key returned by keyFunc
+func RateLimitMiddleware(redisClient *redis.Client, limit int, window time.Duration, keyFunc RateLimitKeyFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := keyFunc(c)
+		if key == "" {
+			c.Next()
+			return
+		}
 
 		// Get current count
 		ctx := c.Request.Context()
@@ -106,6 +128,12 @@ func IPRateLimitMiddleware(redisClient *redis.Client, limit int, window time.Dur
 	}
 }
 
+// APIKeyRateLimitMiddleware limits requests made with an API key per key, across all endpoints.
+// Requests authenticated with a user token pass through.
+func APIKeyRateLimitMiddleware(redisClient *redis.Client, limit int, window time.Duration) gin.HandlerFunc {
+	return RateLimitMiddleware(redisClient, limit, window, APIKeyRateLimitKey)
+}
+
 // LoginRateLimitMiddleware implements strict rate limiting for login endpoints
 func LoginRateLimitMiddleware(redisClient *redis.Client) gin.HandlerFunc {
 	// 5 attempts per 15 minutes per IP
@@ -115,5 +143,5 @@ func LoginRateLimitMiddleware(redisClient *redis.Client) gin.HandlerFunc {
 // EmailRateLimitMiddleware implements rate limiting for email sending
 func EmailRateLimitMiddleware(redisClient *redis.Client) gin.HandlerFunc {
 	// 3 emails per hour per user
-	return RateLimitMiddleware(redisClient, 3, 1*time.Hour)
+	return RateLimitMiddleware(redisClient, 3, 1*time.Hour, ClientRateLimitKey)
 }
@@ -0,0 +1,211 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// EventType identifies the kind of a real-time event
+type EventType string
+
+const (
+	EventNotification      EventType = "notification"       // A new in-app notification
+	EventUnreadCount       EventType = "unread_count"       // The number of unread notifications changed
+	EventApplicationStatus EventType = "application_status" // An application moved to another status
+)
+
+const (
+	// eventChannel is the Redis channel events are published on. Every instance receives every
+	// event and forwards it to the users connected to it.
+	eventChannel = "realtime:events"
+	// subscriberBuffer is the number of events queued for a slow connection before events are dropped
+	subscriberBuffer = 32
+)
+
+// Event is a message pushed to a connected user
+type Event struct {
+	Type EventType       `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+// envelope is an event on the Redis channel, addressed to a user
+type envelope struct {
+	UserID uuid.UUID `json:"user_id"`
+	Event  Event     `json:"event"`
+}
+
+// Subscription receives the events of one connection of a user
+type Subscription struct {
+	userID uuid.UUID
+	events chan Event
+}
+
+// Events returns the events of the subscription. The channel is closed when the hub stops.
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// Hub delivers real-time events to the users connected to this instance. Events are published
+// through Redis pub/sub so users are reached whichever backend instance they are connected to.
+type Hub struct {
+	client *redis.Client
+
+	mu          sync.RWMutex
+	subscribers map[uuid.UUID]map[*Subscription]struct{}
+	cancel      context.CancelFunc
+	done        chan struct{}
+}
+
+// NewHub creates a new hub. Without a Redis client events only reach users connected to this instance.
+func NewHub(client *redis.Client) *Hub {
+	return &Hub{
+		client:      client,
+		subscribers: make(map[uuid.UUID]map[*Subscription]struct{}),
+	}
+}
+
+// Start listens for events published by any instance
+func (h *Hub) Start() {
+	if h.client == nil {
+		log.Println("⚠️  Real-time hub running without Redis: events stay on this instance")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	h.cancel = cancel
+	h.done = make(chan struct{})
+
+	pubsub := h.client.Subscribe(ctx, eventChannel)
+
+	go func() {
+		defer close(h.done)
+		defer pubsub.Close()
+
+		log.Println("✅ Real-time hub started")
+
+		for {
+			select {
+			case msg, ok := <-pubsub.Channel():
+				if !ok {
+					return
+				}
+				var env envelope
+				if err := json.Unmarshal([]byte(msg.Payload), &env); err != nil {
+					log.Printf("Error decoding real-time event: %v", err)
+					continue
+				}
+				h.deliver(env.UserID, env.Event)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops listening and closes all subscriptions, which ends open streams
+func (h *Hub) Stop() {
+	if h.cancel != nil {
+		h.cancel()
+		<-h.done
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for userID, subs := range h.subscribers {
+		for sub := range subs {
+			close(sub.events)
+		}
+		delete(h.subscribers, userID)
+	}
+
+	log.Println("🛑 Real-time hub stopped")
+}
+
+// Subscribe registers a connection of a user
+func (h *Hub) Subscribe(userID uuid.UUID) *Subscription {
+	sub := &Subscription{
+		userID: userID,
+		events: make(chan Event, subscriberBuffer),
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.subscribers[userID] == nil {
+		h.subscribers[userID] = make(map[*Subscription]struct{})
+	}
+	h.subscribers[userID][sub] = struct{}{}
+
+	return sub
+}
+
+// Unsubscribe removes a connection registered with Subscribe
+func (h *Hub) Unsubscribe(sub *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	subs, ok := h.subscribers[sub.userID]
+	if !ok {
+		return
+	}
+	if _, ok := subs[sub]; !ok {
+		return
+	}
+	delete(subs, sub)
+	close(sub.events)
+	if len(subs) == 0 {
+		delete(h.subscribers, sub.userID)
+	}
+}
+
+// Publish sends an event to every connection of a user, on any instance
+func (h *Hub) Publish(ctx context.Context, userID uuid.UUID, eventType EventType, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	event := Event{Type: eventType, Data: payload}
+
+	if h.client == nil {
+		h.deliver(userID, event)
+		return nil
+	}
+
+	msg, err := json.Marshal(envelope{UserID: userID, Event: event})
+	if err != nil {
+		return err
+	}
+	return h.client.Publish(ctx, eventChannel, msg).Err()
+}
+
+// deliver queues an event for the connections of a user on this instance.
+// A connection that doesn't keep up misses the event rather than blocking the others.
+func (h *Hub) deliver(userID uuid.UUID, event Event) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for sub := range h.subscribers[userID] {
+		select {
+		case sub.events <- event:
+		default:
+		}
+	}
+}
+
+// UnreadCountData is the payload of an unread_count event
+type UnreadCountData struct {
+	UnreadCount int64 `json:"unread_count"`
+}
+
+// ApplicationStatusData is the payload of an application_status event
+type ApplicationStatusData struct {
+	ApplicationID uuid.UUID `json:"application_id"`
+	JobID         uuid.UUID `json:"job_id"`
+	JobTitle      string    `json:"job_title"`
+	FromStatus    string    `json:"from_status"`
+	Status        string    `json:"status"`
+}
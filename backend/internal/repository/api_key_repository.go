@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"job-platform/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// APIKeyRepository handles API key database operations
+type APIKeyRepository struct {
+	db *gorm.DB
+}
+
+// NewAPIKeyRepository creates a new API key repository
+func NewAPIKeyRepository(db *gorm.DB) *APIKeyRepository {
+	return &APIKeyRepository{db: db}
+}
+
+// Create creates a new API key
+func (r *APIKeyRepository) Create(key *domain.APIKey) error {
+	return r.db.Create(key).Error
+}
+
+// GetByID retrieves an API key by ID
+func (r *APIKeyRepository) GetByID(id uuid.UUID) (*domain.APIKey, error) {
+	var key domain.APIKey
+	if err := r.db.Preload("Creator").Where("id = ?", id).First(&key).Error; err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+// GetByHash retrieves an API key by the hash of the key
+func (r *APIKeyRepository) GetByHash(keyHash string) (*domain.APIKey, error) {
+	var key domain.APIKey
+	if err := r.db.Where("key_hash = ?", keyHash).First(&key).Error; err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+// GetByCompanyID retrieves the API keys of a company, newest first
+func (r *APIKeyRepository) GetByCompanyID(companyID uuid.UUID) ([]domain.APIKey, error) {
+	var keys []domain.APIKey
+	err := r.db.Preload("Creator").
+		Where("company_id = ?", companyID).
+		Order("created_at DESC").
+		Find(&keys).Error
+	return keys, err
+}
+
+// CountActiveByCompanyID counts the API keys of a company that are not revoked or expired
+func (r *APIKeyRepository) CountActiveByCompanyID(companyID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.Model(&domain.APIKey{}).
+		Where("company_id = ? AND revoked_at IS NULL AND (expires_at IS NULL OR expires_at > ?)", companyID, time.Now()).
+		Count(&count).Error
+	return count, err
+}
+
+// Revoke marks an API key as revoked
+func (r *APIKeyRepository) Revoke(id, revokedBy uuid.UUID, at time.Time) error {
+	return r.db.Model(&domain.APIKey{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"revoked_at": at,
+			"revoked_by": revokedBy,
+		}).Error
+}
+
+// RecordUse records when and from where an API key was last used
+func (r *APIKeyRepository) RecordUse(id uuid.UUID, at time.Time, ip string) error {
+	return r.db.Model(&domain.APIKey{}).
+		Where("id = ?", id).
+		UpdateColumns(map[string]interface{}{
+			"last_used_at": at,
+			"last_used_ip": ip,
+		}).Error
+}
@@ -36,6 +36,7 @@ func (r *ApplicationRepository) GetByID(applicationID uuid.UUID) (*domain.Applic
 		Preload("Job.Employer").
 		Preload("Applicant").
 		Preload("StatusHistory").
+		Preload("Stage").
 		Where("id = ?", applicationID).
 		First(&application).Error
 	if err != nil {
@@ -109,6 +110,7 @@ func (r *ApplicationRepository) GetJobApplications(jobID uuid.UUID, limit, offse
 	err := query.
 		Preload("Applicant").
 		Preload("Applicant.Profile").
+		Preload("Stage").
 		Order("applied_at DESC").
 		Limit(limit).
 		Offset(offset).
@@ -150,6 +152,7 @@ func (r *ApplicationRepository) GetEmployerApplications(employerID uuid.UUID, st
 		Preload("Job").
 		Preload("Applicant").
 		Preload("Applicant.Profile").
+		Preload("Stage").
 		Order(orderBy).
 		Limit(limit).
 		Offset(offset).
@@ -158,6 +161,52 @@ func (r *ApplicationRepository) GetEmployerApplications(employerID uuid.UUID, st
 	return applications, total, err
 }
 
+// ApplicationExportFilters selects the applications of an export. JobID limits the export to one
+// job; otherwise CompanyID selects the company's jobs and the jobs posted by its active team members.
+type ApplicationExportFilters struct {
+	JobID     *uuid.UUID
+	CompanyID *uuid.UUID
+	Status    string
+}
+
+// GetForExport retrieves a batch of applications for an export, oldest first, with their job,
+// applicant, stage and status history
+func (r *ApplicationRepository) GetForExport(filters ApplicationExportFilters, limit, offset int) ([]domain.Application, error) {
+	var applications []domain.Application
+
+	query := r.db.Model(&domain.Application{}).
+		Joins("JOIN jobs ON applications.job_id = jobs.id")
+
+	if filters.JobID != nil {
+		query = query.Where("applications.job_id = ?", *filters.JobID)
+	}
+	if filters.CompanyID != nil {
+		query = query.Where("jobs.deleted_at IS NULL").
+			Where("jobs.company_id = ? OR jobs.employer_id IN (?)", *filters.CompanyID,
+				r.db.Model(&domain.CompanyTeamMember{}).
+					Select("user_id").
+					Where("company_id = ? AND status = ?", *filters.CompanyID, domain.TeamMemberStatusActive))
+	}
+	if filters.Status != "" {
+		query = query.Where("applications.status = ?", filters.Status)
+	}
+
+	err := query.
+		Preload("Job").
+		Preload("Applicant").
+		Preload("Applicant.Profile").
+		Preload("Stage").
+		Preload("StatusHistory", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at ASC")
+		}).
+		Order("applications.applied_at ASC, applications.id ASC").
+		Limit(limit).
+		Offset(offset).
+		Find(&applications).Error
+
+	return applications, err
+}
+
 // GetApplicationsByStatus retrieves applications by status for a job
 func (r *ApplicationRepository) GetApplicationsByStatus(jobID uuid.UUID, status domain.ApplicationStatus, limit, offset int) ([]domain.Application, int64, error) {
 	var applications []domain.Application
@@ -194,11 +243,26 @@ func (r *ApplicationRepository) UpdateStatus(applicationID uuid.UUID, status dom
 		}).Error
 }
 
-// UpdateEmployerNotes updates employer notes for an application
-func (r *ApplicationRepository) UpdateEmployerNotes(applicationID uuid.UUID, notes string) error {
+// UpdateStage moves an application to a pipeline stage and its status category
+func (r *ApplicationRepository) UpdateStage(applicationID uuid.UUID, stageID *uuid.UUID, status domain.ApplicationStatus, updatedBy uuid.UUID) error {
 	return r.db.Model(&domain.Application{}).
 		Where("id = ?", applicationID).
-		Update("employer_notes", notes).Error
+		Updates(map[string]interface{}{
+			"stage_id":          stageID,
+			"status":            status,
+			"status_updated_at": time.Now(),
+			"status_updated_by": updatedBy,
+		}).Error
+}
+
+// GetJobApplicationStages retrieves the stage and status of every application for a job
+func (r *ApplicationRepository) GetJobApplicationStages(jobID uuid.UUID) ([]domain.Application, error) {
+	var applications []domain.Application
+	err := r.db.Model(&domain.Application{}).
+		Select("id", "stage_id", "status").
+		Where("job_id = ?", jobID).
+		Find(&applications).Error
+	return applications, err
 }
 
 // UpdateRating updates the rating for an application
@@ -226,6 +290,31 @@ func (r *ApplicationRepository) CountApplicationsByStatus(jobID uuid.UUID, statu
 	return count, err
 }
 
+// CountByStatusForJobs counts applications with a status for each of the given jobs
+func (r *ApplicationRepository) CountByStatusForJobs(jobIDs []uuid.UUID, status domain.ApplicationStatus) (map[uuid.UUID]int64, error) {
+	result := make(map[uuid.UUID]int64, len(jobIDs))
+	if len(jobIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []struct {
+		JobID uuid.UUID
+		Count int64
+	}
+	err := r.db.Model(&domain.Application{}).
+		Select("job_id, COUNT(*) AS count").
+		Where("job_id IN ? AND status = ?", jobIDs, status).
+		Group("job_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		result[row.JobID] = row.Count
+	}
+	return result, nil
+}
+
 // CountAll counts all applications
 func (r *ApplicationRepository) CountAll() (int64, error) {
 	var count int64
@@ -56,6 +56,15 @@ func (r *CompanyRepository) GetByUserID(userID uuid.UUID) (*domain.Company, erro
 	return &company, nil
 }
 
+// GetJobCompany retrieves the company a job belongs to. Jobs posted by employers
+// are not always linked to a company directly, so the employer's own company is used as a fallback.
+func (r *CompanyRepository) GetJobCompany(job *domain.Job) (*domain.Company, error) {
+	if job.CompanyID != nil {
+		return r.GetByID(*job.CompanyID)
+	}
+	return r.GetByUserID(job.EmployerID)
+}
+
 // Update updates a company
 func (r *CompanyRepository) Update(company *domain.Company) error {
 	return r.db.Save(company).Error
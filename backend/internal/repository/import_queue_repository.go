@@ -0,0 +1,264 @@
+package repository
+
+import (
+	"job-platform/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ImportQueueRepository handles persistence of import queues, import jobs and link extraction tasks
+type ImportQueueRepository struct {
+	db *gorm.DB
+}
+
+// NewImportQueueRepository creates a new import queue repository
+func NewImportQueueRepository(db *gorm.DB) *ImportQueueRepository {
+	return &ImportQueueRepository{db: db}
+}
+
+// CreateQueue creates a queue together with its jobs
+func (r *ImportQueueRepository) CreateQueue(queue *domain.ImportQueue) error {
+	return r.db.Create(queue).Error
+}
+
+// GetQueueByID retrieves a queue with its jobs ordered by position
+func (r *ImportQueueRepository) GetQueueByID(id uuid.UUID) (*domain.ImportQueue, error) {
+	var queue domain.ImportQueue
+	err := r.db.Preload("Jobs", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position ASC")
+	}).Where("id = ?", id).First(&queue).Error
+	if err != nil {
+		return nil, err
+	}
+	return &queue, nil
+}
+
+// GetQueueWithoutJobs retrieves a queue without loading its jobs
+func (r *ImportQueueRepository) GetQueueWithoutJobs(id uuid.UUID) (*domain.ImportQueue, error) {
+	var queue domain.ImportQueue
+	err := r.db.Where("id = ?", id).First(&queue).Error
+	if err != nil {
+		return nil, err
+	}
+	return &queue, nil
+}
+
+// GetAllQueues retrieves all queues, newest first
+func (r *ImportQueueRepository) GetAllQueues() ([]*domain.ImportQueue, error) {
+	var queues []*domain.ImportQueue
+	err := r.db.Preload("Jobs", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position ASC")
+	}).Order("created_at DESC").Find(&queues).Error
+	return queues, err
+}
+
+// UpdateQueueStatus updates a queue status if it is currently in one of the given statuses
+func (r *ImportQueueRepository) UpdateQueueStatus(id uuid.UUID, status domain.ImportStatus, from ...domain.ImportStatus) (bool, error) {
+	query := r.db.Model(&domain.ImportQueue{}).Where("id = ?", id)
+	if len(from) > 0 {
+		query = query.Where("status IN ?", from)
+	}
+	result := query.Update("status", status)
+	return result.RowsAffected > 0, result.Error
+}
+
+// DeleteQueue deletes a queue and its jobs
+func (r *ImportQueueRepository) DeleteQueue(id uuid.UUID) error {
+	return r.db.Where("id = ?", id).Delete(&domain.ImportQueue{}).Error
+}
+
+// DeleteFinishedQueuesBefore deletes queues that are not processing and were last updated before the cutoff
+func (r *ImportQueueRepository) DeleteFinishedQueuesBefore(cutoff time.Time) (int64, error) {
+	result := r.db.Where("status <> ? AND updated_at < ?", domain.ImportStatusProcessing, cutoff).
+		Delete(&domain.ImportQueue{})
+	return result.RowsAffected, result.Error
+}
+
+// RefreshQueueCounts recalculates the completed/failed/cancelled counters of a queue from its jobs
+// and marks the queue completed once no job is pending or processing
+func (r *ImportQueueRepository) RefreshQueueCounts(id uuid.UUID) error {
+	err := r.db.Exec(`
+		UPDATE import_queues SET
+			completed = (SELECT COUNT(*) FROM import_jobs WHERE queue_id = @id AND status = @completed),
+			failed = (SELECT COUNT(*) FROM import_jobs WHERE queue_id = @id AND status = @failed),
+			cancelled = (SELECT COUNT(*) FROM import_jobs WHERE queue_id = @id AND status = @cancelled)
+		WHERE id = @id`,
+		map[string]interface{}{
+			"id":        id,
+			"completed": domain.ImportStatusCompleted,
+			"failed":    domain.ImportStatusFailed,
+			"cancelled": domain.ImportStatusCancelled,
+		}).Error
+	if err != nil {
+		return err
+	}
+
+	return r.db.Exec(`
+		UPDATE import_queues SET status = @completed
+		WHERE id = @id AND status IN (@pending, @processing)
+		AND NOT EXISTS (
+			SELECT 1 FROM import_jobs WHERE queue_id = @id AND status IN (@pending, @processing)
+		)`,
+		map[string]interface{}{
+			"id":         id,
+			"completed":  domain.ImportStatusCompleted,
+			"pending":    domain.ImportStatusPending,
+			"processing": domain.ImportStatusProcessing,
+		}).Error
+}
+
+// ClaimNextJob atomically marks the next pending job of an active queue as processing and returns it.
+// Processing jobs claimed before staleBefore are claimed again, their worker is assumed to have died.
+// Returns nil when there is nothing to process.
+func (r *ImportQueueRepository) ClaimNextJob(now, staleBefore time.Time) (*domain.ImportJob, error) {
+	var jobs []domain.ImportJob
+	err := r.db.Raw(`
+		UPDATE import_jobs SET status = @processing, claimed_at = @now
+		WHERE id = (
+			SELECT ij.id FROM import_jobs ij
+			JOIN import_queues iq ON iq.id = ij.queue_id
+			WHERE (ij.status = @pending OR (ij.status = @processing AND (ij.claimed_at IS NULL OR ij.claimed_at < @stale)))
+			AND iq.status IN (@pending, @processing)
+			ORDER BY iq.created_at ASC, ij.position ASC
+			FOR UPDATE OF ij SKIP LOCKED
+			LIMIT 1
+		)
+		RETURNING *`,
+		map[string]interface{}{
+			"pending":    domain.ImportStatusPending,
+			"processing": domain.ImportStatusProcessing,
+			"now":        now,
+			"stale":      staleBefore,
+		}).Scan(&jobs).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(jobs) == 0 {
+		return nil, nil
+	}
+	return &jobs[0], nil
+}
+
+// UpdateJob saves an import job
+func (r *ImportQueueRepository) UpdateJob(job *domain.ImportJob) error {
+	return r.db.Save(job).Error
+}
+
+// UpdateJobStatus updates the status of jobs in a queue that are currently in one of the given statuses.
+// If jobID is non-nil only that job is updated.
+func (r *ImportQueueRepository) UpdateJobStatus(queueID uuid.UUID, jobID *uuid.UUID, status domain.ImportStatus, from ...domain.ImportStatus) (int64, error) {
+	query := r.db.Model(&domain.ImportJob{}).Where("queue_id = ?", queueID)
+	if jobID != nil {
+		query = query.Where("id = ?", *jobID)
+	}
+	if len(from) > 0 {
+		query = query.Where("status IN ?", from)
+	}
+	result := query.Updates(map[string]interface{}{
+		"status": status,
+		"error":  "",
+	})
+	return result.RowsAffected, result.Error
+}
+
+// GetActiveJobURLs returns which of the given URLs are pending or processing in an active queue
+func (r *ImportQueueRepository) GetActiveJobURLs(urls []string) ([]string, error) {
+	var result []string
+	if len(urls) == 0 {
+		return result, nil
+	}
+
+	err := r.db.Model(&domain.ImportJob{}).
+		Joins("JOIN import_queues ON import_queues.id = import_jobs.queue_id").
+		Where("import_jobs.url IN ?", urls).
+		Where("import_jobs.status IN ?", []domain.ImportStatus{domain.ImportStatusPending, domain.ImportStatusProcessing}).
+		Where("import_queues.status IN ?", []domain.ImportStatus{domain.ImportStatusPending, domain.ImportStatusProcessing}).
+		Distinct().
+		Pluck("import_jobs.url", &result).Error
+	return result, err
+}
+
+// GetDuplicateJobURLs returns which of the given URLs were skipped because the job already exists
+func (r *ImportQueueRepository) GetDuplicateJobURLs(urls []string) ([]string, error) {
+	var result []string
+	if len(urls) == 0 {
+		return result, nil
+	}
+
+	err := r.db.Model(&domain.ImportJob{}).
+		Where("url IN ? AND duplicate_of_id IS NOT NULL", urls).
+		Distinct().
+		Pluck("url", &result).Error
+	return result, err
+}
+
+// CreateExtractionTask creates a link extraction task
+func (r *ImportQueueRepository) CreateExtractionTask(task *domain.LinkExtractionTask) error {
+	return r.db.Create(task).Error
+}
+
+// GetExtractionTaskByID retrieves a link extraction task by ID
+func (r *ImportQueueRepository) GetExtractionTaskByID(id uuid.UUID) (*domain.LinkExtractionTask, error) {
+	var task domain.LinkExtractionTask
+	err := r.db.Where("id = ?", id).First(&task).Error
+	if err != nil {
+		return nil, err
+	}
+	return &task, nil
+}
+
+// GetAllExtractionTasks retrieves all link extraction tasks, newest first
+func (r *ImportQueueRepository) GetAllExtractionTasks() ([]*domain.LinkExtractionTask, error) {
+	var tasks []*domain.LinkExtractionTask
+	err := r.db.Order("created_at DESC").Find(&tasks).Error
+	return tasks, err
+}
+
+// ClaimNextExtractionTask atomically marks the oldest pending link extraction task as processing and
+// returns it. Processing tasks claimed before staleBefore are claimed again, their worker is assumed
+// to have died. Returns nil when there is nothing to extract.
+func (r *ImportQueueRepository) ClaimNextExtractionTask(now, staleBefore time.Time) (*domain.LinkExtractionTask, error) {
+	var tasks []domain.LinkExtractionTask
+	err := r.db.Raw(`
+		UPDATE link_extraction_tasks SET status = @processing, claimed_at = @now, updated_at = @now
+		WHERE id = (
+			SELECT id FROM link_extraction_tasks
+			WHERE status = @pending OR (status = @processing AND (claimed_at IS NULL OR claimed_at < @stale))
+			ORDER BY created_at ASC
+			FOR UPDATE SKIP LOCKED
+			LIMIT 1
+		)
+		RETURNING *`,
+		map[string]interface{}{
+			"pending":    domain.ImportStatusPending,
+			"processing": domain.ImportStatusProcessing,
+			"now":        now,
+			"stale":      staleBefore,
+		}).Scan(&tasks).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(tasks) == 0 {
+		return nil, nil
+	}
+	return &tasks[0], nil
+}
+
+// UpdateExtractionTask saves a link extraction task
+func (r *ImportQueueRepository) UpdateExtractionTask(task *domain.LinkExtractionTask) error {
+	return r.db.Save(task).Error
+}
+
+// DeleteExtractionTask deletes a link extraction task
+func (r *ImportQueueRepository) DeleteExtractionTask(id uuid.UUID) error {
+	return r.db.Where("id = ?", id).Delete(&domain.LinkExtractionTask{}).Error
+}
+
+// DeleteFinishedExtractionTasksBefore deletes tasks that are not processing and were last updated before the cutoff
+func (r *ImportQueueRepository) DeleteFinishedExtractionTasksBefore(cutoff time.Time) (int64, error) {
+	result := r.db.Where("status <> ? AND updated_at < ?", domain.ImportStatusProcessing, cutoff).
+		Delete(&domain.LinkExtractionTask{})
+	return result.RowsAffected, result.Error
+}
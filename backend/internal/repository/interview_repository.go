@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"job-platform/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// InterviewRepository handles interview database operations
+type InterviewRepository struct {
+	db *gorm.DB
+}
+
+// NewInterviewRepository creates a new interview repository
+func NewInterviewRepository(db *gorm.DB) *InterviewRepository {
+	return &InterviewRepository{db: db}
+}
+
+// Create creates an interview together with its slots and interviewers
+func (r *InterviewRepository) Create(interview *domain.Interview) error {
+	return r.db.Create(interview).Error
+}
+
+// Update updates an interview's own columns
+func (r *InterviewRepository) Update(interview *domain.Interview) error {
+	return r.db.Omit("Application", "Slots", "Interviewers").Save(interview).Error
+}
+
+// GetByID retrieves an interview with its slots, interviewers and application
+func (r *InterviewRepository) GetByID(id uuid.UUID) (*domain.Interview, error) {
+	var interview domain.Interview
+	err := r.withDetails(r.db).
+		Where("id = ?", id).
+		First(&interview).Error
+	if err != nil {
+		return nil, err
+	}
+	return &interview, nil
+}
+
+// GetByApplicationID retrieves all interviews of an application, newest first
+func (r *InterviewRepository) GetByApplicationID(applicationID uuid.UUID) ([]domain.Interview, error) {
+	var interviews []domain.Interview
+	err := r.withDetails(r.db).
+		Where("application_id = ?", applicationID).
+		Order("created_at DESC").
+		Find(&interviews).Error
+	return interviews, err
+}
+
+// ReplaceSlots replaces the proposed slots of an interview
+func (r *InterviewRepository) ReplaceSlots(interviewID uuid.UUID, slots []domain.InterviewSlot) error {
+	if err := r.db.Where("interview_id = ?", interviewID).Delete(&domain.InterviewSlot{}).Error; err != nil {
+		return err
+	}
+	if len(slots) == 0 {
+		return nil
+	}
+	for i := range slots {
+		slots[i].InterviewID = interviewID
+	}
+	return r.db.Create(&slots).Error
+}
+
+// withDetails preloads the relationships needed to render and notify about an interview
+func (r *InterviewRepository) withDetails(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Slots", func(db *gorm.DB) *gorm.DB {
+			return db.Order("start_time ASC")
+		}).
+		Preload("Interviewers").
+		Preload("Interviewers.User").
+		Preload("Application").
+		Preload("Application.Job").
+		Preload("Application.Applicant")
+}
@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"job-platform/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// JobDuplicateRepository handles job fingerprint and duplicate flag database operations
+type JobDuplicateRepository struct {
+	db *gorm.DB
+}
+
+// NewJobDuplicateRepository creates a new job duplicate repository
+func NewJobDuplicateRepository(db *gorm.DB) *JobDuplicateRepository {
+	return &JobDuplicateRepository{db: db}
+}
+
+// ============================================================
+// FINGERPRINTS
+// ============================================================
+
+// UpsertFingerprint creates or replaces the fingerprint of a job
+func (r *JobDuplicateRepository) UpsertFingerprint(fingerprint *domain.JobFingerprint) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "job_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"title_key", "company_key", "location_key", "description_hash", "updated_at"}),
+	}).Create(fingerprint).Error
+}
+
+// closedJobStatuses are the statuses of jobs that are no longer live and can't be duplicated
+var closedJobStatuses = []domain.JobStatus{domain.JobStatusExpired, domain.JobStatusClosed, domain.JobStatusRejected}
+
+// GetCandidates retrieves the fingerprints of open jobs at the same company, excluding a job.
+// Jobs with the same title key come first, then the newest, so the most likely duplicates are
+// compared even at companies with more open jobs than the limit.
+func (r *JobDuplicateRepository) GetCandidates(companyKey, titleKey string, excludeJobID uuid.UUID) ([]domain.JobFingerprint, error) {
+	var fingerprints []domain.JobFingerprint
+	err := r.db.
+		Joins("JOIN jobs ON jobs.id = job_fingerprints.job_id").
+		Where("job_fingerprints.company_key = ? AND job_fingerprints.job_id <> ?", companyKey, excludeJobID).
+		Where("jobs.deleted_at IS NULL AND jobs.status NOT IN ?", closedJobStatuses).
+		Order(clause.OrderBy{Expression: clause.Expr{
+			SQL:                "job_fingerprints.title_key = ? DESC, jobs.created_at DESC",
+			Vars:               []interface{}{titleKey},
+			WithoutParentheses: true,
+		}}).
+		Limit(500).
+		Find(&fingerprints).Error
+	return fingerprints, err
+}
+
+// GetJobIDByOriginalURL retrieves the oldest live job imported from a URL, excluding a job
+func (r *JobDuplicateRepository) GetJobIDByOriginalURL(url string, excludeJobID uuid.UUID) (*uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := r.db.Model(&domain.Job{}).
+		Where("original_url = ? AND id <> ? AND deleted_at IS NULL", url, excludeJobID).
+		Where("status NOT IN ?", closedJobStatuses).
+		Order("created_at ASC").
+		Limit(1).
+		Pluck("id", &ids).Error
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+	return &ids[0], nil
+}
+
+// GetJobsForFingerprinting retrieves a batch of live jobs ordered by ID, starting after a job
+func (r *JobDuplicateRepository) GetJobsForFingerprinting(afterID *uuid.UUID, limit int) ([]domain.Job, error) {
+	var jobs []domain.Job
+	query := r.db.Where("deleted_at IS NULL")
+	if afterID != nil {
+		query = query.Where("id > ?", *afterID)
+	}
+	err := query.Order("id ASC").Limit(limit).Find(&jobs).Error
+	return jobs, err
+}
+
+// ============================================================
+// DUPLICATE FLAGS
+// ============================================================
+
+// CreateFlag flags a job as a duplicate, doing nothing if the pair is already flagged
+func (r *JobDuplicateRepository) CreateFlag(flag *domain.JobDuplicate) error {
+	return r.db.Omit("Job", "DuplicateOf").
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(flag).Error
+}
+
+// UpdateFlag updates a duplicate flag's own columns
+func (r *JobDuplicateRepository) UpdateFlag(flag *domain.JobDuplicate) error {
+	return r.db.Omit("Job", "DuplicateOf").Save(flag).Error
+}
+
+// GetFlag retrieves a duplicate flag with both jobs
+func (r *JobDuplicateRepository) GetFlag(id uuid.UUID) (*domain.JobDuplicate, error) {
+	var flag domain.JobDuplicate
+	err := r.withJobs(r.db).
+		Where("id = ?", id).
+		First(&flag).Error
+	if err != nil {
+		return nil, err
+	}
+	return &flag, nil
+}
+
+// ListFlags retrieves duplicate flags, optionally filtered by status, newest first
+func (r *JobDuplicateRepository) ListFlags(status *domain.JobDuplicateStatus, limit, offset int) ([]domain.JobDuplicate, int64, error) {
+	var flags []domain.JobDuplicate
+	var total int64
+
+	query := r.db.Model(&domain.JobDuplicate{})
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := r.withJobs(query).
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&flags).Error
+	return flags, total, err
+}
+
+// GetFlagsByJobID retrieves all flags a job is part of, on either side
+func (r *JobDuplicateRepository) GetFlagsByJobID(jobID uuid.UUID) ([]domain.JobDuplicate, error) {
+	var flags []domain.JobDuplicate
+	err := r.withJobs(r.db).
+		Where("job_id = ? OR duplicate_of_id = ?", jobID, jobID).
+		Order("created_at DESC").
+		Find(&flags).Error
+	return flags, err
+}
+
+// FlagExists checks if two jobs are already flagged, in either direction
+func (r *JobDuplicateRepository) FlagExists(jobID, otherJobID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.Model(&domain.JobDuplicate{}).
+		Where("(job_id = ? AND duplicate_of_id = ?) OR (job_id = ? AND duplicate_of_id = ?)", jobID, otherJobID, otherJobID, jobID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// DismissPendingFlags dismisses the pending flags a job is part of
+func (r *JobDuplicateRepository) DismissPendingFlags(jobID, resolvedBy uuid.UUID) error {
+	return r.db.Model(&domain.JobDuplicate{}).
+		Where("(job_id = ? OR duplicate_of_id = ?) AND status = ?", jobID, jobID, domain.JobDuplicateStatusPending).
+		Updates(map[string]interface{}{
+			"status":      domain.JobDuplicateStatusDismissed,
+			"resolved_by": resolvedBy,
+			"resolved_at": gorm.Expr("NOW()"),
+		}).Error
+}
+
+// withJobs preloads both jobs of a flag, including soft-deleted ones
+func (r *JobDuplicateRepository) withJobs(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Job").
+		Preload("DuplicateOf")
+}
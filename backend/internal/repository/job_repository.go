@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // JobRepository handles job database operations
@@ -47,6 +48,11 @@ func (r *JobRepository) GetByID(jobID uuid.UUID) (*domain.Job, error) {
 	err := r.db.
 		Preload("Employer").
 		Preload("Categories").
+		Preload("HiringManager").
+		Preload("HiringManager.User").
+		Preload("ScreeningQuestions", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position ASC")
+		}).
 		Where("id = ? AND deleted_at IS NULL", jobID).
 		First(&job).Error
 	if err != nil {
@@ -61,6 +67,9 @@ func (r *JobRepository) GetBySlug(slug string) (*domain.Job, error) {
 	err := r.db.
 		Preload("Employer").
 		Preload("Categories").
+		Preload("ScreeningQuestions", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position ASC")
+		}).
 		Where("slug = ? AND deleted_at IS NULL", slug).
 		First(&job).Error
 	if err != nil {
@@ -110,6 +119,8 @@ func (r *JobRepository) GetEmployerJobs(employerID uuid.UUID, limit, offset int)
 	// Get paginated results
 	err := query.
 		Preload("Categories").
+		Preload("HiringManager").
+		Preload("HiringManager.User").
 		Order("created_at DESC").
 		Limit(limit).
 		Offset(offset).
@@ -142,6 +153,101 @@ func (r *JobRepository) GetJobsForSitemap() ([]domain.Job, error) {
 	return jobs, err
 }
 
+// FeedFilters filters the active jobs included in a job feed
+type FeedFilters struct {
+	CategorySlug string
+	CompanySlug  string
+	Location     string
+	Limit        int // Maximum number of jobs, 0 for all
+}
+
+// CountFeedJobs counts the jobs a feed will contain
+func (r *JobRepository) CountFeedJobs(filters FeedFilters) (int64, error) {
+	var count int64
+	err := r.feedQuery(filters).Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+	if filters.Limit > 0 && count > int64(filters.Limit) {
+		count = int64(filters.Limit)
+	}
+	return count, nil
+}
+
+// StreamFeedJobs loads the jobs of a feed in batches, newest first, and passes each batch to fn.
+// Only one batch is held in memory at a time. Batches continue after the last job of the previous
+// batch instead of using an offset, so jobs published or closed while streaming don't shift the
+// remaining batches.
+func (r *JobRepository) StreamFeedJobs(filters FeedFilters, batchSize int, fn func(jobs []domain.Job) error) error {
+	var after *domain.Job
+	for streamed := 0; filters.Limit == 0 || streamed < filters.Limit; streamed += batchSize {
+		size := batchSize
+		if filters.Limit > 0 && streamed+size > filters.Limit {
+			size = filters.Limit - streamed
+		}
+
+		query := r.feedQuery(filters)
+		if after != nil {
+			query = query.Where("(COALESCE(jobs.published_at, jobs.created_at), jobs.id) < (?, ?)", feedSortTime(after), after.ID)
+		}
+
+		var jobs []domain.Job
+		err := query.
+			Preload("Categories").
+			Order("COALESCE(jobs.published_at, jobs.created_at) DESC, jobs.id DESC").
+			Limit(size).
+			Find(&jobs).Error
+		if err != nil {
+			return err
+		}
+		if len(jobs) == 0 {
+			return nil
+		}
+
+		if err := fn(jobs); err != nil {
+			return err
+		}
+		if len(jobs) < size {
+			return nil
+		}
+		after = &jobs[len(jobs)-1]
+	}
+	return nil
+}
+
+// feedSortTime returns the time feeds are sorted by: when the job was published, or created if it wasn't
+func feedSortTime(job *domain.Job) time.Time {
+	if job.PublishedAt != nil {
+		return *job.PublishedAt
+	}
+	return job.CreatedAt
+}
+
+// feedQuery builds the query for the active jobs of a feed
+func (r *JobRepository) feedQuery(filters FeedFilters) *gorm.DB {
+	query := r.db.Model(&domain.Job{}).
+		Where("jobs.status = ? AND jobs.deleted_at IS NULL", domain.JobStatusActive)
+
+	if filters.CategorySlug != "" {
+		query = query.Where("jobs.id IN (?)", r.db.Table("job_category_mappings").
+			Select("job_category_mappings.job_id").
+			Joins("JOIN job_categories ON job_categories.id = job_category_mappings.category_id").
+			Where("job_categories.slug = ?", filters.CategorySlug))
+	}
+	if filters.CompanySlug != "" {
+		query = query.Where("jobs.company_id IN (?)", r.db.Table("companies").
+			Select("id").
+			Where("slug = ? AND deleted_at IS NULL", filters.CompanySlug))
+	}
+	if filters.Location != "" {
+		locationPattern := "%" + filters.Location + "%"
+		query = query.Where("jobs.location ILIKE ? OR jobs.city ILIKE ? OR jobs.state ILIKE ? OR jobs.country ILIKE ?",
+			locationPattern, locationPattern, locationPattern, locationPattern)
+	}
+
+	return query
+}
+
 // CountActiveJobsByEmployer counts active jobs for an employer
 func (r *JobRepository) CountActiveJobsByEmployer(employerID uuid.UUID) (int64, error) {
 	var count int64
@@ -160,6 +266,20 @@ func (r *JobRepository) SlugExists(slug string) (bool, error) {
 	return count > 0, err
 }
 
+// GetExistingOriginalURLs returns which of the given original URLs already belong to a job
+func (r *JobRepository) GetExistingOriginalURLs(urls []string) ([]string, error) {
+	var result []string
+	if len(urls) == 0 {
+		return result, nil
+	}
+
+	err := r.db.Model(&domain.Job{}).
+		Where("original_url IN ? AND deleted_at IS NULL", urls).
+		Distinct().
+		Pluck("original_url", &result).Error
+	return result, err
+}
+
 // IncrementViewCount increments the view count for a job
 func (r *JobRepository) IncrementViewCount(jobID uuid.UUID) error {
 	return r.db.Model(&domain.Job{}).
@@ -188,6 +308,58 @@ func (r *JobRepository) UpdateStatus(jobID uuid.UUID, status domain.JobStatus) e
 		Update("status", status).Error
 }
 
+// CloseIfFilled closes an active job and records when it was filled once the number of
+// hired applications reaches its openings. It reports whether the job was closed.
+func (r *JobRepository) CloseIfFilled(jobID uuid.UUID) (bool, error) {
+	result := r.db.Model(&domain.Job{}).
+		Where("id = ? AND status = ?", jobID, domain.JobStatusActive).
+		Where("openings <= (SELECT COUNT(*) FROM applications WHERE applications.job_id = jobs.id AND applications.status = ?)", domain.ApplicationStatusHired).
+		Updates(map[string]interface{}{
+			"status":    domain.JobStatusClosed,
+			"filled_at": time.Now(),
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// LockOpenings locks an active job and checks that it has openings left. Call it in the
+// transaction that hires for the job, so concurrent hires wait for each other instead of
+// filling more openings than the job has.
+func (r *JobRepository) LockOpenings(jobID uuid.UUID) (bool, error) {
+	var jobs []domain.Job
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id", "openings").
+		Where("id = ? AND status = ? AND deleted_at IS NULL", jobID, domain.JobStatusActive).
+		Find(&jobs).Error; err != nil {
+		return false, err
+	}
+	if len(jobs) == 0 {
+		return false, nil
+	}
+
+	var hired int64
+	if err := r.db.Model(&domain.Application{}).
+		Where("job_id = ? AND status = ?", jobID, domain.ApplicationStatusHired).
+		Count(&hired).Error; err != nil {
+		return false, err
+	}
+	return hired < int64(jobs[0].Openings), nil
+}
+
+// GetEmployerRequisitions retrieves an employer's jobs that have a requisition ID with their hiring
+// details, newest first. An empty requisitionID returns the jobs of all requisitions.
+func (r *JobRepository) GetEmployerRequisitions(employerID uuid.UUID, requisitionID string) ([]domain.Job, error) {
+	var jobs []domain.Job
+	query := r.db.
+		Preload("HiringManager").
+		Preload("HiringManager.User").
+		Where("employer_id = ? AND requisition_id <> '' AND deleted_at IS NULL", employerID)
+	if requisitionID != "" {
+		query = query.Where("requisition_id = ?", requisitionID)
+	}
+	err := query.Order("created_at DESC").Find(&jobs).Error
+	return jobs, err
+}
+
 // GetExpiredJobs retrieves jobs that have expired but not marked as expired
 func (r *JobRepository) GetExpiredJobs() ([]domain.Job, error) {
 	var jobs []domain.Job
@@ -197,6 +369,49 @@ func (r *JobRepository) GetExpiredJobs() ([]domain.Job, error) {
 	return jobs, err
 }
 
+// GetDueScheduledJobs retrieves scheduled jobs whose publish time has been reached
+func (r *JobRepository) GetDueScheduledJobs(now time.Time) ([]domain.Job, error) {
+	var jobs []domain.Job
+	err := r.db.
+		Where("status = ? AND published_at <= ? AND deleted_at IS NULL", domain.JobStatusScheduled, now).
+		Find(&jobs).Error
+	return jobs, err
+}
+
+// PublishScheduled makes a scheduled job active with a new expiry date. It reports whether the
+// job was published, so a job unscheduled in the meantime is left alone.
+func (r *JobRepository) PublishScheduled(jobID uuid.UUID, expiresAt time.Time) (bool, error) {
+	result := r.db.Model(&domain.Job{}).
+		Where("id = ? AND status = ?", jobID, domain.JobStatusScheduled).
+		Updates(map[string]interface{}{
+			"status":     domain.JobStatusActive,
+			"expires_at": expiresAt,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// GetPendingReviewJobs retrieves the jobs waiting for company review in the given companies
+func (r *JobRepository) GetPendingReviewJobs(companyIDs []uuid.UUID, limit, offset int) ([]domain.Job, int64, error) {
+	var jobs []domain.Job
+	var total int64
+
+	query := r.db.Model(&domain.Job{}).
+		Where("status = ? AND company_id IN ? AND deleted_at IS NULL", domain.JobStatusPendingReview, companyIDs)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Preload("Employer").
+		Preload("Categories").
+		Order("updated_at ASC").
+		Limit(limit).
+		Offset(offset).
+		Find(&jobs).Error
+	return jobs, total, err
+}
+
 // GetJobsExpiringBefore retrieves jobs expiring before a specific date
 func (r *JobRepository) GetJobsExpiringBefore(date time.Time) ([]domain.Job, error) {
 	var jobs []domain.Job
@@ -339,6 +554,36 @@ func (r *JobRepository) AddCategories(jobID uuid.UUID, categoryIDs []uuid.UUID)
 	return r.db.Model(&job).Association("Categories").Replace(categories)
 }
 
+// SaveScreeningQuestions sets the screening questions of a job. Questions with the ID of an existing
+// question of the job update it, the others are added, and questions not in the list are deleted.
+func (r *JobRepository) SaveScreeningQuestions(jobID uuid.UUID, questions []domain.ScreeningQuestion) error {
+	ids := make([]uuid.UUID, len(questions))
+	for i := range questions {
+		questions[i].JobID = jobID
+		ids[i] = questions[i].ID
+	}
+
+	query := r.db.Where("job_id = ?", jobID)
+	if len(ids) > 0 {
+		query = query.Where("id NOT IN ?", ids)
+	}
+	if err := query.Delete(&domain.ScreeningQuestion{}).Error; err != nil {
+		return err
+	}
+	if len(questions) == 0 {
+		return nil
+	}
+
+	return r.db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"position", "question", "question_type", "options", "min_value", "max_value",
+			"is_required", "knockout_action", "knockout_answers",
+		}),
+		Where: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "job_screening_questions.job_id = excluded.job_id"}}},
+	}).Create(&questions).Error
+}
+
 // RemoveCategories removes categories from a job
 func (r *JobRepository) RemoveCategories(jobID uuid.UUID, categoryIDs []uuid.UUID) error {
 	var job domain.Job
@@ -423,6 +668,26 @@ func (r *JobRepository) GetJobsNearExpiry(daysUntilExpiry int) ([]domain.Job, er
 	return jobs, err
 }
 
+// GetJobsNeedingExpiryWarning retrieves active jobs expiring within the given days
+// whose employer has not been warned yet
+func (r *JobRepository) GetJobsNeedingExpiryWarning(daysUntilExpiry int) ([]domain.Job, error) {
+	var jobs []domain.Job
+	now := time.Now()
+
+	err := r.db.Where("status = ? AND expires_at <= ? AND expires_at > ? AND expiry_warning_sent_at IS NULL AND deleted_at IS NULL",
+		domain.JobStatusActive, now.AddDate(0, 0, daysUntilExpiry), now).
+		Find(&jobs).Error
+
+	return jobs, err
+}
+
+// MarkExpiryWarningSent records that the expiry warning was sent for a job
+func (r *JobRepository) MarkExpiryWarningSent(jobID uuid.UUID) error {
+	return r.db.Model(&domain.Job{}).
+		Where("id = ?", jobID).
+		Update("expiry_warning_sent_at", time.Now()).Error
+}
+
 // GetPendingJobs retrieves all pending jobs
 func (r *JobRepository) GetPendingJobs(limit, offset int) ([]domain.Job, int64, error) {
 	var jobs []domain.Job
@@ -594,9 +859,41 @@ func (r *JobRepository) GetFilteredJobs(filters JobFilters, limit, offset int) (
 	var jobs []domain.Job
 	var total int64
 
-	query := r.db.Model(&domain.Job{}).
-		Where("status = ? AND deleted_at IS NULL", domain.JobStatusActive)
+	query := applyJobFilters(r.db.Model(&domain.Job{}).
+		Where("status = ? AND deleted_at IS NULL", domain.JobStatusActive), filters)
 
+	// Get total count
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Get paginated results
+	err := query.
+		Preload("Employer").
+		Preload("Categories").
+		Order("published_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&jobs).Error
+
+	return jobs, total, err
+}
+
+// GetMatchingJobIDs retrieves the IDs of active jobs published after since that match the filters and
+// were not matched to the saved search yet, oldest first
+func (r *JobRepository) GetMatchingJobIDs(searchID uuid.UUID, filters JobFilters, since time.Time, limit int) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := applyJobFilters(r.db.Model(&domain.Job{}).
+		Where("jobs.status = ? AND jobs.deleted_at IS NULL AND jobs.published_at > ?", domain.JobStatusActive, since), filters).
+		Where("NOT EXISTS (SELECT 1 FROM saved_search_matches m WHERE m.saved_search_id = ? AND m.job_id = jobs.id)", searchID).
+		Order("jobs.published_at ASC").
+		Limit(limit).
+		Pluck("jobs.id", &ids).Error
+	return ids, err
+}
+
+// applyJobFilters narrows a job query down to the jobs matching the filters
+func applyJobFilters(query *gorm.DB, filters JobFilters) *gorm.DB {
 	// Apply search query
 	if filters.Query != "" {
 		searchPattern := "%" + filters.Query + "%"
@@ -641,19 +938,5 @@ func (r *JobRepository) GetFilteredJobs(filters JobFilters, limit, offset int) (
 			Where("job_categories.slug = ?", filters.CategorySlug)
 	}
 
-	// Get total count
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
-	}
-
-	// Get paginated results
-	err := query.
-		Preload("Employer").
-		Preload("Categories").
-		Order("published_at DESC").
-		Limit(limit).
-		Offset(offset).
-		Find(&jobs).Error
-
-	return jobs, total, err
+	return query
 }
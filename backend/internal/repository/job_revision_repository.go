@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"errors"
+	"job-platform/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// JobRevisionRepository handles job revision database operations
+type JobRevisionRepository struct {
+	db *gorm.DB
+}
+
+// NewJobRevisionRepository creates a new job revision repository
+func NewJobRevisionRepository(db *gorm.DB) *JobRevisionRepository {
+	return &JobRevisionRepository{db: db}
+}
+
+// Create creates a job revision
+func (r *JobRevisionRepository) Create(revision *domain.JobRevision) error {
+	return r.db.Omit("ChangedByUser").Create(revision).Error
+}
+
+// GetByID retrieves a revision of a job
+func (r *JobRevisionRepository) GetByID(jobID, revisionID uuid.UUID) (*domain.JobRevision, error) {
+	var revision domain.JobRevision
+	err := r.db.
+		Preload("ChangedByUser").
+		Where("id = ? AND job_id = ?", revisionID, jobID).
+		First(&revision).Error
+	if err != nil {
+		return nil, err
+	}
+	return &revision, nil
+}
+
+// GetByJobID retrieves all revisions of a job, oldest first
+func (r *JobRevisionRepository) GetByJobID(jobID uuid.UUID) ([]domain.JobRevision, error) {
+	var revisions []domain.JobRevision
+	err := r.db.
+		Preload("ChangedByUser").
+		Where("job_id = ?", jobID).
+		Order("revision_number ASC").
+		Find(&revisions).Error
+	return revisions, err
+}
+
+// GetLatest retrieves the newest revision of a job, or nil if the job has none
+func (r *JobRevisionRepository) GetLatest(jobID uuid.UUID) (*domain.JobRevision, error) {
+	var revision domain.JobRevision
+	err := r.db.
+		Where("job_id = ?", jobID).
+		Order("revision_number DESC").
+		First(&revision).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &revision, nil
+}
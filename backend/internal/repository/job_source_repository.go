@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"job-platform/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// JobSourceRepository handles persistence of scheduled career-page job sources
+type JobSourceRepository struct {
+	db *gorm.DB
+}
+
+// NewJobSourceRepository creates a new job source repository
+func NewJobSourceRepository(db *gorm.DB) *JobSourceRepository {
+	return &JobSourceRepository{db: db}
+}
+
+// Create creates a new job source
+func (r *JobSourceRepository) Create(source *domain.JobSource) error {
+	return r.db.Create(source).Error
+}
+
+// Update saves a job source
+func (r *JobSourceRepository) Update(source *domain.JobSource) error {
+	return r.db.Save(source).Error
+}
+
+// Delete deletes a job source
+func (r *JobSourceRepository) Delete(id uuid.UUID) error {
+	return r.db.Where("id = ?", id).Delete(&domain.JobSource{}).Error
+}
+
+// GetByID retrieves a job source by ID
+func (r *JobSourceRepository) GetByID(id uuid.UUID) (*domain.JobSource, error) {
+	var source domain.JobSource
+	err := r.db.Where("id = ?", id).First(&source).Error
+	if err != nil {
+		return nil, err
+	}
+	return &source, nil
+}
+
+// List retrieves job sources with pagination, optionally filtered by company
+func (r *JobSourceRepository) List(companyID *uuid.UUID, limit, offset int) ([]domain.JobSource, int64, error) {
+	var sources []domain.JobSource
+	var total int64
+
+	query := r.db.Model(&domain.JobSource{})
+	if companyID != nil {
+		query = query.Where("company_id = ?", *companyID)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&sources).Error
+
+	return sources, total, err
+}
+
+// ClaimDueSource atomically claims the active source whose crawl is the most overdue by moving its
+// next crawl to leaseUntil, so other instances skip it while it is being crawled. The crawl result
+// schedules the real next crawl; if the crawling instance dies the source is due again at leaseUntil.
+// Returns nil without error when no source is due.
+func (r *JobSourceRepository) ClaimDueSource(now, leaseUntil time.Time) (*domain.JobSource, error) {
+	var sources []domain.JobSource
+	err := r.db.Raw(`
+		UPDATE job_sources SET next_crawl_at = @lease
+		WHERE id = (
+			SELECT id FROM job_sources
+			WHERE is_active = true AND (next_crawl_at IS NULL OR next_crawl_at <= @now)
+			ORDER BY next_crawl_at ASC NULLS FIRST
+			FOR UPDATE SKIP LOCKED
+			LIMIT 1
+		)
+		RETURNING *`,
+		map[string]interface{}{
+			"now":   now,
+			"lease": leaseUntil,
+		}).Scan(&sources).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(sources) == 0 {
+		return nil, nil
+	}
+	return &sources[0], nil
+}
+
+// GetActiveSourceJobs retrieves active jobs that were imported from a source
+func (r *JobSourceRepository) GetActiveSourceJobs(sourceID uuid.UUID) ([]domain.Job, error) {
+	var jobs []domain.Job
+	err := r.db.
+		Where("job_source_id = ? AND status = ? AND deleted_at IS NULL", sourceID, domain.JobStatusActive).
+		Find(&jobs).Error
+	return jobs, err
+}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"job-platform/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// JobUploadRepository handles bulk job upload database operations
+type JobUploadRepository struct {
+	db *gorm.DB
+}
+
+// NewJobUploadRepository creates a new job upload repository
+func NewJobUploadRepository(db *gorm.DB) *JobUploadRepository {
+	return &JobUploadRepository{db: db}
+}
+
+// Create creates an upload with its rows
+func (r *JobUploadRepository) Create(upload *domain.JobUpload) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		rows := upload.Rows
+		if err := tx.Omit("Rows").Create(upload).Error; err != nil {
+			return err
+		}
+		for i := range rows {
+			rows[i].UploadID = upload.ID
+		}
+		if len(rows) > 0 {
+			if err := tx.CreateInBatches(rows, 100).Error; err != nil {
+				return err
+			}
+		}
+		upload.Rows = rows
+		return nil
+	})
+}
+
+// GetByID retrieves an upload by ID, with its rows if requested
+func (r *JobUploadRepository) GetByID(id uuid.UUID, withRows bool) (*domain.JobUpload, error) {
+	var upload domain.JobUpload
+	query := r.db
+	if withRows {
+		query = query.Preload("Rows", func(db *gorm.DB) *gorm.DB {
+			return db.Order("row_number ASC")
+		})
+	}
+	if err := query.Where("id = ?", id).First(&upload).Error; err != nil {
+		return nil, err
+	}
+	return &upload, nil
+}
+
+// GetByUploader retrieves a user's uploads of a mode without their rows, newest first
+func (r *JobUploadRepository) GetByUploader(userID uuid.UUID, mode domain.JobUploadMode, limit, offset int) ([]domain.JobUpload, int64, error) {
+	var uploads []domain.JobUpload
+	var total int64
+
+	query := r.db.Model(&domain.JobUpload{}).Where("uploaded_by = ? AND mode = ?", userID, mode)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Order("created_at DESC").Limit(limit).Offset(offset).Find(&uploads).Error
+	return uploads, total, err
+}
+
+// Confirm queues a validated upload for processing. Returns false if the upload was already confirmed.
+func (r *JobUploadRepository) Confirm(id uuid.UUID, at time.Time) (bool, error) {
+	result := r.db.Model(&domain.JobUpload{}).
+		Where("id = ? AND status = ?", id, domain.JobUploadStatusValidated).
+		Updates(map[string]interface{}{
+			"status":       domain.JobUploadStatusPending,
+			"confirmed_at": at,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// ClaimNext atomically marks the oldest pending upload as processing and returns it. Processing
+// uploads whose claim was last renewed before staleBefore are claimed again, their worker is
+// assumed to have died. Returns nil without error when there is nothing to process.
+func (r *JobUploadRepository) ClaimNext(now, staleBefore time.Time) (*domain.JobUpload, error) {
+	var uploads []domain.JobUpload
+	err := r.db.Raw(`
+		UPDATE job_uploads SET status = @processing, claimed_at = @now
+		WHERE id = (
+			SELECT id FROM job_uploads
+			WHERE status = @pending OR (status = @processing AND (claimed_at IS NULL OR claimed_at < @stale))
+			ORDER BY confirmed_at ASC
+			FOR UPDATE SKIP LOCKED
+			LIMIT 1
+		)
+		RETURNING *`,
+		map[string]interface{}{
+			"pending":    domain.JobUploadStatusPending,
+			"processing": domain.JobUploadStatusProcessing,
+			"now":        now,
+			"stale":      staleBefore,
+		}).Scan(&uploads).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(uploads) == 0 {
+		return nil, nil
+	}
+	return &uploads[0], nil
+}
+
+// RenewClaim extends the lease of a processing upload
+func (r *JobUploadRepository) RenewClaim(id uuid.UUID, now time.Time) error {
+	return r.db.Model(&domain.JobUpload{}).
+		Where("id = ? AND status = ?", id, domain.JobUploadStatusProcessing).
+		Update("claimed_at", now).Error
+}
+
+// GetRowsByStatus retrieves the rows of an upload with a status, in file order
+func (r *JobUploadRepository) GetRowsByStatus(uploadID uuid.UUID, status domain.JobUploadRowStatus) ([]domain.JobUploadRow, error) {
+	var rows []domain.JobUploadRow
+	err := r.db.Where("upload_id = ? AND status = ?", uploadID, status).
+		Order("row_number ASC").
+		Find(&rows).Error
+	return rows, err
+}
+
+// FinishRow records the result of a valid row. Returns false if the row is no longer valid,
+// i.e. another worker already processed it.
+func (r *JobUploadRepository) FinishRow(row *domain.JobUploadRow) (bool, error) {
+	result := r.db.Model(&domain.JobUploadRow{}).
+		Where("id = ? AND status = ?", row.ID, domain.JobUploadRowStatusValid).
+		Updates(map[string]interface{}{
+			"status": row.Status,
+			"error":  row.Error,
+			"job_id": row.JobID,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// RefreshCounts recalculates the created and failed counters of an upload
+func (r *JobUploadRepository) RefreshCounts(uploadID uuid.UUID) error {
+	return r.db.Exec(`
+		UPDATE job_uploads SET
+			created_jobs = (SELECT COUNT(*) FROM job_upload_rows WHERE upload_id = ? AND status = ?),
+			failed_rows = (SELECT COUNT(*) FROM job_upload_rows WHERE upload_id = ? AND status = ?)
+		WHERE id = ?`,
+		uploadID, domain.JobUploadRowStatusCreated,
+		uploadID, domain.JobUploadRowStatusFailed,
+		uploadID).Error
+}
+
+// Complete marks an upload as completed
+func (r *JobUploadRepository) Complete(id uuid.UUID, at time.Time) error {
+	return r.db.Model(&domain.JobUpload{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":       domain.JobUploadStatusCompleted,
+			"completed_at": at,
+		}).Error
+}
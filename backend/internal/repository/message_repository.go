@@ -0,0 +1,213 @@
+package repository
+
+import (
+	"job-platform/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// MessageRepository handles conversation and message database operations
+type MessageRepository struct {
+	db *gorm.DB
+}
+
+// NewMessageRepository creates a new message repository
+func NewMessageRepository(db *gorm.DB) *MessageRepository {
+	return &MessageRepository{db: db}
+}
+
+// GetOrCreateConversation retrieves the conversation of an application, creating it if needed
+func (r *MessageRepository) GetOrCreateConversation(conversation *domain.Conversation) (*domain.Conversation, error) {
+	err := r.db.
+		Omit("Application", "Employer", "Candidate").
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "application_id"}}, DoNothing: true}).
+		Create(conversation).Error
+	if err != nil {
+		return nil, err
+	}
+	return r.GetConversationByApplication(conversation.ApplicationID)
+}
+
+// GetConversationByApplication retrieves the conversation of an application
+func (r *MessageRepository) GetConversationByApplication(applicationID uuid.UUID) (*domain.Conversation, error) {
+	var conversation domain.Conversation
+	err := r.conversationQuery().
+		Where("application_id = ?", applicationID).
+		First(&conversation).Error
+	if err != nil {
+		return nil, err
+	}
+	return &conversation, nil
+}
+
+// GetConversationByID retrieves a conversation by ID
+func (r *MessageRepository) GetConversationByID(id uuid.UUID) (*domain.Conversation, error) {
+	var conversation domain.Conversation
+	err := r.conversationQuery().
+		Where("id = ?", id).
+		First(&conversation).Error
+	if err != nil {
+		return nil, err
+	}
+	return &conversation, nil
+}
+
+// GetUserConversations retrieves a user's conversations, most recently active first
+func (r *MessageRepository) GetUserConversations(userID uuid.UUID, limit, offset int) ([]domain.Conversation, int64, error) {
+	var conversations []domain.Conversation
+	var total int64
+
+	query := r.db.Model(&domain.Conversation{}).
+		Where("(employer_id = ? OR candidate_id = ?) AND last_message_at IS NOT NULL", userID, userID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := r.conversationQuery().
+		Where("(employer_id = ? OR candidate_id = ?) AND last_message_at IS NOT NULL", userID, userID).
+		Order("last_message_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&conversations).Error
+	return conversations, total, err
+}
+
+// GetMessages retrieves a page of a conversation's messages, newest first
+func (r *MessageRepository) GetMessages(conversationID uuid.UUID, limit, offset int) ([]domain.Message, int64, error) {
+	var messages []domain.Message
+	var total int64
+
+	query := r.db.Model(&domain.Message{}).Where("conversation_id = ?", conversationID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := r.db.
+		Preload("Sender").
+		Preload("Attachments").
+		Where("conversation_id = ?", conversationID).
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&messages).Error
+	return messages, total, err
+}
+
+// GetLastMessages retrieves the latest message of each conversation
+func (r *MessageRepository) GetLastMessages(conversationIDs []uuid.UUID) (map[uuid.UUID]*domain.Message, error) {
+	result := make(map[uuid.UUID]*domain.Message, len(conversationIDs))
+	if len(conversationIDs) == 0 {
+		return result, nil
+	}
+
+	var messages []domain.Message
+	err := r.db.
+		Raw(`SELECT DISTINCT ON (conversation_id) * FROM messages
+			WHERE conversation_id IN ? ORDER BY conversation_id, created_at DESC`, conversationIDs).
+		Scan(&messages).Error
+	if err != nil {
+		return nil, err
+	}
+	for i := range messages {
+		result[messages[i].ConversationID] = &messages[i]
+	}
+	return result, nil
+}
+
+// CreateMessage creates a message with its attachments and bumps the conversation's activity
+func (r *MessageRepository) CreateMessage(message *domain.Message) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if message.ID == uuid.Nil {
+			message.ID = uuid.New()
+		}
+		if message.CreatedAt.IsZero() {
+			message.CreatedAt = time.Now()
+		}
+		if err := tx.Omit("Sender", "Attachments").Create(message).Error; err != nil {
+			return err
+		}
+
+		for i := range message.Attachments {
+			message.Attachments[i].ID = uuid.New()
+			message.Attachments[i].MessageID = message.ID
+		}
+		if len(message.Attachments) > 0 {
+			if err := tx.Create(&message.Attachments).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(&domain.Conversation{}).
+			Where("id = ?", message.ConversationID).
+			Update("last_message_at", message.CreatedAt).Error
+	})
+}
+
+// MarkRead marks the messages a user received in a conversation as read
+func (r *MessageRepository) MarkRead(conversationID, readerID uuid.UUID) (int64, error) {
+	result := r.db.Model(&domain.Message{}).
+		Where("conversation_id = ? AND sender_id <> ? AND read_at IS NULL", conversationID, readerID).
+		Update("read_at", time.Now())
+	return result.RowsAffected, result.Error
+}
+
+// CountUnread counts the unread messages a user received across all conversations
+func (r *MessageRepository) CountUnread(userID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.Model(&domain.Message{}).
+		Joins("JOIN conversations ON conversations.id = messages.conversation_id").
+		Where("(conversations.employer_id = ? OR conversations.candidate_id = ?)", userID, userID).
+		Where("messages.sender_id <> ? AND messages.read_at IS NULL", userID).
+		Count(&count).Error
+	return count, err
+}
+
+// CountUnreadByConversation counts the unread messages a user received in each conversation
+func (r *MessageRepository) CountUnreadByConversation(conversationIDs []uuid.UUID, userID uuid.UUID) (map[uuid.UUID]int64, error) {
+	result := make(map[uuid.UUID]int64, len(conversationIDs))
+	if len(conversationIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []struct {
+		ConversationID uuid.UUID
+		Count          int64
+	}
+	err := r.db.Model(&domain.Message{}).
+		Select("conversation_id, COUNT(*) AS count").
+		Where("conversation_id IN ? AND sender_id <> ? AND read_at IS NULL", conversationIDs, userID).
+		Group("conversation_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		result[row.ConversationID] = row.Count
+	}
+	return result, nil
+}
+
+// GetAttachment retrieves an attachment of a message in a conversation
+func (r *MessageRepository) GetAttachment(conversationID, attachmentID uuid.UUID) (*domain.MessageAttachment, error) {
+	var attachment domain.MessageAttachment
+	err := r.db.
+		Joins("JOIN messages ON messages.id = message_attachments.message_id").
+		Where("message_attachments.id = ? AND messages.conversation_id = ?", attachmentID, conversationID).
+		First(&attachment).Error
+	if err != nil {
+		return nil, err
+	}
+	return &attachment, nil
+}
+
+// conversationQuery preloads what is needed to display a conversation
+func (r *MessageRepository) conversationQuery() *gorm.DB {
+	return r.db.
+		Preload("Application").
+		Preload("Application.Job").
+		Preload("Employer").
+		Preload("Candidate")
+}
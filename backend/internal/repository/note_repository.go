@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"job-platform/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// NoteRepository handles employer note database operations
+type NoteRepository struct {
+	db *gorm.DB
+}
+
+// NewNoteRepository creates a new note repository
+func NewNoteRepository(db *gorm.DB) *NoteRepository {
+	return &NoteRepository{db: db}
+}
+
+// GetBySubject retrieves a company's notes on a subject as threads: top-level notes
+// newest first, each with its replies in chronological order
+func (r *NoteRepository) GetBySubject(companyID uuid.UUID, subjectType domain.NoteSubjectType, subjectID uuid.UUID) ([]domain.Note, error) {
+	var notes []domain.Note
+	err := r.db.
+		Preload("Author").
+		Preload("Mentions.User").
+		Preload("Replies", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at ASC")
+		}).
+		Preload("Replies.Author").
+		Preload("Replies.Mentions.User").
+		Where("company_id = ? AND subject_type = ? AND subject_id = ? AND parent_id IS NULL", companyID, subjectType, subjectID).
+		Order("created_at DESC").
+		Find(&notes).Error
+	return notes, err
+}
+
+// GetByID retrieves a note by ID
+func (r *NoteRepository) GetByID(id uuid.UUID) (*domain.Note, error) {
+	var note domain.Note
+	err := r.db.
+		Preload("Author").
+		Preload("Mentions.User").
+		Where("id = ?", id).
+		First(&note).Error
+	if err != nil {
+		return nil, err
+	}
+	return &note, nil
+}
+
+// Create creates a note with its mentions
+func (r *NoteRepository) Create(note *domain.Note, mentionIDs []uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if note.ID == uuid.Nil {
+			note.ID = uuid.New()
+		}
+		if err := tx.Omit("Author", "Mentions", "Replies").Create(note).Error; err != nil {
+			return err
+		}
+		return replaceNoteMentions(tx, note.ID, mentionIDs)
+	})
+}
+
+// Update updates a note's content and replaces its mentions
+func (r *NoteRepository) Update(note *domain.Note, mentionIDs []uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&domain.Note{}).
+			Where("id = ?", note.ID).
+			Updates(map[string]interface{}{
+				"content":   note.Content,
+				"edited_at": note.EditedAt,
+			}).Error
+		if err != nil {
+			return err
+		}
+		return replaceNoteMentions(tx, note.ID, mentionIDs)
+	})
+}
+
+// Delete deletes a note; replies are removed with it
+func (r *NoteRepository) Delete(id uuid.UUID) error {
+	return r.db.Where("id = ?", id).Delete(&domain.Note{}).Error
+}
+
+// replaceNoteMentions replaces the mentions of a note
+func replaceNoteMentions(tx *gorm.DB, noteID uuid.UUID, mentionIDs []uuid.UUID) error {
+	if err := tx.Where("note_id = ?", noteID).Delete(&domain.NoteMention{}).Error; err != nil {
+		return err
+	}
+	if len(mentionIDs) == 0 {
+		return nil
+	}
+	mentions := make([]domain.NoteMention, len(mentionIDs))
+	for i, userID := range mentionIDs {
+		mentions[i] = domain.NoteMention{NoteID: noteID, UserID: userID}
+	}
+	return tx.Create(&mentions).Error
+}
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"job-platform/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// NotificationDigestRepository handles the notifications queued for daily digest emails
+type NotificationDigestRepository struct {
+	db *gorm.DB
+}
+
+// NewNotificationDigestRepository creates a new notification digest repository
+func NewNotificationDigestRepository(db *gorm.DB) *NotificationDigestRepository {
+	return &NotificationDigestRepository{db: db}
+}
+
+// Create queues a notification for the user's next digest
+func (r *NotificationDigestRepository) Create(item *domain.NotificationDigestItem) error {
+	return r.db.Create(item).Error
+}
+
+// GetDueUserIDs retrieves users whose oldest unsent digest item was queued before the given time
+func (r *NotificationDigestRepository) GetDueUserIDs(before time.Time, limit int) ([]uuid.UUID, error) {
+	var userIDs []uuid.UUID
+	err := r.db.Model(&domain.NotificationDigestItem{}).
+		Where("sent_at IS NULL").
+		Group("user_id").
+		Having("MIN(created_at) <= ?", before).
+		Order("MIN(created_at)").
+		Limit(limit).
+		Pluck("user_id", &userIDs).Error
+	return userIDs, err
+}
+
+// GetPending retrieves the unsent digest items of a user queued up to the given time, newest first
+func (r *NotificationDigestRepository) GetPending(userID uuid.UUID, until time.Time) ([]domain.NotificationDigestItem, error) {
+	var items []domain.NotificationDigestItem
+	err := r.db.Where("user_id = ? AND sent_at IS NULL AND created_at <= ?", userID, until).
+		Order("created_at DESC").
+		Find(&items).Error
+	return items, err
+}
+
+// MarkSent marks the unsent digest items of a user queued up to sentAt as sent
+func (r *NotificationDigestRepository) MarkSent(userID uuid.UUID, sentAt time.Time) error {
+	return r.db.Model(&domain.NotificationDigestItem{}).
+		Where("user_id = ? AND sent_at IS NULL AND created_at <= ?", userID, sentAt).
+		UpdateColumn("sent_at", sentAt).Error
+}
+
+// DeleteSentBefore removes digest items sent before the given time
+func (r *NotificationDigestRepository) DeleteSentBefore(before time.Time) (int64, error) {
+	result := r.db.Where("sent_at IS NOT NULL AND sent_at < ?", before).Delete(&domain.NotificationDigestItem{})
+	return result.RowsAffected, result.Error
+}
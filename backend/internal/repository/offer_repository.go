@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"job-platform/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// OfferRepository handles offer database operations
+type OfferRepository struct {
+	db *gorm.DB
+}
+
+// NewOfferRepository creates a new offer repository
+func NewOfferRepository(db *gorm.DB) *OfferRepository {
+	return &OfferRepository{db: db}
+}
+
+// Create creates an offer
+func (r *OfferRepository) Create(offer *domain.Offer) error {
+	return r.db.Omit("Application", "Creator").Create(offer).Error
+}
+
+// Update updates an offer's own columns
+func (r *OfferRepository) Update(offer *domain.Offer) error {
+	return r.db.Omit("Application", "Creator").Save(offer).Error
+}
+
+// GetByID retrieves an offer with its application
+func (r *OfferRepository) GetByID(id uuid.UUID) (*domain.Offer, error) {
+	var offer domain.Offer
+	err := r.withDetails(r.db).
+		Where("id = ?", id).
+		First(&offer).Error
+	if err != nil {
+		return nil, err
+	}
+	return &offer, nil
+}
+
+// GetByApplicationID retrieves all offers of an application, newest first
+func (r *OfferRepository) GetByApplicationID(applicationID uuid.UUID) ([]domain.Offer, error) {
+	var offers []domain.Offer
+	err := r.withDetails(r.db).
+		Where("application_id = ?", applicationID).
+		Order("created_at DESC").
+		Find(&offers).Error
+	return offers, err
+}
+
+// GetCandidateOffers retrieves the offers sent to a candidate, newest first. Drafts are not included.
+func (r *OfferRepository) GetCandidateOffers(candidateID uuid.UUID) ([]domain.Offer, error) {
+	var offers []domain.Offer
+	err := r.withDetails(r.db).
+		Joins("JOIN applications ON applications.id = offers.application_id").
+		Where("applications.applicant_id = ? AND offers.status <> ?", candidateID, domain.OfferStatusDraft).
+		Order("offers.created_at DESC").
+		Find(&offers).Error
+	return offers, err
+}
+
+// HasOpenOffer checks if an application has a draft or sent offer
+func (r *OfferRepository) HasOpenOffer(applicationID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.Model(&domain.Offer{}).
+		Where("application_id = ? AND status IN ?", applicationID, []domain.OfferStatus{domain.OfferStatusDraft, domain.OfferStatusSent}).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// WithdrawOpen withdraws the draft and sent offers of an application
+func (r *OfferRepository) WithdrawOpen(applicationID uuid.UUID) error {
+	return r.db.Model(&domain.Offer{}).
+		Where("application_id = ? AND status IN ?", applicationID, []domain.OfferStatus{domain.OfferStatusDraft, domain.OfferStatusSent}).
+		Update("status", domain.OfferStatusWithdrawn).Error
+}
+
+// withDetails preloads the relationships needed to render and notify about an offer
+func (r *OfferRepository) withDetails(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Application").
+		Preload("Application.Job").
+		Preload("Application.Applicant").
+		Preload("Creator")
+}
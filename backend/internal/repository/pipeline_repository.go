@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"job-platform/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// PipelineRepository handles persistence of company hiring pipeline stages
+type PipelineRepository struct {
+	db *gorm.DB
+}
+
+// NewPipelineRepository creates a new pipeline repository
+func NewPipelineRepository(db *gorm.DB) *PipelineRepository {
+	return &PipelineRepository{db: db}
+}
+
+// GetCompanyStages retrieves the stages of a company ordered by position
+func (r *PipelineRepository) GetCompanyStages(companyID uuid.UUID) ([]domain.PipelineStage, error) {
+	var stages []domain.PipelineStage
+	err := r.db.
+		Where("company_id = ?", companyID).
+		Order("position ASC").
+		Find(&stages).Error
+	return stages, err
+}
+
+// ReplaceStages replaces the stages of a company. Stages whose IDs are kept are updated in place
+// so applications stay in them; removed stages are deleted and their applications lose the stage link.
+func (r *PipelineRepository) ReplaceStages(companyID uuid.UUID, stages []domain.PipelineStage) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		keep := make([]uuid.UUID, 0, len(stages))
+		for _, stage := range stages {
+			if stage.ID != uuid.Nil {
+				keep = append(keep, stage.ID)
+			}
+		}
+
+		query := tx.Where("company_id = ?", companyID)
+		if len(keep) > 0 {
+			query = query.Where("id NOT IN ?", keep)
+		}
+		if err := query.Delete(&domain.PipelineStage{}).Error; err != nil {
+			return err
+		}
+
+		for i := range stages {
+			stages[i].CompanyID = companyID
+			if stages[i].ID == uuid.Nil {
+				stages[i].ID = uuid.New()
+				if err := tx.Create(&stages[i]).Error; err != nil {
+					return err
+				}
+				continue
+			}
+			err := tx.Model(&domain.PipelineStage{}).
+				Where("id = ? AND company_id = ?", stages[i].ID, companyID).
+				Updates(map[string]interface{}{
+					"name":     stages[i].Name,
+					"status":   stages[i].Status,
+					"position": stages[i].Position,
+				}).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// DeleteCompanyStages deletes all stages of a company, reverting it to the default pipeline
+func (r *PipelineRepository) DeleteCompanyStages(companyID uuid.UUID) error {
+	return r.db.Where("company_id = ?", companyID).Delete(&domain.PipelineStage{}).Error
+}
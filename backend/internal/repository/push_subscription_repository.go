@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"job-platform/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PushSubscriptionRepository handles push subscription database operations
+type PushSubscriptionRepository struct {
+	db *gorm.DB
+}
+
+// NewPushSubscriptionRepository creates a new push subscription repository
+func NewPushSubscriptionRepository(db *gorm.DB) *PushSubscriptionRepository {
+	return &PushSubscriptionRepository{db: db}
+}
+
+// Upsert registers a subscription. A browser re-subscribing with a known endpoint replaces its
+// keys and owner, e.g. when another user signs in on the same device.
+func (r *PushSubscriptionRepository) Upsert(sub *domain.PushSubscription) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "endpoint"}},
+		DoUpdates: clause.AssignmentColumns([]string{"user_id", "p256dh", "auth", "user_agent", "failure_count", "updated_at"}),
+	}).Create(sub).Error
+}
+
+// GetByEndpoint retrieves a subscription by its push service endpoint
+func (r *PushSubscriptionRepository) GetByEndpoint(endpoint string) (*domain.PushSubscription, error) {
+	var sub domain.PushSubscription
+	if err := r.db.Where("endpoint = ?", endpoint).First(&sub).Error; err != nil {
+		return nil, err
+	}
+	return &sub, nil
+}
+
+// GetByID retrieves a subscription by ID
+func (r *PushSubscriptionRepository) GetByID(id uuid.UUID) (*domain.PushSubscription, error) {
+	var sub domain.PushSubscription
+	if err := r.db.Where("id = ?", id).First(&sub).Error; err != nil {
+		return nil, err
+	}
+	return &sub, nil
+}
+
+// GetByUserID retrieves the subscriptions of a user, most recently registered first
+func (r *PushSubscriptionRepository) GetByUserID(userID uuid.UUID) ([]domain.PushSubscription, error) {
+	var subs []domain.PushSubscription
+	err := r.db.Where("user_id = ?", userID).
+		Order("updated_at DESC").
+		Find(&subs).Error
+	return subs, err
+}
+
+// Delete deletes a subscription
+func (r *PushSubscriptionRepository) Delete(id uuid.UUID) error {
+	return r.db.Where("id = ?", id).Delete(&domain.PushSubscription{}).Error
+}
+
+// DeleteOldestByUserID deletes the least recently registered subscriptions of a user beyond keep
+func (r *PushSubscriptionRepository) DeleteOldestByUserID(userID uuid.UUID, keep int) error {
+	return r.db.Where("user_id = ? AND id NOT IN (?)", userID,
+		r.db.Model(&domain.PushSubscription{}).
+			Select("id").
+			Where("user_id = ?", userID).
+			Order("updated_at DESC").
+			Limit(keep),
+	).Delete(&domain.PushSubscription{}).Error
+}
+
+// RecordSuccess resets the failure count of a subscription after a delivered message
+func (r *PushSubscriptionRepository) RecordSuccess(id uuid.UUID, at time.Time) error {
+	return r.db.Model(&domain.PushSubscription{}).
+		Where("id = ?", id).
+		UpdateColumns(map[string]interface{}{
+			"failure_count":   0,
+			"last_success_at": at,
+		}).Error
+}
+
+// RecordFailure increments the failure count of a subscription and returns the new count
+func (r *PushSubscriptionRepository) RecordFailure(id uuid.UUID) (int, error) {
+	var sub domain.PushSubscription
+	err := r.db.Model(&sub).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "failure_count"}}}).
+		Where("id = ?", id).
+		UpdateColumn("failure_count", gorm.Expr("failure_count + 1")).Error
+	return sub.FailureCount, err
+}
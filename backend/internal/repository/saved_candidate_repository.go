@@ -102,6 +102,30 @@ func (r *SavedCandidateRepository) GetByFolder(employerID uuid.UUID, folder stri
 	return saved, total, err
 }
 
+// GetForExport retrieves a batch of an employer's saved candidates for an export, oldest first.
+// A nil folder selects every folder, an empty folder the candidates without one.
+func (r *SavedCandidateRepository) GetForExport(employerID uuid.UUID, folder *string, limit, offset int) ([]domain.SavedCandidate, error) {
+	var saved []domain.SavedCandidate
+
+	query := r.db.Where("employer_id = ?", employerID)
+	if folder != nil {
+		if *folder != "" {
+			query = query.Where("folder = ?", *folder)
+		} else {
+			query = query.Where("folder IS NULL OR folder = ''")
+		}
+	}
+
+	err := query.Preload("Candidate").
+		Preload("Candidate.Profile").
+		Order("created_at ASC, id ASC").
+		Limit(limit).
+		Offset(offset).
+		Find(&saved).Error
+
+	return saved, err
+}
+
 // Update updates a saved candidate entry
 func (r *SavedCandidateRepository) Update(saved *domain.SavedCandidate) error {
 	return r.db.Save(saved).Error
@@ -0,0 +1,204 @@
+package repository
+
+import (
+	"job-platform/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SavedSearchRepository handles saved search and job alert data access
+type SavedSearchRepository struct {
+	db *gorm.DB
+}
+
+// NewSavedSearchRepository creates a new saved search repository
+func NewSavedSearchRepository(db *gorm.DB) *SavedSearchRepository {
+	return &SavedSearchRepository{db: db}
+}
+
+// Create creates a new saved search
+func (r *SavedSearchRepository) Create(search *domain.SavedSearch) error {
+	return r.db.Create(search).Error
+}
+
+// Update saves all fields of a saved search
+func (r *SavedSearchRepository) Update(search *domain.SavedSearch) error {
+	return r.db.Save(search).Error
+}
+
+// Delete deletes a saved search and its matches
+func (r *SavedSearchRepository) Delete(id uuid.UUID) error {
+	return r.db.Delete(&domain.SavedSearch{}, "id = ?", id).Error
+}
+
+// GetByID retrieves a saved search by ID
+func (r *SavedSearchRepository) GetByID(id uuid.UUID) (*domain.SavedSearch, error) {
+	var search domain.SavedSearch
+	err := r.db.Where("id = ?", id).First(&search).Error
+	if err != nil {
+		return nil, err
+	}
+	return &search, nil
+}
+
+// GetByToken retrieves a saved search by its unsubscribe token
+func (r *SavedSearchRepository) GetByToken(token string) (*domain.SavedSearch, error) {
+	var search domain.SavedSearch
+	err := r.db.Where("unsubscribe_token = ?", token).First(&search).Error
+	if err != nil {
+		return nil, err
+	}
+	return &search, nil
+}
+
+// GetByUserID retrieves the saved searches of a user, newest first
+func (r *SavedSearchRepository) GetByUserID(userID uuid.UUID) ([]domain.SavedSearch, error) {
+	var searches []domain.SavedSearch
+	err := r.db.Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&searches).Error
+	return searches, err
+}
+
+// CountByUserID counts the saved searches of a user
+func (r *SavedSearchRepository) CountByUserID(userID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.Model(&domain.SavedSearch{}).
+		Where("user_id = ?", userID).
+		Count(&count).Error
+	return count, err
+}
+
+// GetActive retrieves a batch of active saved searches ordered by ID, starting after afterID
+func (r *SavedSearchRepository) GetActive(afterID uuid.UUID, limit int) ([]domain.SavedSearch, error) {
+	var searches []domain.SavedSearch
+	err := r.db.Where("is_active = ? AND id > ?", true, afterID).
+		Order("id").
+		Limit(limit).
+		Find(&searches).Error
+	return searches, err
+}
+
+// GetWithPendingMatches retrieves active saved searches that have matches not sent in an alert yet
+func (r *SavedSearchRepository) GetWithPendingMatches() ([]domain.SavedSearch, error) {
+	var searches []domain.SavedSearch
+	err := r.db.Preload("User").
+		Where("is_active = ?", true).
+		Where("EXISTS (SELECT 1 FROM saved_search_matches m WHERE m.saved_search_id = saved_searches.id AND m.notified_at IS NULL)").
+		Order("created_at").
+		Find(&searches).Error
+	return searches, err
+}
+
+// LockForAlert runs fn in a transaction holding a lock on an active saved search, so only one
+// instance sends its alert. fn gets the search as currently stored and a repository bound to the
+// transaction. Returns false without running fn when the search is locked by another instance or
+// no longer active.
+func (r *SavedSearchRepository) LockForAlert(id uuid.UUID, fn func(repo *SavedSearchRepository, search *domain.SavedSearch) error) (bool, error) {
+	locked := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var searches []domain.SavedSearch
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("id = ? AND is_active = ?", id, true).
+			Find(&searches).Error; err != nil {
+			return err
+		}
+		if len(searches) == 0 {
+			return nil
+		}
+		locked = true
+		return fn(NewSavedSearchRepository(tx), &searches[0])
+	})
+	return locked, err
+}
+
+// AddMatches records jobs found for a saved search and moves its match watermark to matchedAt.
+// Jobs matched before are ignored. Returns the number of new matches.
+func (r *SavedSearchRepository) AddMatches(searchID uuid.UUID, jobIDs []uuid.UUID, matchedAt time.Time) (int, error) {
+	added := 0
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if len(jobIDs) > 0 {
+			matches := make([]domain.SavedSearchMatch, len(jobIDs))
+			for i, jobID := range jobIDs {
+				matches[i] = domain.SavedSearchMatch{
+					SavedSearchID: searchID,
+					JobID:         jobID,
+					MatchedAt:     matchedAt,
+				}
+			}
+			result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&matches)
+			if result.Error != nil {
+				return result.Error
+			}
+			added = int(result.RowsAffected)
+		}
+
+		return tx.Model(&domain.SavedSearch{}).
+			Where("id = ?", searchID).
+			UpdateColumn("last_matched_at", matchedAt).Error
+	})
+	return added, err
+}
+
+// GetPendingMatches retrieves the matches of a saved search not sent in an alert yet, with jobs that are still active
+func (r *SavedSearchRepository) GetPendingMatches(searchID uuid.UUID, limit int) ([]domain.SavedSearchMatch, int64, error) {
+	var matches []domain.SavedSearchMatch
+	var total int64
+
+	query := r.db.Model(&domain.SavedSearchMatch{}).
+		Joins("JOIN jobs ON jobs.id = saved_search_matches.job_id").
+		Where("saved_search_matches.saved_search_id = ? AND saved_search_matches.notified_at IS NULL", searchID).
+		Where("jobs.status = ? AND jobs.deleted_at IS NULL", domain.JobStatusActive)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Preload("Job").
+		Order("jobs.published_at DESC").
+		Limit(limit).
+		Find(&matches).Error
+	return matches, total, err
+}
+
+// MarkNotified marks the pending matches of a saved search found up to notifiedAt as sent, including matches
+// of jobs that were closed before the alert, and records when the alert was sent
+func (r *SavedSearchRepository) MarkNotified(searchID uuid.UUID, notifiedAt time.Time) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&domain.SavedSearchMatch{}).
+			Where("saved_search_id = ? AND notified_at IS NULL AND matched_at <= ?", searchID, notifiedAt).
+			UpdateColumn("notified_at", notifiedAt).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&domain.SavedSearch{}).
+			Where("id = ?", searchID).
+			UpdateColumn("last_notified_at", notifiedAt).Error
+	})
+}
+
+// GetMatches retrieves the jobs matched for a saved search, newest first
+func (r *SavedSearchRepository) GetMatches(searchID uuid.UUID, limit, offset int) ([]domain.SavedSearchMatch, int64, error) {
+	var matches []domain.SavedSearchMatch
+	var total int64
+
+	query := r.db.Model(&domain.SavedSearchMatch{}).
+		Joins("JOIN jobs ON jobs.id = saved_search_matches.job_id").
+		Where("saved_search_matches.saved_search_id = ? AND jobs.deleted_at IS NULL", searchID)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Preload("Job").
+		Order("saved_search_matches.matched_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&matches).Error
+	return matches, total, err
+}
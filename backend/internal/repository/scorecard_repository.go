@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"job-platform/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ScorecardRepository handles scorecard criteria and scorecard database operations
+type ScorecardRepository struct {
+	db *gorm.DB
+}
+
+// NewScorecardRepository creates a new scorecard repository
+func NewScorecardRepository(db *gorm.DB) *ScorecardRepository {
+	return &ScorecardRepository{db: db}
+}
+
+// GetJobCriteria retrieves the scorecard criteria of a job ordered by position
+func (r *ScorecardRepository) GetJobCriteria(jobID uuid.UUID) ([]domain.ScorecardCriterion, error) {
+	var criteria []domain.ScorecardCriterion
+	err := r.db.
+		Where("job_id = ?", jobID).
+		Order("position ASC").
+		Find(&criteria).Error
+	return criteria, err
+}
+
+// ReplaceJobCriteria replaces the scorecard criteria of a job. Criteria whose IDs are kept are
+// updated in place so existing ratings stay linked; removed criteria are deleted.
+func (r *ScorecardRepository) ReplaceJobCriteria(jobID uuid.UUID, criteria []domain.ScorecardCriterion) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		keep := make([]uuid.UUID, 0, len(criteria))
+		for _, c := range criteria {
+			if c.ID != uuid.Nil {
+				keep = append(keep, c.ID)
+			}
+		}
+
+		query := tx.Where("job_id = ?", jobID)
+		if len(keep) > 0 {
+			query = query.Where("id NOT IN ?", keep)
+		}
+		if err := query.Delete(&domain.ScorecardCriterion{}).Error; err != nil {
+			return err
+		}
+
+		for i := range criteria {
+			criteria[i].JobID = jobID
+			if criteria[i].ID == uuid.Nil {
+				criteria[i].ID = uuid.New()
+				if err := tx.Create(&criteria[i]).Error; err != nil {
+					return err
+				}
+				continue
+			}
+			err := tx.Model(&domain.ScorecardCriterion{}).
+				Where("id = ? AND job_id = ?", criteria[i].ID, jobID).
+				Updates(map[string]interface{}{
+					"name":        criteria[i].Name,
+					"description": criteria[i].Description,
+					"position":    criteria[i].Position,
+				}).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GetApplicationScorecards retrieves all scorecards of an application
+func (r *ScorecardRepository) GetApplicationScorecards(applicationID uuid.UUID) ([]domain.Scorecard, error) {
+	var scorecards []domain.Scorecard
+	err := r.db.
+		Preload("Ratings").
+		Preload("Reviewer").
+		Where("application_id = ?", applicationID).
+		Order("submitted_at ASC NULLS LAST, created_at ASC").
+		Find(&scorecards).Error
+	return scorecards, err
+}
+
+// GetByApplicationAndReviewer retrieves a reviewer's scorecard for an application
+func (r *ScorecardRepository) GetByApplicationAndReviewer(applicationID, reviewerID uuid.UUID) (*domain.Scorecard, error) {
+	var scorecard domain.Scorecard
+	err := r.db.
+		Preload("Ratings").
+		Preload("Reviewer").
+		Where("application_id = ? AND reviewer_id = ?", applicationID, reviewerID).
+		First(&scorecard).Error
+	if err != nil {
+		return nil, err
+	}
+	return &scorecard, nil
+}
+
+// Save creates or updates a scorecard and replaces its ratings
+func (r *ScorecardRepository) Save(scorecard *domain.Scorecard) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		ratings := scorecard.Ratings
+		if scorecard.ID == uuid.Nil {
+			scorecard.ID = uuid.New()
+			if err := tx.Omit("Ratings", "Reviewer").Create(scorecard).Error; err != nil {
+				return err
+			}
+		} else if err := tx.Omit("Ratings", "Reviewer").Save(scorecard).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("scorecard_id = ?", scorecard.ID).Delete(&domain.ScorecardRating{}).Error; err != nil {
+			return err
+		}
+		for i := range ratings {
+			ratings[i].ID = uuid.New()
+			ratings[i].ScorecardID = scorecard.ID
+		}
+		if len(ratings) > 0 {
+			if err := tx.Create(&ratings).Error; err != nil {
+				return err
+			}
+		}
+		scorecard.Ratings = ratings
+		return nil
+	})
+}
+
+// IsApplicationInterviewer checks if a user is an interviewer on any interview of an application
+func (r *ScorecardRepository) IsApplicationInterviewer(applicationID, userID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.Model(&domain.InterviewInterviewer{}).
+		Joins("JOIN interviews ON interviews.id = interview_interviewers.interview_id").
+		Where("interviews.application_id = ? AND interview_interviewers.user_id = ?", applicationID, userID).
+		Count(&count).Error
+	return count > 0, err
+}
@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"job-platform/internal/domain"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// SitemapEntry is a page listed in a sitemap
+type SitemapEntry struct {
+	Slug      string
+	UpdatedAt time.Time
+}
+
+// SitemapPage summarises one sitemap file of a section
+type SitemapPage struct {
+	Page    int
+	LastMod time.Time
+}
+
+// SitemapRepository reads the public jobs, companies and blogs listed in sitemaps.
+// Records are ordered by creation, so existing URLs stay in the same sitemap file as new ones are added.
+type SitemapRepository struct {
+	db *gorm.DB
+}
+
+// NewSitemapRepository creates a new sitemap repository
+func NewSitemapRepository(db *gorm.DB) *SitemapRepository {
+	return &SitemapRepository{db: db}
+}
+
+// GetJobPages splits active jobs into sitemap files of pageSize URLs
+func (r *SitemapRepository) GetJobPages(pageSize int) ([]SitemapPage, error) {
+	return r.getPages(r.jobs(), pageSize)
+}
+
+// GetJobEntries retrieves the active jobs of a sitemap file (pages start at 1)
+func (r *SitemapRepository) GetJobEntries(page, pageSize int) ([]SitemapEntry, error) {
+	return r.getEntries(r.jobs(), page, pageSize)
+}
+
+// GetCompanyPages splits public companies into sitemap files of pageSize URLs
+func (r *SitemapRepository) GetCompanyPages(pageSize int) ([]SitemapPage, error) {
+	return r.getPages(r.companies(), pageSize)
+}
+
+// GetCompanyEntries retrieves the public companies of a sitemap file (pages start at 1)
+func (r *SitemapRepository) GetCompanyEntries(page, pageSize int) ([]SitemapEntry, error) {
+	return r.getEntries(r.companies(), page, pageSize)
+}
+
+// GetBlogPages splits published blog posts into sitemap files of pageSize URLs
+func (r *SitemapRepository) GetBlogPages(pageSize int) ([]SitemapPage, error) {
+	return r.getPages(r.blogs(), pageSize)
+}
+
+// GetBlogEntries retrieves the published blog posts of a sitemap file (pages start at 1)
+func (r *SitemapRepository) GetBlogEntries(page, pageSize int) ([]SitemapEntry, error) {
+	return r.getEntries(r.blogs(), page, pageSize)
+}
+
+// GetCategoryEntries retrieves the active job categories, listed with the static pages
+func (r *SitemapRepository) GetCategoryEntries() ([]SitemapEntry, error) {
+	var entries []SitemapEntry
+	err := r.db.Table("job_categories").
+		Select("slug, updated_at").
+		Where("is_active = ?", true).
+		Order("sort_order, name").
+		Scan(&entries).Error
+	return entries, err
+}
+
+// jobs selects the jobs listed in sitemaps
+func (r *SitemapRepository) jobs() *gorm.DB {
+	return r.db.Table("jobs").
+		Where("status = ? AND deleted_at IS NULL", domain.JobStatusActive)
+}
+
+// companies selects the companies listed in sitemaps
+func (r *SitemapRepository) companies() *gorm.DB {
+	return r.db.Table("companies").
+		Where("status IN ? AND deleted_at IS NULL", []domain.CompanyStatus{domain.CompanyStatusActive, domain.CompanyStatusVerified})
+}
+
+// blogs selects the blog posts listed in sitemaps
+func (r *SitemapRepository) blogs() *gorm.DB {
+	return r.db.Table("blogs").
+		Where("status = ?", domain.BlogStatusPublished)
+}
+
+// getPages numbers the records of a section and returns the newest update of each sitemap file
+func (r *SitemapRepository) getPages(source *gorm.DB, pageSize int) ([]SitemapPage, error) {
+	numbered := source.Select("(ROW_NUMBER() OVER (ORDER BY created_at, id) - 1) / ? + 1 AS page, updated_at", pageSize)
+
+	var pages []SitemapPage
+	err := r.db.Table("(?) AS numbered", numbered).
+		Select("page, MAX(updated_at) AS last_mod").
+		Group("page").
+		Order("page").
+		Scan(&pages).Error
+	return pages, err
+}
+
+// getEntries retrieves the records of one sitemap file
+func (r *SitemapRepository) getEntries(source *gorm.DB, page, pageSize int) ([]SitemapEntry, error) {
+	var entries []SitemapEntry
+	err := source.
+		Select("slug, updated_at").
+		Order("created_at, id").
+		Limit(pageSize).
+		Offset((page - 1) * pageSize).
+		Scan(&entries).Error
+	return entries, err
+}
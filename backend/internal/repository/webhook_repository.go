@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"job-platform/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// WebhookRepository handles webhook and webhook delivery database operations
+type WebhookRepository struct {
+	db *gorm.DB
+}
+
+// NewWebhookRepository creates a new webhook repository
+func NewWebhookRepository(db *gorm.DB) *WebhookRepository {
+	return &WebhookRepository{db: db}
+}
+
+// Create creates a new webhook
+func (r *WebhookRepository) Create(webhook *domain.Webhook) error {
+	return r.db.Create(webhook).Error
+}
+
+// Update updates a webhook
+func (r *WebhookRepository) Update(webhook *domain.Webhook) error {
+	return r.db.Save(webhook).Error
+}
+
+// Delete deletes a webhook and its deliveries
+func (r *WebhookRepository) Delete(id uuid.UUID) error {
+	return r.db.Where("id = ?", id).Delete(&domain.Webhook{}).Error
+}
+
+// GetByID retrieves a webhook by ID
+func (r *WebhookRepository) GetByID(id uuid.UUID) (*domain.Webhook, error) {
+	var webhook domain.Webhook
+	if err := r.db.Where("id = ?", id).First(&webhook).Error; err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+// GetByCompanyID retrieves the webhooks of a company, oldest first
+func (r *WebhookRepository) GetByCompanyID(companyID uuid.UUID) ([]domain.Webhook, error) {
+	var webhooks []domain.Webhook
+	err := r.db.Where("company_id = ?", companyID).
+		Order("created_at ASC").
+		Find(&webhooks).Error
+	return webhooks, err
+}
+
+// CountByCompanyID counts the webhooks of a company
+func (r *WebhookRepository) CountByCompanyID(companyID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.Model(&domain.Webhook{}).Where("company_id = ?", companyID).Count(&count).Error
+	return count, err
+}
+
+// GetActiveByEvent retrieves the active webhooks of a company subscribed to an event
+func (r *WebhookRepository) GetActiveByEvent(companyID uuid.UUID, event domain.WebhookEvent) ([]domain.Webhook, error) {
+	var webhooks []domain.Webhook
+	err := r.db.Where("company_id = ? AND is_active = ? AND ? = ANY(events)", companyID, true, string(event)).
+		Find(&webhooks).Error
+	return webhooks, err
+}
+
+// CreateDelivery records a new delivery
+func (r *WebhookRepository) CreateDelivery(delivery *domain.WebhookDelivery) error {
+	return r.db.Create(delivery).Error
+}
+
+// UpdateDelivery saves the outcome of a delivery attempt
+func (r *WebhookRepository) UpdateDelivery(delivery *domain.WebhookDelivery) error {
+	return r.db.Save(delivery).Error
+}
+
+// GetDeliveryByID retrieves a delivery by ID
+func (r *WebhookRepository) GetDeliveryByID(id uuid.UUID) (*domain.WebhookDelivery, error) {
+	var delivery domain.WebhookDelivery
+	if err := r.db.Where("id = ?", id).First(&delivery).Error; err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+// GetDeliveries retrieves the deliveries of a webhook, newest first, optionally filtered by status
+func (r *WebhookRepository) GetDeliveries(webhookID uuid.UUID, status *domain.WebhookDeliveryStatus, limit, offset int) ([]domain.WebhookDelivery, int64, error) {
+	var deliveries []domain.WebhookDelivery
+	var total int64
+
+	query := r.db.Model(&domain.WebhookDelivery{}).Where("webhook_id = ?", webhookID)
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&deliveries).Error
+	return deliveries, total, err
+}
+
+// GetDueDeliveryIDs retrieves pending deliveries whose next attempt is due, oldest first
+func (r *WebhookRepository) GetDueDeliveryIDs(now time.Time, limit int) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := r.db.Model(&domain.WebhookDelivery{}).
+		Where("status = ? AND next_attempt_at <= ?", domain.WebhookDeliveryPending, now).
+		Order("next_attempt_at ASC").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+// ClaimDelivery reserves a due delivery for an attempt by moving its next attempt to leaseUntil.
+// Returns false when the delivery is no longer due, e.g. another instance claimed it first.
+func (r *WebhookRepository) ClaimDelivery(id uuid.UUID, now, leaseUntil time.Time) (bool, error) {
+	result := r.db.Model(&domain.WebhookDelivery{}).
+		Where("id = ? AND status = ? AND next_attempt_at <= ?", id, domain.WebhookDeliveryPending, now).
+		UpdateColumn("next_attempt_at", leaseUntil)
+	return result.RowsAffected > 0, result.Error
+}
+
+// DeleteDeliveriesBefore removes deliveries created before the given time
+func (r *WebhookRepository) DeleteDeliveriesBefore(before time.Time) (int64, error) {
+	result := r.db.Where("created_at < ? AND status <> ?", before, domain.WebhookDeliveryPending).
+		Delete(&domain.WebhookDelivery{})
+	return result.RowsAffected, result.Error
+}
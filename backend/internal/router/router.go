@@ -3,14 +3,19 @@ package router
 import (
 	"job-platform/internal/cache"
 	"job-platform/internal/config"
+	"job-platform/internal/domain"
+	"job-platform/internal/cron"
 	"job-platform/internal/handler"
 	handlerMiddleware "job-platform/internal/handler/middleware"
 	"job-platform/internal/middleware"
+	"job-platform/internal/realtime"
 	"job-platform/internal/repository"
 	"job-platform/internal/search"
 	"job-platform/internal/service"
 	"job-platform/internal/storage"
 	"job-platform/internal/util/email"
+	"job-platform/internal/util/webpush"
+	"log"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -18,8 +23,65 @@ import (
 	"gorm.io/gorm"
 )
 
-func SetupRouter(cfg *config.Config, db *gorm.DB, redis *redis.Client, minioClient *storage.MinioClient, meiliClient *search.MeiliClient, cacheService *cache.CacheService) *gin.Engine {
-	r := gin.Default()
+// NewEmailSender creates the email sender of the configured provider
+func NewEmailSender(cfg *config.Config) email.EmailSender {
+	if cfg.EmailProvider == "RESEND" {
+		return email.NewResendService(&email.ResendConfig{
+			APIKey:    cfg.ResendAPIKey,
+			FromEmail: cfg.ResendFromEmail,
+			FromName:  cfg.ResendFromName,
+		})
+	}
+
+	// Default to SMTP
+	return email.NewEmailService(&email.EmailConfig{
+		SMTPHost:     cfg.SMTPHost,
+		SMTPPort:     cfg.SMTPPort,
+		SMTPUser:     cfg.SMTPUser,
+		SMTPPassword: cfg.SMTPPassword,
+		FromEmail:    cfg.EmailFrom,
+		FromName:     "Job Platform",
+	})
+}
+
+// NewPushService creates the web push service. Push notifications are disabled when no VAPID keys are configured.
+func NewPushService(cfg *config.Config, db *gorm.DB) *service.PushService {
+	pushConfig := &service.PushConfig{
+		FrontendURL:            cfg.FrontendURL,
+		AllowInsecureEndpoints: cfg.AppEnv != "production",
+	}
+
+	var client *webpush.Client
+	if cfg.VAPIDPrivateKey != "" {
+		var err error
+		client, err = webpush.NewClient(webpush.Config{
+			PublicKey:  cfg.VAPIDPublicKey,
+			PrivateKey: cfg.VAPIDPrivateKey,
+			Subject:    cfg.VAPIDSubject,
+		}, service.NewPushHTTPClient(pushConfig))
+		if err != nil {
+			log.Printf("⚠️  Web push disabled: %v", err)
+		}
+	}
+
+	return service.NewPushService(repository.NewPushSubscriptionRepository(db), client, pushConfig)
+}
+
+// NewWebhookService creates the service that delivers company webhook events
+func NewWebhookService(cfg *config.Config, db *gorm.DB) *service.WebhookService {
+	return service.NewWebhookService(repository.NewWebhookRepository(db), &service.WebhookConfig{
+		FrontendURL:       cfg.FrontendURL,
+		AllowInsecureURLs: cfg.AppEnv != "production",
+	})
+}
+
+// SetupRouter sets up the routes. The job service and the background workers are shared with main,
+// which starts and stops the workers.
+func SetupRouter(cfg *config.Config, db *gorm.DB, redis *redis.Client, minioClient *storage.MinioClient, meiliClient *search.MeiliClient, cacheService *cache.CacheService, jobCronScheduler *cron.JobCronScheduler, realtimeHub *realtime.Hub, jobService *service.JobService, importQueueService *service.ImportQueueService, jobUploadService *service.JobUploadService) *gin.Engine {
+	r := gin.New()
+
+	// Logger skips the notification stream: its access token is passed in the query string
+	r.Use(gin.LoggerWithConfig(gin.LoggerConfig{SkipPaths: []string{"/api/v1/me/notifications/stream"}}), gin.Recovery())
 
 	// Middleware
 	r.Use(middleware.CORS())
@@ -57,9 +119,16 @@ func SetupRouter(cfg *config.Config, db *gorm.DB, redis *redis.Client, minioClie
 	jobRepo := repository.NewJobRepository(db)
 	applicationRepo := repository.NewApplicationRepository(db)
 	applicationStatusHistoryRepo := repository.NewApplicationStatusHistoryRepository(db)
+	pipelineRepo := repository.NewPipelineRepository(db)
+	interviewRepo := repository.NewInterviewRepository(db)
+	scorecardRepo := repository.NewScorecardRepository(db)
+	noteRepo := repository.NewNoteRepository(db)
+	messageRepo := repository.NewMessageRepository(db)
+	offerRepo := repository.NewOfferRepository(db)
 	savedJobRepo := repository.NewSavedJobRepository(db)
 	jobCategoryRepo := repository.NewJobCategoryRepository(db)
 	jobViewRepo := repository.NewJobViewRepository(db)
+	sitemapRepo := repository.NewSitemapRepository(db)
 
 	// Notification repositories
 	notificationRepo := repository.NewNotificationRepository(db)
@@ -73,25 +142,7 @@ func SetupRouter(cfg *config.Config, db *gorm.DB, redis *redis.Client, minioClie
 	jwtAdminExpiry, _ := config.ParseDuration(cfg.JWTAdminExpiry)
 
 	// Initialize email service based on provider
-	var emailService email.EmailSender
-
-	if cfg.EmailProvider == "RESEND" {
-		emailService = email.NewResendService(&email.ResendConfig{
-			APIKey:    cfg.ResendAPIKey,
-			FromEmail: cfg.ResendFromEmail,
-			FromName:  cfg.ResendFromName,
-		})
-	} else {
-		// Default to SMTP
-		emailService = email.NewEmailService(&email.EmailConfig{
-			SMTPHost:     cfg.SMTPHost,
-			SMTPPort:     cfg.SMTPPort,
-			SMTPUser:     cfg.SMTPUser,
-			SMTPPassword: cfg.SMTPPassword,
-			FromEmail:    cfg.EmailFrom,
-			FromName:     "Job Platform",
-		})
-	}
+	emailService := NewEmailSender(cfg)
 
 	// Initialize services
 	tokenService := service.NewTokenService(
@@ -149,22 +200,11 @@ func SetupRouter(cfg *config.Config, db *gorm.DB, redis *redis.Client, minioClie
 		cfg.GoogleRedirectURL,
 	)
 
-	// Job management services
-	jobService := service.NewJobService(
-		jobRepo,
-		jobCategoryRepo,
-		applicationRepo,
-		jobViewRepo,
-		userRepo,
-		db,
-		&service.JobConfig{
-			ExpiryDays:             30,
-			ExpiryWarningDays:      7,
-			MaxFreeJobsPerEmployer: 5,
-			ModerationEnabled:      false,
-			DefaultExpiryDays:      30,
-		},
-	)
+	// Duplicate job detection (the shared job service flags duplicates on save)
+	jobDuplicateService := service.NewJobDuplicateService(repository.NewJobDuplicateRepository(db), jobRepo, db)
+
+	// Outbound company webhooks
+	webhookService := NewWebhookService(cfg, db)
 
 	applicationService := service.NewApplicationService(
 		applicationRepo,
@@ -174,7 +214,51 @@ func SetupRouter(cfg *config.Config, db *gorm.DB, redis *redis.Client, minioClie
 		db,
 	)
 
+	// Hiring pipeline service
+	pipelineService := service.NewPipelineService(pipelineRepo, companyRepo)
+	applicationService.SetPipelineService(pipelineService)
+	applicationService.SetRealtimeHub(realtimeHub)
+	applicationService.SetWebhookService(webhookService)
+	applicationService.SetJobService(jobService)
+
+	// Interview scheduling service (calendar invites are sent from the configured sender address)
+	interviewOrganizerEmail := cfg.EmailFrom
+	if cfg.EmailProvider == "RESEND" {
+		interviewOrganizerEmail = cfg.ResendFromEmail
+	}
+	interviewService := service.NewInterviewService(
+		interviewRepo,
+		applicationRepo,
+		teamRepo,
+		companyRepo,
+		applicationService,
+		emailService,
+		db,
+		&service.InterviewConfig{
+			CompanyName:    "Job Platform",
+			SupportEmail:   cfg.EmailFrom,
+			FrontendURL:    cfg.FrontendURL,
+			OrganizerEmail: interviewOrganizerEmail,
+		},
+	)
+	scorecardService := service.NewScorecardService(scorecardRepo, applicationRepo, jobRepo, teamRepo, companyRepo)
+	noteService := service.NewNoteService(noteRepo, applicationRepo, userRepo, teamRepo, companyRepo)
+	offerService := service.NewOfferService(offerRepo, applicationRepo, applicationService, minioClient, cfg.MinioBucketOffers, db)
+	messageService := service.NewMessageService(messageRepo, applicationRepo, minioClient, emailService,
+		&service.MessageConfig{
+			CompanyName:  "Job Platform",
+			SupportEmail: cfg.EmailFrom,
+			FrontendURL:  cfg.FrontendURL,
+			Bucket:       cfg.MinioBucketMessages,
+		},
+	)
+
 	savedJobService := service.NewSavedJobService(savedJobRepo, jobRepo)
+	savedSearchService := service.NewSavedSearchService(repository.NewSavedSearchRepository(db), jobRepo, emailService, &service.SavedSearchConfig{
+		CompanyName:  "Job Platform",
+		SupportEmail: cfg.EmailFrom,
+		FrontendURL:  cfg.FrontendURL,
+	})
 	jobCategoryService := service.NewJobCategoryService(jobCategoryRepo)
 
 	// Profile management services
@@ -195,16 +279,26 @@ func SetupRouter(cfg *config.Config, db *gorm.DB, redis *redis.Client, minioClie
 	// Company management services
 	companyService := service.NewCompanyService(companyRepo, teamRepo, locationRepo, benefitRepo, mediaRepo, reviewRepo, followerRepo, minioClient)
 	teamService := service.NewTeamService(teamRepo, companyRepo)
+	apiKeyService := service.NewAPIKeyService(repository.NewAPIKeyRepository(db), teamRepo, userRepo)
 	invitationService := service.NewInvitationService(invitationRepo, teamRepo, companyRepo)
 	locationService := service.NewLocationService(locationRepo, companyRepo)
 	benefitService := service.NewBenefitService(benefitRepo, companyRepo)
 	mediaService := service.NewMediaService(mediaRepo, companyRepo, minioClient)
 	reviewService := service.NewReviewService(reviewRepo, companyRepo, teamRepo)
+	reviewService.SetWebhookService(webhookService)
 	followerService := service.NewFollowerService(followerRepo, companyRepo)
 	analyticsService := service.NewAnalyticsService(companyRepo, reviewRepo, followerRepo, teamRepo, locationRepo)
 
 	// Notification service
 	notificationService := service.NewNotificationService(notificationRepo, notificationPrefsRepo)
+	pushService := NewPushService(cfg, db)
+	notificationService.SetPushService(pushService)
+	notificationService.SetRealtimeHub(realtimeHub)
+	notificationService.SetEmailService(emailService, userRepo, repository.NewNotificationDigestRepository(db), &service.NotificationEmailConfig{
+		CompanyName:  "Job Platform",
+		SupportEmail: cfg.EmailFrom,
+		FrontendURL:  cfg.FrontendURL,
+	})
 
 	// Newsletter service
 	newsletterRepo := repository.NewNewsletterRepository(db)
@@ -222,6 +316,11 @@ func SetupRouter(cfg *config.Config, db *gorm.DB, redis *redis.Client, minioClie
 
 	// Set notification service on application service (to avoid circular dependency)
 	applicationService.SetNotificationService(notificationService)
+	interviewService.SetNotificationService(notificationService)
+	noteService.SetNotificationService(notificationService)
+	messageService.SetNotificationService(notificationService)
+	offerService.SetNotificationService(notificationService)
+	savedSearchService.SetNotificationService(notificationService)
 
 	// Initialize handlers
 	healthHandler := handler.NewHealthHandler(db, redis)
@@ -235,8 +334,22 @@ func SetupRouter(cfg *config.Config, db *gorm.DB, redis *redis.Client, minioClie
 	// Job management handlers
 	jobHandler := handler.NewJobHandler(jobService, jobCategoryService, savedJobService, meiliClient, cacheService)
 	jobSeekerHandler := handler.NewJobSeekerHandler(applicationService, savedJobService, jobService)
-	employerJobHandler := handler.NewEmployerJobHandler(jobService, applicationService, cacheService)
+	savedSearchHandler := handler.NewSavedSearchHandler(savedSearchService)
+	employerJobHandler := handler.NewEmployerJobHandler(jobService, applicationService, scorecardService, cacheService)
 	adminJobHandler := handler.NewAdminJobHandler(jobService, applicationService, jobCategoryService, searchService)
+	adminDuplicateHandler := handler.NewAdminDuplicateHandler(jobDuplicateService, jobService, searchService)
+	structuredDataHandler := handler.NewStructuredDataHandler(service.NewStructuredDataService(jobRepo, companyRepo, cfg.FrontendURL))
+	feedHandler := handler.NewFeedHandler(service.NewFeedService(jobRepo, jobCategoryRepo, companyRepo, &service.FeedConfig{
+		PublisherName: "Job Platform",
+		FrontendURL:   cfg.FrontendURL,
+	}), cacheService, cfg.APIPublicURL+"/feeds")
+	sitemapHandler := handler.NewSitemapHandler(service.NewSitemapService(sitemapRepo, cfg.FrontendURL), cacheService, cfg.APIPublicURL+"/sitemaps")
+	employerPipelineHandler := handler.NewEmployerPipelineHandler(pipelineService)
+	interviewHandler := handler.NewInterviewHandler(interviewService)
+	scorecardHandler := handler.NewScorecardHandler(scorecardService)
+	noteHandler := handler.NewNoteHandler(noteService)
+	messageHandler := handler.NewMessageHandler(messageService)
+	offerHandler := handler.NewOfferHandler(offerService)
 
 	// Profile management handlers
 	profileHandler := handler.NewProfileHandler(profileService, userService, minioClient)
@@ -258,7 +371,11 @@ func SetupRouter(cfg *config.Config, db *gorm.DB, redis *redis.Client, minioClie
 	employerCandidateHandler := handler.NewEmployerCandidateHandler(candidateSearchService, profileService, userService, skillService)
 
 	// Notification handler
-	notificationHandler := handler.NewNotificationHandler(notificationService)
+	notificationHandler := handler.NewNotificationHandler(notificationService, messageService)
+	realtimeHandler := handler.NewRealtimeHandler(realtimeHub, notificationService)
+	pushHandler := handler.NewPushHandler(pushService)
+	employerWebhookHandler := handler.NewEmployerWebhookHandler(webhookService)
+	employerAPIKeyHandler := handler.NewEmployerAPIKeyHandler(apiKeyService)
 
 	// Blog handler
 	blogHandler := handler.NewBlogHandler(blogService, searchService, cacheService)
@@ -273,26 +390,44 @@ func SetupRouter(cfg *config.Config, db *gorm.DB, redis *redis.Client, minioClie
 	// Scraper handler
 	scraperHandler := handler.NewScraperHandler(scraperService, jobService)
 
-	// Import queue service and handler
-	importQueueService := service.NewImportQueueService(scraperService, jobService)
+	// Import queue handler
 	importQueueHandler := handler.NewImportQueueHandler(importQueueService)
 
+	// Job source service and handler (scheduled career-page re-crawls)
+	jobSourceRepo := repository.NewJobSourceRepository(db)
+	jobSourceService := service.NewJobSourceService(jobSourceRepo, jobRepo, companyRepo, scraperService, importQueueService, jobService)
+	adminJobSourceHandler := handler.NewAdminJobSourceHandler(jobSourceService)
+
+	// Bulk job uploads (CSV/XLSX) for employers and admins
+	employerJobUploadHandler := handler.NewJobUploadHandler(jobUploadService, domain.JobUploadModeEmployer)
+	adminJobUploadHandler := handler.NewJobUploadHandler(jobUploadService, domain.JobUploadModeAdmin)
+
+	// CSV/XLSX exports of applications and saved candidates
+	exportService := service.NewExportService(applicationRepo, savedCandidateRepo, jobRepo)
+	exportHandler := handler.NewExportHandler(exportService)
+
 	// Newsletter handler
 	newsletterHandler := handler.NewNewsletterHandler(newsletterService)
 
 	// Cache handler
 	adminCacheHandler := handler.NewAdminCacheHandler(cacheService)
 
+	// Scheduler status handler
+	adminSchedulerHandler := handler.NewAdminSchedulerHandler(jobCronScheduler)
+
 	// Admin resume handler
 	adminResumeHandler := handler.NewAdminResumeHandler(resumeRepo, resumeService, userRepo, userSkillRepo)
 
 	// Initialize middleware
 	authMiddleware := middleware.AuthMiddleware(tokenService, userService)
+	// Employer API routes also accept company API keys, rate limited per key
+	employerAPIAuthMiddleware := middleware.APIKeyAuthMiddleware(apiKeyService, authMiddleware)
+	apiKeyRateLimitMiddleware := middleware.APIKeyRateLimitMiddleware(redis, cfg.APIKeyRateLimit, 1*time.Minute)
 	companyMiddleware := handlerMiddleware.NewCompanyMiddleware(companyService, teamService)
 	adminMiddleware := middleware.AdminMiddleware()
 	loginRateLimitMiddleware := middleware.LoginRateLimitMiddleware(redis)
 	emailRateLimitMiddleware := middleware.EmailRateLimitMiddleware(redis)
-	generalRateLimitMiddleware := middleware.RateLimitMiddleware(redis, 100, 1*time.Minute)
+	generalRateLimitMiddleware := middleware.RateLimitMiddleware(redis, 100, 1*time.Minute, middleware.ClientRateLimitKey)
 
 	// Storage handler for serving files from MinIO
 	storageHandler := handler.NewStorageHandler(minioClient)
@@ -464,6 +599,12 @@ func SetupRouter(cfg *config.Config, db *gorm.DB, redis *redis.Client, minioClie
 			newsletter.GET("/unsubscribe", newsletterHandler.Unsubscribe)
 		}
 
+		// ==================== Job Alert Routes (Public) ====================
+		savedSearches := v1.Group("/saved-searches")
+		{
+			savedSearches.GET("/unsubscribe", savedSearchHandler.Unsubscribe) // One-click link from job alert emails
+		}
+
 		// ==================== Admin Newsletter Routes ====================
 		adminNewsletter := v1.Group("/admin/newsletter")
 		adminNewsletter.Use(authMiddleware, adminMiddleware)
@@ -484,6 +625,21 @@ func SetupRouter(cfg *config.Config, db *gorm.DB, redis *redis.Client, minioClie
 			jobs.GET("/view/:slug", jobHandler.GetJobBySlug)         // Get job by slug (records view)
 			jobs.GET("/by-skill/:skill", jobHandler.GetJobsBySkill)  // Jobs filtered by skill
 			jobs.GET("/by-location/:city", jobHandler.GetJobsByCity) // Jobs filtered by city
+
+			// JobPosting JSON-LD for Google for Jobs
+			jobs.GET("/view/:slug/structured-data", structuredDataHandler.GetJobStructuredData)
+		}
+
+		// ==================== Job Feed Routes (Public) ====================
+		feeds := v1.Group("/feeds")
+		{
+			feeds.GET("/:format", feedHandler.GetFeed) // indeed, linkedin, rss or atom
+		}
+
+		// ==================== Sitemap Routes (Public) ====================
+		sitemaps := v1.Group("/sitemaps")
+		{
+			sitemaps.GET("/:file", sitemapHandler.GetSitemap) // sitemap.xml index and the files it lists
 		}
 
 		// Platform stats
@@ -512,10 +668,28 @@ func SetupRouter(cfg *config.Config, db *gorm.DB, redis *redis.Client, minioClie
 			jobSeekerMe.GET("/applications", jobSeekerHandler.GetMyApplications)
 			jobSeekerMe.GET("/applications/:id", jobSeekerHandler.GetApplicationDetail)
 
+			// Interviews
+			jobSeekerMe.GET("/applications/:id/interviews", interviewHandler.GetMyApplicationInterviews)
+			jobSeekerMe.POST("/interviews/:id/select-slot", interviewHandler.SelectSlot)
+
+			// Offers
+			jobSeekerMe.GET("/offers", offerHandler.GetMyOffers)
+			jobSeekerMe.GET("/offers/:id", offerHandler.GetMyOffer)
+			jobSeekerMe.POST("/offers/:id/accept", offerHandler.AcceptOffer)
+			jobSeekerMe.POST("/offers/:id/decline", offerHandler.DeclineOffer)
+
 			// Saved jobs
 			jobSeekerMe.GET("/saved-jobs", jobSeekerHandler.GetSavedJobs)
 			jobSeekerMe.PATCH("/saved-jobs/:id/notes", jobSeekerHandler.UpdateSavedJobNotes)
 
+			// Saved searches and job alerts
+			jobSeekerMe.GET("/saved-searches", savedSearchHandler.GetSavedSearches)
+			jobSeekerMe.POST("/saved-searches", savedSearchHandler.CreateSavedSearch)
+			jobSeekerMe.GET("/saved-searches/:id", savedSearchHandler.GetSavedSearch)
+			jobSeekerMe.PUT("/saved-searches/:id", savedSearchHandler.UpdateSavedSearch)
+			jobSeekerMe.DELETE("/saved-searches/:id", savedSearchHandler.DeleteSavedSearch)
+			jobSeekerMe.GET("/saved-searches/:id/matches", savedSearchHandler.GetSavedSearchMatches)
+
 			// Profile CRUD
 			jobSeekerMe.GET("/profile", profileHandler.GetMyProfile)
 			jobSeekerMe.PUT("/profile", profileHandler.UpdateProfile)
@@ -589,6 +763,21 @@ func SetupRouter(cfg *config.Config, db *gorm.DB, redis *redis.Client, minioClie
 			notificationRoutes.DELETE("/clear", notificationHandler.ClearRead)
 		}
 
+		// Streams can't send an Authorization header from EventSource, so the token may come from the query string
+		v1.GET("/me/notifications/stream", middleware.StreamAuthMiddleware(tokenService, userService), realtimeHandler.Stream)
+
+		// ==================== Web Push Routes ====================
+		v1.GET("/push/vapid-public-key", pushHandler.GetVAPIDPublicKey)
+
+		pushRoutes := v1.Group("/me/push-subscriptions")
+		pushRoutes.Use(authMiddleware)
+		{
+			pushRoutes.GET("", pushHandler.GetSubscriptions)
+			pushRoutes.POST("", pushHandler.Subscribe)
+			pushRoutes.POST("/test", pushHandler.SendTest)
+			pushRoutes.DELETE("/:id", pushHandler.Unsubscribe)
+		}
+
 		notificationPrefsRoutes := v1.Group("/me/notification-preferences")
 		notificationPrefsRoutes.Use(authMiddleware)
 		{
@@ -596,35 +785,150 @@ func SetupRouter(cfg *config.Config, db *gorm.DB, redis *redis.Client, minioClie
 			notificationPrefsRoutes.PUT("", notificationHandler.UpdatePreferences)
 		}
 
+		// ==================== Conversation Routes (Employers and Applicants) ====================
+		conversationRoutes := v1.Group("/me/conversations")
+		conversationRoutes.Use(authMiddleware)
+		{
+			conversationRoutes.GET("", messageHandler.GetConversations)
+			conversationRoutes.POST("", messageHandler.StartConversation)
+			conversationRoutes.GET("/unread", messageHandler.GetUnreadCount)
+			conversationRoutes.GET("/:id", messageHandler.GetConversation)
+			conversationRoutes.GET("/:id/messages", messageHandler.GetMessages)
+			conversationRoutes.POST("/:id/messages", messageHandler.SendMessage)
+			conversationRoutes.PUT("/:id/read", messageHandler.MarkRead)
+			conversationRoutes.GET("/:id/attachments/:attachmentId", messageHandler.DownloadAttachment)
+		}
+
 		// ==================== Employer Job Routes ====================
 		employerJobs := v1.Group("/employer/jobs")
-		employerJobs.Use(authMiddleware, middleware.EmployerOnly())
+		employerJobs.Use(employerAPIAuthMiddleware, apiKeyRateLimitMiddleware, middleware.EmployerOnly())
 		{
+			jobsRead := middleware.RequireScope(domain.APIKeyScopeJobsRead)
+			jobsWrite := middleware.RequireScope(domain.APIKeyScopeJobsWrite)
+			applicationsRead := middleware.RequireScope(domain.APIKeyScopeApplicationsRead)
+
 			// Job CRUD
-			employerJobs.POST("", employerJobHandler.CreateJob)
-			employerJobs.GET("", employerJobHandler.GetMyJobs)
-			employerJobs.GET("/:id", employerJobHandler.GetMyJobByID)
-			employerJobs.PUT("/:id", employerJobHandler.UpdateJob)
-			employerJobs.DELETE("/:id", employerJobHandler.DeleteJob)
+			employerJobs.POST("", jobsWrite, employerJobHandler.CreateJob)
+			employerJobs.GET("", jobsRead, employerJobHandler.GetMyJobs)
+			employerJobs.GET("/:id", jobsRead, employerJobHandler.GetMyJobByID)
+			employerJobs.PUT("/:id", jobsWrite, employerJobHandler.UpdateJob)
+			employerJobs.DELETE("/:id", jobsWrite, employerJobHandler.DeleteJob)
+
+			// Bulk upload (validated first, created in the background once confirmed)
+			employerJobs.GET("/bulk-upload/columns", jobsRead, employerJobUploadHandler.GetColumns)
+			employerJobs.GET("/bulk-upload/template", jobsRead, employerJobUploadHandler.DownloadTemplate)
+			employerJobs.POST("/bulk-upload", jobsWrite, employerJobUploadHandler.UploadJobs)
+			employerJobs.GET("/bulk-upload", jobsRead, employerJobUploadHandler.GetUploads)
+			employerJobs.GET("/bulk-upload/:id", jobsRead, employerJobUploadHandler.GetUpload)
+			employerJobs.POST("/bulk-upload/:id/confirm", jobsWrite, employerJobUploadHandler.ConfirmUpload)
 
 			// Job actions
-			employerJobs.POST("/:id/close", employerJobHandler.CloseJob)
-			employerJobs.POST("/:id/renew", employerJobHandler.RenewJob)
+			employerJobs.POST("/:id/close", jobsWrite, employerJobHandler.CloseJob)
+			employerJobs.POST("/:id/renew", jobsWrite, employerJobHandler.RenewJob)
+			employerJobs.POST("/:id/publish", jobsWrite, employerJobHandler.PublishJob)
+			employerJobs.POST("/:id/draft", jobsWrite, employerJobHandler.RevertJobToDraft)
+
+			// Revision history
+			employerJobs.GET("/:id/revisions", jobsRead, employerJobHandler.GetJobRevisions)
+			employerJobs.POST("/:id/revisions/:revisionId/restore", jobsWrite, employerJobHandler.RestoreJobRevision)
 
 			// Job applications
-			employerJobs.GET("/:id/applications", employerJobHandler.GetJobApplications)
-			employerJobs.GET("/:id/analytics", employerJobHandler.GetJobAnalytics)
+			employerJobs.GET("/:id/applications", applicationsRead, employerJobHandler.GetJobApplications)
+			employerJobs.GET("/:id/applications/export", applicationsRead, exportHandler.ExportJobApplications)
+			employerJobs.GET("/:id/analytics", jobsRead, employerJobHandler.GetJobAnalytics)
+			employerJobs.GET("/:id/pipeline", applicationsRead, employerJobHandler.GetJobPipeline)
+
+			// Scorecard criteria
+			employerJobs.GET("/:id/scorecard", middleware.UserTokenOnly(), scorecardHandler.GetJobCriteria)
+			employerJobs.PUT("/:id/scorecard", middleware.UserTokenOnly(), scorecardHandler.UpdateJobCriteria)
+		}
+
+		// Employer - Company review of recruiter job postings
+		employerJobReviews := v1.Group("/employer/job-reviews")
+		employerJobReviews.Use(authMiddleware, middleware.EmployerOnly())
+		{
+			employerJobReviews.GET("", employerJobHandler.GetJobsPendingReview)
+			employerJobReviews.POST("/:id/approve", employerJobHandler.ApproveJobReview)
+			employerJobReviews.POST("/:id/reject", employerJobHandler.RejectJobReview)
 		}
 
 		// Employer - Application management
 		employerApplications := v1.Group("/employer/applications")
-		employerApplications.Use(authMiddleware, middleware.EmployerOnly())
+		employerApplications.Use(employerAPIAuthMiddleware, apiKeyRateLimitMiddleware, middleware.EmployerOnly())
 		{
-			employerApplications.GET("", employerJobHandler.GetAllApplications)
-			employerApplications.GET("/:id", employerJobHandler.GetApplicationDetail)
-			employerApplications.PATCH("/:id/status", employerJobHandler.UpdateApplicationStatus)
-			employerApplications.PATCH("/:id/notes", employerJobHandler.AddApplicationNotes)
-			employerApplications.PATCH("/:id/rating", employerJobHandler.RateApplicant)
+			applicationsRead := middleware.RequireScope(domain.APIKeyScopeApplicationsRead)
+			applicationsWrite := middleware.RequireScope(domain.APIKeyScopeApplicationsWrite)
+
+			employerApplications.GET("", applicationsRead, employerJobHandler.GetAllApplications)
+			employerApplications.POST("/bulk-move", applicationsWrite, employerJobHandler.BulkMoveApplications)
+			employerApplications.GET("/:id", applicationsRead, employerJobHandler.GetApplicationDetail)
+			employerApplications.PATCH("/:id/status", applicationsWrite, employerJobHandler.UpdateApplicationStatus)
+			employerApplications.PATCH("/:id/stage", applicationsWrite, employerJobHandler.MoveApplication)
+			employerApplications.PATCH("/:id/rating", applicationsWrite, employerJobHandler.RateApplicant)
+
+			// Interviews, offers, feedback and team notes are created in a team member's own name,
+			// API keys can only read interviews and offers
+
+			// Interviews
+			employerApplications.GET("/:id/interviews", applicationsRead, interviewHandler.GetApplicationInterviews)
+			employerApplications.POST("/:id/interviews", middleware.UserTokenOnly(), interviewHandler.CreateInterview)
+
+			// Offers
+			employerApplications.GET("/:id/offers", applicationsRead, offerHandler.GetApplicationOffers)
+			employerApplications.POST("/:id/offers", middleware.UserTokenOnly(), offerHandler.CreateOffer)
+
+			// Interview feedback
+			employerApplications.GET("/:id/scorecards", middleware.UserTokenOnly(), scorecardHandler.GetApplicationFeedback)
+			employerApplications.PUT("/:id/scorecards/me", middleware.UserTokenOnly(), scorecardHandler.SaveMyScorecard)
+
+			// Team notes
+			employerApplications.GET("/:id/notes", middleware.UserTokenOnly(), noteHandler.GetApplicationNotes)
+			employerApplications.POST("/:id/notes", middleware.UserTokenOnly(), noteHandler.AddApplicationNote)
+			// Legacy notes endpoint, adds a team note instead of overwriting employer_notes
+			employerApplications.PATCH("/:id/notes", middleware.UserTokenOnly(), noteHandler.AddApplicationNote)
+		}
+
+		// Employer - Team notes (edit/delete by author)
+		employerNotes := v1.Group("/employer/notes")
+		employerNotes.Use(authMiddleware, middleware.EmployerOnly())
+		{
+			employerNotes.PUT("/:id", noteHandler.UpdateNote)
+			employerNotes.DELETE("/:id", noteHandler.DeleteNote)
+		}
+
+		// Employer - Interview management
+		employerInterviews := v1.Group("/employer/interviews")
+		employerInterviews.Use(authMiddleware, middleware.EmployerOnly())
+		{
+			employerInterviews.GET("/:id", interviewHandler.GetInterview)
+			employerInterviews.POST("/:id/reschedule", interviewHandler.RescheduleInterview)
+			employerInterviews.POST("/:id/cancel", interviewHandler.CancelInterview)
+			employerInterviews.POST("/:id/complete", interviewHandler.CompleteInterview)
+		}
+
+		// Interview calendar invites (candidate, employer and interviewers)
+		interviews := v1.Group("/interviews")
+		interviews.Use(authMiddleware)
+		{
+			interviews.GET("/:id/calendar.ics", interviewHandler.DownloadCalendarInvite)
+		}
+
+		// Employer - Offers
+		employerOffers := v1.Group("/employer/offers")
+		employerOffers.Use(authMiddleware, middleware.EmployerOnly())
+		{
+			employerOffers.GET("/:id", offerHandler.GetOffer)
+			employerOffers.PUT("/:id", offerHandler.UpdateOffer)
+			employerOffers.POST("/:id/document", offerHandler.UploadOfferLetter)
+			employerOffers.POST("/:id/send", offerHandler.SendOffer)
+			employerOffers.POST("/:id/withdraw", offerHandler.WithdrawOffer)
+		}
+
+		// Offer letters (candidate and employer)
+		offers := v1.Group("/offers")
+		offers.Use(authMiddleware)
+		{
+			offers.GET("/:id/document", offerHandler.DownloadOfferLetter)
 		}
 
 		// Employer - Analytics
@@ -632,6 +936,7 @@ func SetupRouter(cfg *config.Config, db *gorm.DB, redis *redis.Client, minioClie
 		employerAnalytics.Use(authMiddleware, middleware.EmployerOnly())
 		{
 			employerAnalytics.GET("/overview", employerJobHandler.GetOverviewAnalytics)
+			employerAnalytics.GET("/requisitions", employerJobHandler.GetRequisitionAnalytics)
 		}
 
 		// Employer - Candidate Search
@@ -640,8 +945,8 @@ func SetupRouter(cfg *config.Config, db *gorm.DB, redis *redis.Client, minioClie
 		{
 			employerCandidates.GET("", employerCandidateHandler.SearchCandidates)
 			employerCandidates.GET("/:id", employerCandidateHandler.GetCandidateProfile)
-			employerCandidates.GET("/:id/notes", employerCandidateHandler.GetCandidateNotes)
-			employerCandidates.POST("/:id/notes", employerCandidateHandler.AddCandidateNote)
+			employerCandidates.GET("/:id/notes", noteHandler.GetCandidateNotes)
+			employerCandidates.POST("/:id/notes", noteHandler.AddCandidateNote)
 		}
 
 		// Employer - Saved Candidates
@@ -649,6 +954,7 @@ func SetupRouter(cfg *config.Config, db *gorm.DB, redis *redis.Client, minioClie
 		employerSavedCandidates.Use(authMiddleware, middleware.EmployerOnly())
 		{
 			employerSavedCandidates.GET("", employerCandidateHandler.GetSavedCandidates)
+			employerSavedCandidates.GET("/export", exportHandler.ExportSavedCandidates)
 			employerSavedCandidates.POST("", employerCandidateHandler.SaveCandidate)
 			employerSavedCandidates.PUT("/:id", employerCandidateHandler.UpdateSavedCandidate)
 			employerSavedCandidates.DELETE("/:id", employerCandidateHandler.RemoveSavedCandidate)
@@ -667,11 +973,32 @@ func SetupRouter(cfg *config.Config, db *gorm.DB, redis *redis.Client, minioClie
 			adminJobs.PUT("/:id", adminJobHandler.UpdateJob)
 			adminJobs.DELETE("/:id", adminJobHandler.DeleteJob)
 
+			// Bulk upload (validated first, created in the background once confirmed)
+			adminJobs.GET("/bulk-upload/columns", adminJobUploadHandler.GetColumns)
+			adminJobs.GET("/bulk-upload/template", adminJobUploadHandler.DownloadTemplate)
+			adminJobs.POST("/bulk-upload", adminJobUploadHandler.UploadJobs)
+			adminJobs.GET("/bulk-upload", adminJobUploadHandler.GetUploads)
+			adminJobs.GET("/bulk-upload/:id", adminJobUploadHandler.GetUpload)
+			adminJobs.POST("/bulk-upload/:id/confirm", adminJobUploadHandler.ConfirmUpload)
+
 			// Job moderation
 			adminJobs.POST("/:id/approve", adminJobHandler.ApproveJob)
 			adminJobs.POST("/:id/reject", adminJobHandler.RejectJob)
 			adminJobs.POST("/:id/feature", adminJobHandler.FeatureJob)
 			adminJobs.POST("/:id/unfeature", adminJobHandler.UnfeatureJob)
+			adminJobs.GET("/:id/revisions", adminJobHandler.GetJobRevisions)
+			adminJobs.POST("/:id/revisions/:revisionId/restore", adminJobHandler.RestoreJobRevision)
+
+			// Duplicate jobs
+			adminJobs.GET("/duplicates", adminDuplicateHandler.GetDuplicates)
+			adminJobs.POST("/duplicates/rebuild", adminDuplicateHandler.RebuildFingerprints)
+			adminJobs.POST("/duplicates/:id/dismiss", adminDuplicateHandler.DismissDuplicate)
+			adminJobs.POST("/duplicates/:id/merge", adminDuplicateHandler.MergeDuplicate)
+			adminJobs.GET("/:id/duplicates", adminDuplicateHandler.GetJobDuplicates)
+
+			// Structured data (Google for Jobs)
+			adminJobs.GET("/structured-data/audit", structuredDataHandler.AuditJobs)
+			adminJobs.GET("/:id/structured-data", structuredDataHandler.AdminGetJobStructuredData)
 
 			// Job scraping
 			adminJobs.POST("/scrape/preview", scraperHandler.PreviewJobFromURL)
@@ -706,6 +1033,21 @@ func SetupRouter(cfg *config.Config, db *gorm.DB, redis *redis.Client, minioClie
 
 			// Auto-categorization
 			adminJobs.POST("/auto-categorize", adminJobHandler.AutoCategorizeJobs)
+
+			// Expiry scheduler status and run history
+			adminJobs.GET("/scheduler-status", adminSchedulerHandler.GetJobSchedulerStatus)
+		}
+
+		// Admin - Job sources (recurring career-page imports)
+		adminJobSources := v1.Group("/admin/job-sources")
+		adminJobSources.Use(authMiddleware, adminMiddleware)
+		{
+			adminJobSources.GET("", adminJobSourceHandler.ListSources)
+			adminJobSources.POST("", adminJobSourceHandler.CreateSource)
+			adminJobSources.GET("/:id", adminJobSourceHandler.GetSource)
+			adminJobSources.PUT("/:id", adminJobSourceHandler.UpdateSource)
+			adminJobSources.DELETE("/:id", adminJobSourceHandler.DeleteSource)
+			adminJobSources.POST("/:id/sync", adminJobSourceHandler.SyncSource)
 		}
 
 		// Admin - Application stats
@@ -816,6 +1158,14 @@ func SetupRouter(cfg *config.Config, db *gorm.DB, redis *redis.Client, minioClie
 			employerCompany.DELETE("/team/:id", companyMiddleware.HasUserCompany(), companyMiddleware.CanManageTeam(), employerCompanyHandler.RemoveTeamMember)
 			employerCompany.POST("/team/transfer-ownership", companyMiddleware.HasUserCompany(), companyMiddleware.IsCompanyOwner(), employerCompanyHandler.TransferOwnership)
 
+			// Hiring pipeline
+			employerCompany.GET("/pipeline", companyMiddleware.HasUserCompany(), employerPipelineHandler.GetPipeline)
+			employerCompany.PUT("/pipeline", companyMiddleware.HasUserCompany(), companyMiddleware.CanManageTeam(), employerPipelineHandler.UpdatePipeline)
+			employerCompany.DELETE("/pipeline", companyMiddleware.HasUserCompany(), companyMiddleware.CanManageTeam(), employerPipelineHandler.ResetPipeline)
+
+			// Applications export across all company jobs
+			employerCompany.GET("/applications/export", companyMiddleware.HasUserCompany(), companyMiddleware.CanManageTeam(), exportHandler.ExportCompanyApplications)
+
 			// Locations
 			employerCompany.GET("/locations", companyMiddleware.HasUserCompany(), employerCompanyHandler.GetLocations)
 			employerCompany.POST("/locations", companyMiddleware.HasUserCompany(), companyMiddleware.CanEditCompany(), employerCompanyHandler.CreateLocation)
@@ -847,6 +1197,24 @@ func SetupRouter(cfg *config.Config, db *gorm.DB, redis *redis.Client, minioClie
 			employerCompany.POST("/invitations", companyMiddleware.HasUserCompany(), companyMiddleware.CanManageTeam(), invitationHandler.CreateInvitation)
 			employerCompany.DELETE("/invitations/:id", companyMiddleware.HasUserCompany(), companyMiddleware.CanManageTeam(), invitationHandler.CancelInvitation)
 			employerCompany.POST("/invitations/:id/resend", companyMiddleware.HasUserCompany(), companyMiddleware.CanManageTeam(), invitationHandler.ResendInvitation)
+
+			// Webhooks (owners and admins)
+			employerCompany.GET("/webhooks", companyMiddleware.HasUserCompany(), companyMiddleware.CanManageTeam(), employerWebhookHandler.GetWebhooks)
+			employerCompany.GET("/webhooks/events", companyMiddleware.HasUserCompany(), companyMiddleware.CanManageTeam(), employerWebhookHandler.GetWebhookEvents)
+			employerCompany.POST("/webhooks", companyMiddleware.HasUserCompany(), companyMiddleware.CanManageTeam(), employerWebhookHandler.CreateWebhook)
+			employerCompany.GET("/webhooks/:id", companyMiddleware.HasUserCompany(), companyMiddleware.CanManageTeam(), employerWebhookHandler.GetWebhook)
+			employerCompany.PUT("/webhooks/:id", companyMiddleware.HasUserCompany(), companyMiddleware.CanManageTeam(), employerWebhookHandler.UpdateWebhook)
+			employerCompany.DELETE("/webhooks/:id", companyMiddleware.HasUserCompany(), companyMiddleware.CanManageTeam(), employerWebhookHandler.DeleteWebhook)
+			employerCompany.POST("/webhooks/:id/rotate-secret", companyMiddleware.HasUserCompany(), companyMiddleware.CanManageTeam(), employerWebhookHandler.RotateSecret)
+			employerCompany.GET("/webhooks/:id/deliveries", companyMiddleware.HasUserCompany(), companyMiddleware.CanManageTeam(), employerWebhookHandler.GetDeliveries)
+			employerCompany.GET("/webhooks/:id/deliveries/:delivery_id", companyMiddleware.HasUserCompany(), companyMiddleware.CanManageTeam(), employerWebhookHandler.GetDelivery)
+			employerCompany.POST("/webhooks/:id/deliveries/:delivery_id/replay", companyMiddleware.HasUserCompany(), companyMiddleware.CanManageTeam(), employerWebhookHandler.ReplayDelivery)
+
+			// API keys (owners and admins)
+			employerCompany.GET("/api-keys", companyMiddleware.HasUserCompany(), companyMiddleware.CanManageTeam(), employerAPIKeyHandler.GetAPIKeys)
+			employerCompany.GET("/api-keys/scopes", companyMiddleware.HasUserCompany(), companyMiddleware.CanManageTeam(), employerAPIKeyHandler.GetScopes)
+			employerCompany.POST("/api-keys", companyMiddleware.HasUserCompany(), companyMiddleware.CanManageTeam(), employerAPIKeyHandler.CreateAPIKey)
+			employerCompany.DELETE("/api-keys/:id", companyMiddleware.HasUserCompany(), companyMiddleware.CanManageTeam(), employerAPIKeyHandler.RevokeAPIKey)
 		}
 
 		// ==================== Invitation Routes ====================
@@ -0,0 +1,204 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"job-platform/internal/domain"
+	"job-platform/internal/repository"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	// APIKeyPrefix starts every API key, so keys can be told apart from access tokens and found by secret scanners
+	APIKeyPrefix = "jpk_"
+	// MaxAPIKeysPerCompany is the number of active API keys a company can have
+	MaxAPIKeysPerCompany = 20
+	// apiKeyDisplayLength is how much of a key is stored in clear to recognize it in listings
+	apiKeyDisplayLength = len(APIKeyPrefix) + 8
+	// apiKeyUsageInterval throttles last-used tracking to one write per key and interval
+	apiKeyUsageInterval = time.Minute
+)
+
+// APIKeyService handles company API keys
+type APIKeyService struct {
+	apiKeyRepo *repository.APIKeyRepository
+	teamRepo   *repository.TeamRepository
+	userRepo   *repository.UserRepository
+}
+
+// NewAPIKeyService creates a new API key service
+func NewAPIKeyService(
+	apiKeyRepo *repository.APIKeyRepository,
+	teamRepo *repository.TeamRepository,
+	userRepo *repository.UserRepository,
+) *APIKeyService {
+	return &APIKeyService{
+		apiKeyRepo: apiKeyRepo,
+		teamRepo:   teamRepo,
+		userRepo:   userRepo,
+	}
+}
+
+// CreateAPIKeyInput represents input for creating an API key
+type CreateAPIKeyInput struct {
+	Name      string
+	Scopes    []string
+	ExpiresAt *time.Time
+}
+
+// CreateAPIKey creates an API key for a company that acts as the user creating it.
+// Returns the key itself, which is not stored and can't be retrieved again.
+func (s *APIKeyService) CreateAPIKey(companyID, userID uuid.UUID, input CreateAPIKeyInput) (*domain.APIKey, string, error) {
+	scopes, err := normalizeAPIKeyScopes(input.Scopes)
+	if err != nil {
+		return nil, "", err
+	}
+	if input.ExpiresAt != nil && !input.ExpiresAt.After(time.Now()) {
+		return nil, "", domain.ErrInvalidAPIKeyExpiry
+	}
+
+	count, err := s.apiKeyRepo.CountActiveByCompanyID(companyID)
+	if err != nil {
+		return nil, "", err
+	}
+	if count >= MaxAPIKeysPerCompany {
+		return nil, "", domain.ErrAPIKeyLimitReached
+	}
+
+	rawKey, err := generateAPIKey()
+	if err != nil {
+		return nil, "", err
+	}
+
+	key := &domain.APIKey{
+		ID:        uuid.New(),
+		CompanyID: companyID,
+		CreatedBy: userID,
+		Name:      strings.TrimSpace(input.Name),
+		Prefix:    rawKey[:apiKeyDisplayLength],
+		KeyHash:   hashAPIKey(rawKey),
+		Scopes:    scopes,
+		ExpiresAt: input.ExpiresAt,
+	}
+	if err := s.apiKeyRepo.Create(key); err != nil {
+		return nil, "", err
+	}
+
+	created, err := s.apiKeyRepo.GetByID(key.ID)
+	if err != nil {
+		return nil, "", err
+	}
+	return created, rawKey, nil
+}
+
+// GetAPIKeys retrieves the API keys of a company, including revoked and expired keys
+func (s *APIKeyService) GetAPIKeys(companyID uuid.UUID) ([]domain.APIKey, error) {
+	return s.apiKeyRepo.GetByCompanyID(companyID)
+}
+
+// RevokeAPIKey revokes an API key of a company. Requests with the key are rejected immediately.
+func (s *APIKeyService) RevokeAPIKey(id, companyID, revokedBy uuid.UUID) (*domain.APIKey, error) {
+	key, err := s.apiKeyRepo.GetByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrAPIKeyNotFound
+		}
+		return nil, err
+	}
+	if key.CompanyID != companyID {
+		return nil, domain.ErrAPIKeyNotFound
+	}
+
+	if !key.IsRevoked() {
+		if err := s.apiKeyRepo.Revoke(id, revokedBy, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.apiKeyRepo.GetByID(id)
+}
+
+// IsAPIKey reports whether a credential looks like an API key rather than an access token
+func IsAPIKey(credential string) bool {
+	return strings.HasPrefix(credential, APIKeyPrefix)
+}
+
+// Authenticate resolves an API key to the key and the user it acts as. Keys stop working when they
+// are revoked or expired, and when their creator is no longer an active member of the company.
+func (s *APIKeyService) Authenticate(rawKey, ip string) (*domain.APIKey, *domain.User, error) {
+	if !IsAPIKey(rawKey) {
+		return nil, nil, domain.ErrInvalidAPIKey
+	}
+
+	key, err := s.apiKeyRepo.GetByHash(hashAPIKey(rawKey))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, domain.ErrInvalidAPIKey
+		}
+		return nil, nil, err
+	}
+	if key.IsRevoked() || key.IsExpired() {
+		return nil, nil, domain.ErrInvalidAPIKey
+	}
+
+	member, err := s.teamRepo.GetByCompanyAndUser(key.CompanyID, key.CreatedBy)
+	if err != nil || member.Status != domain.TeamMemberStatusActive {
+		return nil, nil, domain.ErrInvalidAPIKey
+	}
+
+	user, err := s.userRepo.GetByID(key.CreatedBy)
+	if err != nil || user.Status != domain.StatusActive {
+		return nil, nil, domain.ErrInvalidAPIKey
+	}
+
+	now := time.Now()
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= apiKeyUsageInterval || key.LastUsedIP != ip {
+		if err := s.apiKeyRepo.RecordUse(key.ID, now, ip); err != nil {
+			log.Printf("Error recording use of API key %s: %v", key.ID, err)
+		}
+	}
+
+	return key, user, nil
+}
+
+// normalizeAPIKeyScopes validates scopes and removes duplicates
+func normalizeAPIKeyScopes(requested []string) ([]string, error) {
+	if len(requested) == 0 {
+		return nil, domain.ErrInvalidAPIKeyScopes
+	}
+
+	scopes := make([]string, 0, len(requested))
+	seen := make(map[string]bool, len(requested))
+	for _, scope := range requested {
+		if !domain.APIKeyScope(scope).IsValid() {
+			return nil, domain.ErrInvalidAPIKeyScopes
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes, nil
+}
+
+// generateAPIKey creates a random API key
+func generateAPIKey() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return APIKeyPrefix + hex.EncodeToString(b), nil
+}
+
+// hashAPIKey hashes an API key for storage and lookup. Keys are random, so a fast hash is enough.
+func hashAPIKey(rawKey string) string {
+	sum := sha256.Sum256([]byte(rawKey))
+	return hex.EncodeToString(sum[:])
+}
@@ -2,12 +2,17 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"job-platform/internal/domain"
+	"job-platform/internal/realtime"
 	"job-platform/internal/repository"
+	"log"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
 
@@ -19,6 +24,10 @@ type ApplicationService struct {
 	userRepo               *repository.UserRepository
 	db                     *gorm.DB
 	notificationService    *NotificationService
+	pipelineService        *PipelineService
+	realtimeHub            *realtime.Hub
+	webhookService         *WebhookService
+	jobService             *JobService
 }
 
 // NewApplicationService creates a new application service
@@ -43,6 +52,44 @@ func (s *ApplicationService) SetNotificationService(ns *NotificationService) {
 	s.notificationService = ns
 }
 
+// SetPipelineService sets the pipeline service used to resolve company pipeline stages
+func (s *ApplicationService) SetPipelineService(ps *PipelineService) {
+	s.pipelineService = ps
+}
+
+// SetRealtimeHub sets the hub used to push application status updates to connected users
+func (s *ApplicationService) SetRealtimeHub(hub *realtime.Hub) {
+	s.realtimeHub = hub
+}
+
+// SetWebhookService sets the service used to send application events to company webhooks
+func (s *ApplicationService) SetWebhookService(ws *WebhookService) {
+	s.webhookService = ws
+}
+
+// SetJobService sets the job service used to close jobs whose openings are filled
+func (s *ApplicationService) SetJobService(js *JobService) {
+	s.jobService = js
+}
+
+// publishStatusChange pushes an application status update to a connected user
+func (s *ApplicationService) publishStatusChange(userID uuid.UUID, application *domain.Application, fromStatus, toStatus domain.ApplicationStatus) {
+	if s.realtimeHub == nil {
+		return
+	}
+
+	data := realtime.ApplicationStatusData{
+		ApplicationID: application.ID,
+		JobID:         application.JobID,
+		JobTitle:      application.Job.Title,
+		FromStatus:    string(fromStatus),
+		Status:        string(toStatus),
+	}
+	if err := s.realtimeHub.Publish(context.Background(), userID, realtime.EventApplicationStatus, data); err != nil {
+		log.Printf("Error publishing status of application %s: %v", application.ID, err)
+	}
+}
+
 // ApplyJobInput represents input for applying to a job
 type ApplyJobInput struct {
 	ResumeURL      string
@@ -77,6 +124,23 @@ func (s *ApplicationService) ApplyToJob(applicantID, jobID uuid.UUID, input Appl
 		return nil, domain.ErrResumeRequired
 	}
 
+	// Validate screening answers against the job's questions
+	job, err := s.jobRepo.GetByID(jobID)
+	if err != nil {
+		return nil, domain.ErrJobNotFound
+	}
+	screening, err := evaluateScreeningAnswers(job.ScreeningQuestions, input.Answers)
+	if err != nil {
+		return nil, err
+	}
+
+	// New applications start in the first stage of the company's pipeline
+	pipeline, err := s.getJobPipeline(job)
+	if err != nil {
+		return nil, err
+	}
+	firstStage := pipeline.FirstStage()
+
 	// Create application
 	application := &domain.Application{
 		ID:             uuid.New(),
@@ -87,16 +151,42 @@ func (s *ApplicationService) ApplyToJob(applicantID, jobID uuid.UUID, input Appl
 		ExpectedSalary: input.ExpectedSalary,
 		AvailableFrom:  input.AvailableFrom,
 		Status:         domain.ApplicationStatusSubmitted,
+		StageID:        persistedStageID(firstStage),
 		AppliedAt:      time.Now(),
 	}
 
-	// Convert answers to JSONB if provided
-	// answers := datatypes.JSON{}
-	// if input.Answers != nil {
-	// 	answersJSON, _ := json.Marshal(input.Answers)
-	// 	answers = answersJSON
-	// }
-	// application.Answers = answers
+	// Store validated answers as JSONB
+	if len(screening.Answers) > 0 {
+		answersJSON, err := json.Marshal(screening.Answers)
+		if err != nil {
+			return nil, err
+		}
+		application.Answers = datatypes.JSON(answersJSON)
+	}
+
+	// Apply knockout rules
+	var rejection *domain.ApplicationStatusHistory
+	switch screening.Action {
+	case domain.KnockoutActionReject:
+		now := time.Now()
+		fromStatus := domain.ApplicationStatusSubmitted
+		application.Status = domain.ApplicationStatusRejected
+		application.StatusUpdatedAt = &now
+		application.RejectionReason = "Did not meet the screening requirements"
+		rejection = &domain.ApplicationStatusHistory{
+			ID:            uuid.New(),
+			ApplicationID: application.ID,
+			FromStatus:    &fromStatus,
+			ToStatus:      domain.ApplicationStatusRejected,
+			FromStage:     firstStage.Name,
+			ToStage:       domain.DefaultStageName(domain.ApplicationStatusRejected),
+			Notes:         "Automatically rejected by screening questions: " + strings.Join(screening.KnockedOut, "; "),
+			CreatedAt:     now.Add(time.Millisecond), // Keep ordering after the SUBMITTED entry
+		}
+	case domain.KnockoutActionFlag:
+		application.IsFlagged = true
+		application.FlagReason = "Knockout answer to: " + strings.Join(screening.KnockedOut, "; ")
+	}
 
 	// Create application in transaction
 	tx := s.db.Begin()
@@ -118,6 +208,8 @@ func (s *ApplicationService) ApplyToJob(applicantID, jobID uuid.UUID, input Appl
 		ApplicationID: application.ID,
 		FromStatus:    nil,
 		ToStatus:      domain.ApplicationStatusSubmitted,
+		ToStage:       firstStage.Name,
+		ToStageID:     persistedStageID(firstStage),
 		CreatedAt:     time.Now(),
 	}
 
@@ -127,6 +219,13 @@ func (s *ApplicationService) ApplyToJob(applicantID, jobID uuid.UUID, input Appl
 		return nil, err
 	}
 
+	if rejection != nil {
+		if err := historyRepoTx.Create(rejection); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
 	// Increment applications count for job
 	jobRepoTx := repository.NewJobRepository(tx)
 	if err := jobRepoTx.IncrementApplicationsCount(jobID); err != nil {
@@ -144,6 +243,14 @@ func (s *ApplicationService) ApplyToJob(applicantID, jobID uuid.UUID, input Appl
 		return nil, err
 	}
 
+	if s.webhookService != nil {
+		stage := firstStage.Name
+		if rejection != nil {
+			stage = rejection.ToStage
+		}
+		s.webhookService.ApplicationCreated(app, stage)
+	}
+
 	// Send notification to employer about new application (async)
 	if s.notificationService != nil {
 		go func() {
@@ -170,6 +277,43 @@ func (s *ApplicationService) ApplyToJob(applicantID, jobID uuid.UUID, input Appl
 	return app, nil
 }
 
+// screeningResult holds validated screening answers and the strongest knockout triggered by them
+type screeningResult struct {
+	Answers    []domain.ScreeningAnswer
+	Action     domain.KnockoutAction
+	KnockedOut []string // Questions that received a knockout answer
+}
+
+// evaluateScreeningAnswers validates answers (keyed by question ID) against a job's screening questions
+func evaluateScreeningAnswers(questions []domain.ScreeningQuestion, answers map[string]interface{}) (*screeningResult, error) {
+	result := &screeningResult{Action: domain.KnockoutActionNone}
+
+	for i := range questions {
+		question := &questions[i]
+		answer, err := question.EvaluateAnswer(answers[question.ID.String()])
+		if err != nil {
+			return nil, err
+		}
+		if answer == nil {
+			continue
+		}
+		result.Answers = append(result.Answers, *answer)
+
+		if !answer.KnockedOut {
+			continue
+		}
+		result.KnockedOut = append(result.KnockedOut, question.Question)
+		// REJECT wins over FLAG when several knockouts are triggered
+		if question.KnockoutAction == domain.KnockoutActionReject {
+			result.Action = domain.KnockoutActionReject
+		} else if result.Action == domain.KnockoutActionNone {
+			result.Action = question.KnockoutAction
+		}
+	}
+
+	return result, nil
+}
+
 // WithdrawApplication withdraws an application
 func (s *ApplicationService) WithdrawApplication(applicationID, applicantID uuid.UUID) error {
 	// Get application
@@ -218,7 +362,18 @@ func (s *ApplicationService) WithdrawApplication(applicationID, applicantID uuid
 		return err
 	}
 
-	return tx.Commit().Error
+	if err := tx.Commit().Error; err != nil {
+		return err
+	}
+
+	// Let the employer's open pipeline views know the application left
+	s.publishStatusChange(application.Job.EmployerID, application, application.Status, domain.ApplicationStatusWithdrawn)
+
+	if s.webhookService != nil {
+		s.dispatchWithdrawal(application)
+	}
+
+	return nil
 }
 
 // GetMyApplications retrieves all applications by an applicant
@@ -270,25 +425,43 @@ func (s *ApplicationService) GetJobApplications(jobID, employerID uuid.UUID, lim
 	return s.applicationRepo.GetJobApplications(jobID, limit, offset)
 }
 
-// UpdateApplicationStatus updates the status of an application (employer only)
+// UpdateApplicationStatus moves an application to the first pipeline stage of a status (employer only)
 func (s *ApplicationService) UpdateApplicationStatus(applicationID, employerID uuid.UUID, status domain.ApplicationStatus, reason string) error {
+	_, err := s.MoveApplication(applicationID, employerID, MoveApplicationInput{
+		Status: status,
+		Reason: reason,
+	})
+	return err
+}
+
+// MoveApplicationInput represents a move of an application within its pipeline.
+// StageID selects a stage of the company's custom pipeline; otherwise Status selects
+// the first stage mapped to that status, or REJECTED to reject the application.
+type MoveApplicationInput struct {
+	StageID *uuid.UUID
+	Status  domain.ApplicationStatus
+	Reason  string
+}
+
+// MoveApplication moves an application to another pipeline stage (employer only)
+func (s *ApplicationService) MoveApplication(applicationID, employerID uuid.UUID, input MoveApplicationInput) (*domain.Application, error) {
 	// Get application
 	application, err := s.applicationRepo.GetByID(applicationID)
 	if err != nil {
-		return domain.ErrApplicationNotFound
+		return nil, domain.ErrApplicationNotFound
 	}
 
 	// Verify job ownership
 	if application.Job.EmployerID != employerID {
-		return domain.ErrJobNotOwnedByEmployer
+		return nil, domain.ErrJobNotOwnedByEmployer
 	}
 
-	// Validate status transition
-	if !s.ValidateStatusTransition(application.Status, status) {
-		return domain.ErrInvalidApplicationStatus
-	}
+	return s.moveApplication(application, employerID, input)
+}
 
-	// Update in transaction
+// moveApplication moves an application to another pipeline stage on behalf of changedBy.
+// Callers are responsible for checking that changedBy may move the application.
+func (s *ApplicationService) moveApplication(application *domain.Application, changedBy uuid.UUID, input MoveApplicationInput) (*domain.Application, error) {
 	tx := s.db.Begin()
 	defer func() {
 		if r := recover(); r != nil {
@@ -296,18 +469,91 @@ func (s *ApplicationService) UpdateApplicationStatus(applicationID, employerID u
 		}
 	}()
 
-	appRepoTx := repository.NewApplicationRepository(tx)
-	if err := appRepoTx.UpdateStatus(applicationID, status, employerID); err != nil {
+	move, err := s.applyMove(tx, application, changedBy, input)
+	if err != nil {
 		tx.Rollback()
-		return err
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+
+	return s.afterMove(application, changedBy, move)
+}
+
+// stageMove describes a move of an application between pipeline stages
+type stageMove struct {
+	fromStatus domain.ApplicationStatus
+	fromStage  string
+	toStatus   domain.ApplicationStatus
+	toStage    string
+}
+
+// applyMove moves an application to another pipeline stage in tx, so callers can change related
+// records in the same transaction. Once tx is committed, pass the move to afterMove.
+func (s *ApplicationService) applyMove(tx *gorm.DB, application *domain.Application, changedBy uuid.UUID, input MoveApplicationInput) (*stageMove, error) {
+	applicationID := application.ID
+
+	// Hired, rejected and withdrawn applications are closed
+	if application.IsInFinalStage() {
+		return nil, domain.ErrApplicationClosed
+	}
+
+	pipeline, err := s.getJobPipeline(&application.Job)
+	if err != nil {
+		return nil, err
+	}
+
+	// Resolve target stage
+	fromStatus := application.Status
+	fromStage := pipeline.StageName(application)
+	toStatus := input.Status
+	toStageID := application.StageID
+	var toStage string
+
+	switch {
+	case input.StageID != nil:
+		stage := pipeline.StageByID(*input.StageID)
+		if stage == nil {
+			return nil, domain.ErrPipelineStageNotFound
+		}
+		toStatus = stage.Status
+		toStageID = persistedStageID(stage)
+		toStage = stage.Name
+	case input.Status == domain.ApplicationStatusRejected:
+		// Rejected applications keep their stage so it is visible where they dropped out
+		toStage = domain.DefaultStageName(domain.ApplicationStatusRejected)
+	default:
+		stage := pipeline.StageForStatus(input.Status)
+		if stage == nil {
+			return nil, domain.ErrInvalidApplicationStatus
+		}
+		toStageID = persistedStageID(stage)
+		toStage = stage.Name
+	}
+
+	if toStatus == fromStatus && toStage == fromStage {
+		return nil, domain.ErrApplicationAlreadyInStage
+	}
+
+	// Stages can be reordered, but hiring goes through the offer stage, where AcceptOffer hires
+	if toStatus == domain.ApplicationStatusHired && fromStatus != domain.ApplicationStatusOffered {
+		return nil, domain.ErrHireRequiresOffer
+	}
+
+	appRepoTx := repository.NewApplicationRepository(tx)
+	if err := appRepoTx.UpdateStage(applicationID, toStageID, toStatus, changedBy); err != nil {
+		return nil, err
 	}
 
 	// Update rejection reason if status is rejected
-	if status == domain.ApplicationStatusRejected && reason != "" {
-		application.RejectionReason = reason
+	if toStatus == domain.ApplicationStatusRejected && input.Reason != "" {
+		application.RejectionReason = input.Reason
+		application.Status = toStatus
+		application.StageID = toStageID
 		if err := appRepoTx.Update(application); err != nil {
-			tx.Rollback()
-			return err
+			return nil, err
 		}
 	}
 
@@ -315,25 +561,52 @@ func (s *ApplicationService) UpdateApplicationStatus(applicationID, employerID u
 	history := &domain.ApplicationStatusHistory{
 		ID:            uuid.New(),
 		ApplicationID: applicationID,
-		FromStatus:    &application.Status,
-		ToStatus:      status,
-		ChangedBy:     &employerID,
-		Notes:         reason,
+		FromStatus:    &fromStatus,
+		ToStatus:      toStatus,
+		FromStage:     fromStage,
+		ToStage:       toStage,
+		ToStageID:     toStageID,
+		ChangedBy:     &changedBy,
+		Notes:         input.Reason,
 		CreatedAt:     time.Now(),
 	}
 
 	historyRepoTx := repository.NewApplicationStatusHistoryRepository(tx)
 	if err := historyRepoTx.Create(history); err != nil {
-		tx.Rollback()
-		return err
+		return nil, err
 	}
 
-	if err := tx.Commit().Error; err != nil {
-		return err
+	// Offers still waiting for an answer lapse once the application leaves the offer stage.
+	// Accepted and declined offers were answered before the move.
+	if fromStatus == domain.ApplicationStatusOffered && toStatus != domain.ApplicationStatusOffered {
+		if err := repository.NewOfferRepository(tx).WithdrawOpen(applicationID); err != nil {
+			return nil, err
+		}
 	}
 
-	// Send notification to applicant about status change (async)
-	if s.notificationService != nil {
+	return &stageMove{
+		fromStatus: fromStatus,
+		fromStage:  fromStage,
+		toStatus:   toStatus,
+		toStage:    toStage,
+	}, nil
+}
+
+// afterMove closes a filled job and notifies the applicant and webhooks about a committed move.
+// It returns the application as stored after the move.
+func (s *ApplicationService) afterMove(application *domain.Application, changedBy uuid.UUID, move *stageMove) (*domain.Application, error) {
+	applicationID := application.ID
+	fromStatus, toStatus := move.fromStatus, move.toStatus
+
+	// The job closes once all its openings are filled
+	if s.jobService != nil && toStatus == domain.ApplicationStatusHired && toStatus != fromStatus {
+		if _, err := s.jobService.CloseIfFilled(context.Background(), application.JobID); err != nil {
+			log.Printf("Failed to close filled job %s: %v", application.JobID, err)
+		}
+	}
+
+	// Notify the applicant when the status category changes; custom stage names stay internal (async)
+	if s.notificationService != nil && toStatus != fromStatus && changedBy != application.ApplicantID {
 		go func() {
 			_ = s.notificationService.NotifyApplicationStatusChange(
 				context.Background(),
@@ -341,28 +614,165 @@ func (s *ApplicationService) UpdateApplicationStatus(applicationID, employerID u
 				application.JobID,
 				applicationID,
 				application.Job.Title,
-				string(status),
+				string(toStatus),
 			)
 		}()
 	}
 
-	return nil
+	// Push the update to the applicant when the status category changes
+	if toStatus != fromStatus && changedBy != application.ApplicantID {
+		s.publishStatusChange(application.ApplicantID, application, fromStatus, toStatus)
+	}
+
+	updated, err := s.applicationRepo.GetByID(applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.webhookService != nil {
+		s.webhookService.ApplicationStatusChanged(updated, fromStatus, move.fromStage, move.toStage)
+	}
+
+	return updated, nil
 }
 
-// AddEmployerNotes adds notes to an application
-func (s *ApplicationService) AddEmployerNotes(applicationID, employerID uuid.UUID, notes string) error {
-	// Get application
-	application, err := s.applicationRepo.GetByID(applicationID)
+// dispatchWithdrawal sends application.status_changed for an application the applicant withdrew
+func (s *ApplicationService) dispatchWithdrawal(application *domain.Application) {
+	fromStatus := application.Status
+	fromStage := domain.DefaultStageName(fromStatus)
+	if pipeline, err := s.getJobPipeline(&application.Job); err == nil {
+		fromStage = pipeline.StageName(application)
+	}
+
+	withdrawn := *application
+	withdrawn.Status = domain.ApplicationStatusWithdrawn
+	s.webhookService.ApplicationStatusChanged(&withdrawn, fromStatus, fromStage, domain.DefaultStageName(domain.ApplicationStatusWithdrawn))
+}
+
+// MaxBulkMoveApplications is the maximum number of applications moved in one bulk request
+const MaxBulkMoveApplications = 100
+
+// BulkMoveFailure describes an application that could not be moved
+type BulkMoveFailure struct {
+	ApplicationID uuid.UUID
+	Err           error
+}
+
+// BulkMoveResult represents the outcome of a bulk move
+type BulkMoveResult struct {
+	Moved  []uuid.UUID
+	Failed []BulkMoveFailure
+}
+
+// BulkMoveApplications moves several applications to the same stage. Applications are moved
+// independently, so one failing (e.g. already rejected) does not block the others.
+func (s *ApplicationService) BulkMoveApplications(employerID uuid.UUID, applicationIDs []uuid.UUID, input MoveApplicationInput) (*BulkMoveResult, error) {
+	if len(applicationIDs) > MaxBulkMoveApplications {
+		return nil, domain.ErrTooManyApplications
+	}
+
+	result := &BulkMoveResult{
+		Moved:  []uuid.UUID{},
+		Failed: []BulkMoveFailure{},
+	}
+	seen := make(map[uuid.UUID]bool, len(applicationIDs))
+	for _, id := range applicationIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		if _, err := s.MoveApplication(id, employerID, input); err != nil {
+			result.Failed = append(result.Failed, BulkMoveFailure{ApplicationID: id, Err: err})
+			continue
+		}
+		result.Moved = append(result.Moved, id)
+	}
+
+	return result, nil
+}
+
+// PipelineStageCount represents the number of applications of a job in a pipeline stage
+type PipelineStageCount struct {
+	Stage domain.PipelineStage
+	Count int64
+}
+
+// GetJobPipeline retrieves a job's pipeline stages with application counts.
+// Rejected and withdrawn applications are counted under their own trailing entries.
+func (s *ApplicationService) GetJobPipeline(jobID, employerID uuid.UUID) ([]PipelineStageCount, error) {
+	job, err := s.jobRepo.GetByID(jobID)
 	if err != nil {
-		return domain.ErrApplicationNotFound
+		return nil, domain.ErrJobNotFound
+	}
+	if job.EmployerID != employerID {
+		return nil, domain.ErrJobNotOwnedByEmployer
 	}
 
-	// Verify job ownership
-	if application.Job.EmployerID != employerID {
-		return domain.ErrJobNotOwnedByEmployer
+	pipeline, err := s.getJobPipeline(job)
+	if err != nil {
+		return nil, err
 	}
 
-	return s.applicationRepo.UpdateEmployerNotes(applicationID, notes)
+	applications, err := s.applicationRepo.GetJobApplicationStages(jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make([]PipelineStageCount, 0, len(pipeline.Stages)+2)
+	for _, stage := range pipeline.Stages {
+		counts = append(counts, PipelineStageCount{Stage: stage})
+	}
+	rejected := PipelineStageCount{Stage: domain.PipelineStage{
+		Name:     domain.DefaultStageName(domain.ApplicationStatusRejected),
+		Status:   domain.ApplicationStatusRejected,
+		Position: len(pipeline.Stages),
+	}}
+	withdrawn := PipelineStageCount{Stage: domain.PipelineStage{
+		Name:     domain.DefaultStageName(domain.ApplicationStatusWithdrawn),
+		Status:   domain.ApplicationStatusWithdrawn,
+		Position: len(pipeline.Stages) + 1,
+	}}
+
+	for i := range applications {
+		switch applications[i].Status {
+		case domain.ApplicationStatusRejected:
+			rejected.Count++
+			continue
+		case domain.ApplicationStatusWithdrawn:
+			withdrawn.Count++
+			continue
+		}
+		stage := pipeline.CurrentStage(&applications[i])
+		if stage == nil {
+			continue
+		}
+		for j := range counts {
+			if counts[j].Stage.Name == stage.Name {
+				counts[j].Count++
+				break
+			}
+		}
+	}
+
+	return append(counts, rejected, withdrawn), nil
+}
+
+// getJobPipeline resolves the pipeline used for a job's applications
+func (s *ApplicationService) getJobPipeline(job *domain.Job) (*domain.Pipeline, error) {
+	if s.pipelineService == nil {
+		return domain.DefaultPipeline(job.CompanyID), nil
+	}
+	return s.pipelineService.GetJobPipeline(job)
+}
+
+// persistedStageID returns the ID of a stored pipeline stage, or nil for default pipeline stages
+func persistedStageID(stage *domain.PipelineStage) *uuid.UUID {
+	if stage == nil || stage.ID == uuid.Nil {
+		return nil
+	}
+	id := stage.ID
+	return &id
 }
 
 // RateApplicant rates an applicant
@@ -416,46 +826,6 @@ func (s *ApplicationService) CanApply(applicantID, jobID uuid.UUID) (bool, strin
 	return true, "", nil
 }
 
-// ValidateStatusTransition validates if a status transition is allowed
-func (s *ApplicationService) ValidateStatusTransition(current, new domain.ApplicationStatus) bool {
-	// Define valid transitions
-	validTransitions := map[domain.ApplicationStatus][]domain.ApplicationStatus{
-		domain.ApplicationStatusSubmitted: {
-			domain.ApplicationStatusReviewed,
-			domain.ApplicationStatusRejected,
-		},
-		domain.ApplicationStatusReviewed: {
-			domain.ApplicationStatusShortlisted,
-			domain.ApplicationStatusRejected,
-		},
-		domain.ApplicationStatusShortlisted: {
-			domain.ApplicationStatusInterview,
-			domain.ApplicationStatusRejected,
-		},
-		domain.ApplicationStatusInterview: {
-			domain.ApplicationStatusOffered,
-			domain.ApplicationStatusRejected,
-		},
-		domain.ApplicationStatusOffered: {
-			domain.ApplicationStatusHired,
-			domain.ApplicationStatusRejected,
-		},
-	}
-
-	allowedStatuses, exists := validTransitions[current]
-	if !exists {
-		return false
-	}
-
-	for _, allowed := range allowedStatuses {
-		if allowed == new {
-			return true
-		}
-	}
-
-	return false
-}
-
 // GetApplicationStatusHistory retrieves status history for an application
 func (s *ApplicationService) GetApplicationStatusHistory(applicationID uuid.UUID) ([]domain.ApplicationStatusHistory, error) {
 	return s.applicationHistoryRepo.GetByApplicationID(applicationID)
@@ -163,6 +163,23 @@ func (s *CompanyService) UpdateCompany(companyID uuid.UUID, req *domain.Company)
 	return company, nil
 }
 
+// SetRequireJobApproval turns company approval of recruiter job postings on or off
+func (s *CompanyService) SetRequireJobApproval(companyID uuid.UUID, required bool) (*domain.Company, error) {
+	company, err := s.companyRepo.GetByID(companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	company.RequireJobApproval = required
+	company.UpdatedAt = time.Now()
+
+	if err := s.companyRepo.Update(company); err != nil {
+		return nil, err
+	}
+
+	return company, nil
+}
+
 // DeleteCompany soft deletes a company
 func (s *CompanyService) DeleteCompany(companyID uuid.UUID) error {
 	company, err := s.companyRepo.GetByID(companyID)
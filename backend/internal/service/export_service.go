@@ -0,0 +1,401 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"job-platform/internal/domain"
+	"job-platform/internal/repository"
+	"job-platform/internal/util/spreadsheet"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// exportBatchSize is how many records an export loads at a time
+	exportBatchSize = 500
+	// exportTimeLayout formats timestamps in exports
+	exportTimeLayout = "2006-01-02 15:04"
+	// exportDateLayout formats dates in exports
+	exportDateLayout = "2006-01-02"
+)
+
+// exportStatusTimings are the statuses whose first time reached is exported for each application
+var exportStatusTimings = []domain.ApplicationStatus{
+	domain.ApplicationStatusReviewed,
+	domain.ApplicationStatusShortlisted,
+	domain.ApplicationStatusInterview,
+	domain.ApplicationStatusOffered,
+	domain.ApplicationStatusHired,
+	domain.ApplicationStatusRejected,
+	domain.ApplicationStatusWithdrawn,
+}
+
+// Export is a spreadsheet export. Its rows are loaded in batches while it is written, so large
+// exports are streamed instead of being built in memory.
+type Export struct {
+	Filename string // Without extension
+	header   []string
+	rows     func(write func([]string) error) error
+}
+
+// Write writes the header and rows of the export and finishes the file
+func (e *Export) Write(w spreadsheet.Writer) error {
+	if err := w.WriteRow(e.header); err != nil {
+		return err
+	}
+	if err := e.rows(w.WriteRow); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+// ExportService exports applications and saved candidates to CSV and XLSX
+type ExportService struct {
+	applicationRepo    *repository.ApplicationRepository
+	savedCandidateRepo *repository.SavedCandidateRepository
+	jobRepo            *repository.JobRepository
+}
+
+// NewExportService creates a new export service
+func NewExportService(
+	applicationRepo *repository.ApplicationRepository,
+	savedCandidateRepo *repository.SavedCandidateRepository,
+	jobRepo *repository.JobRepository,
+) *ExportService {
+	return &ExportService{
+		applicationRepo:    applicationRepo,
+		savedCandidateRepo: savedCandidateRepo,
+		jobRepo:            jobRepo,
+	}
+}
+
+// ExportJobApplications exports the applications of an employer's job, optionally filtered by
+// status. Every screening question of the job gets its own column.
+func (s *ExportService) ExportJobApplications(jobID, employerID uuid.UUID, status string) (*Export, error) {
+	job, err := s.jobRepo.GetByID(jobID)
+	if err != nil {
+		return nil, domain.ErrJobNotFound
+	}
+	if job.EmployerID != employerID {
+		return nil, domain.ErrJobNotOwnedByEmployer
+	}
+
+	header := applicationExportHeader(false)
+	for _, q := range job.ScreeningQuestions {
+		header = append(header, q.Question)
+	}
+
+	filters := repository.ApplicationExportFilters{JobID: &job.ID, Status: status}
+	return &Export{
+		Filename: fmt.Sprintf("applications-%s-%s", job.Slug, time.Now().Format(exportDateLayout)),
+		header:   header,
+		rows: s.applicationRows(filters, func(app *domain.Application) []string {
+			// Answers are matched by question ID, or by question text for questions edited since
+			byID := make(map[uuid.UUID]string)
+			byQuestion := make(map[string]string)
+			for _, answer := range parseScreeningAnswers(app) {
+				value := formatAnswerValue(answer.Value)
+				byID[answer.QuestionID] = value
+				byQuestion[answer.Question] = value
+			}
+
+			row := applicationExportRow(app, false)
+			for _, q := range job.ScreeningQuestions {
+				value, ok := byID[q.ID]
+				if !ok {
+					value = byQuestion[q.Question]
+				}
+				row = append(row, value)
+			}
+			return row
+		}),
+	}, nil
+}
+
+// ExportCompanyApplications exports the applications to all jobs of a company, optionally filtered
+// by status. Screening answers of the different jobs are combined in one column.
+func (s *ExportService) ExportCompanyApplications(companyID uuid.UUID, status string) (*Export, error) {
+	header := append(applicationExportHeader(true), "Screening Answers")
+
+	filters := repository.ApplicationExportFilters{CompanyID: &companyID, Status: status}
+	return &Export{
+		Filename: "applications-" + time.Now().Format(exportDateLayout),
+		header:   header,
+		rows: s.applicationRows(filters, func(app *domain.Application) []string {
+			var answers []string
+			for _, answer := range parseScreeningAnswers(app) {
+				answers = append(answers, answer.Question+": "+formatAnswerValue(answer.Value))
+			}
+			return append(applicationExportRow(app, true), strings.Join(answers, "\n"))
+		}),
+	}, nil
+}
+
+// ExportSavedCandidates exports an employer's saved candidates. A nil folder exports every folder,
+// an empty folder the candidates without one. Email addresses and phone numbers are only included
+// when the candidate's profile shows them.
+func (s *ExportService) ExportSavedCandidates(employerID uuid.UUID, folder *string) (*Export, error) {
+	filename := "saved-candidates-" + time.Now().Format(exportDateLayout)
+	if folder != nil && *folder != "" {
+		filename = "saved-candidates-" + exportFilenamePart(*folder) + "-" + time.Now().Format(exportDateLayout)
+	}
+
+	header := []string{
+		"Candidate ID", "First Name", "Last Name", "Email", "Phone", "Headline", "Current Title",
+		"Current Company", "Location", "Years of Experience", "Open to Opportunities", "LinkedIn",
+		"Portfolio", "Folder", "Notes", "Saved At",
+	}
+
+	return &Export{
+		Filename: filename,
+		header:   header,
+		rows: func(write func([]string) error) error {
+			for offset := 0; ; offset += exportBatchSize {
+				saved, err := s.savedCandidateRepo.GetForExport(employerID, folder, exportBatchSize, offset)
+				if err != nil {
+					return err
+				}
+				for i := range saved {
+					if err := write(savedCandidateExportRow(&saved[i])); err != nil {
+						return err
+					}
+				}
+				if len(saved) < exportBatchSize {
+					return nil
+				}
+			}
+		},
+	}, nil
+}
+
+// applicationRows returns the row source of an application export, loading applications in batches
+func (s *ExportService) applicationRows(filters repository.ApplicationExportFilters, toRow func(*domain.Application) []string) func(func([]string) error) error {
+	return func(write func([]string) error) error {
+		for offset := 0; ; offset += exportBatchSize {
+			applications, err := s.applicationRepo.GetForExport(filters, exportBatchSize, offset)
+			if err != nil {
+				return err
+			}
+			for i := range applications {
+				if err := write(toRow(&applications[i])); err != nil {
+					return err
+				}
+			}
+			if len(applications) < exportBatchSize {
+				return nil
+			}
+		}
+	}
+}
+
+// applicationExportHeader returns the columns every application export has
+func applicationExportHeader(includeJob bool) []string {
+	header := []string{"Application ID"}
+	if includeJob {
+		header = append(header, "Job ID", "Job Title", "Requisition ID")
+	}
+	header = append(header,
+		"First Name", "Last Name", "Email", "Phone", "Location", "Status", "Stage", "Rating",
+		"Flagged", "Flag Reason", "Rejection Reason", "Expected Salary", "Available From", "Applied At",
+	)
+	for _, status := range exportStatusTimings {
+		header = append(header, domain.DefaultStageName(status)+" At")
+	}
+	return append(header, "Days to Current Status", "Days in Current Status")
+}
+
+// applicationExportRow returns the values of the columns of applicationExportHeader. Email
+// addresses and phone numbers are only included when the applicant's profile shows them.
+func applicationExportRow(app *domain.Application, includeJob bool) []string {
+	row := []string{app.ID.String()}
+	if includeJob {
+		row = append(row, app.JobID.String(), app.Job.Title, app.Job.RequisitionID)
+	}
+
+	email, phone, location := "", "", ""
+	if profile := app.Applicant.Profile; profile != nil {
+		if profile.ShowEmail {
+			email = app.Applicant.Email
+		}
+		if profile.ShowPhone {
+			phone = stringValue(profile.Phone)
+		}
+		location = profileLocation(profile)
+	}
+
+	stage := domain.DefaultStageName(app.Status)
+	if app.Stage != nil {
+		stage = app.Stage.Name
+	}
+
+	row = append(row,
+		app.Applicant.FirstName,
+		app.Applicant.LastName,
+		email,
+		phone,
+		location,
+		string(app.Status),
+		stage,
+		intValue(app.Rating),
+		yesNo(app.IsFlagged),
+		app.FlagReason,
+		app.RejectionReason,
+		intValue(app.ExpectedSalary),
+		formatExportTime(app.AvailableFrom, exportDateLayout),
+		app.AppliedAt.UTC().Format(exportTimeLayout),
+	)
+
+	// First time each status was reached
+	reached := make(map[domain.ApplicationStatus]time.Time)
+	for _, h := range app.StatusHistory {
+		if _, ok := reached[h.ToStatus]; !ok {
+			reached[h.ToStatus] = h.CreatedAt
+		}
+	}
+	for _, status := range exportStatusTimings {
+		if t, ok := reached[status]; ok {
+			row = append(row, t.UTC().Format(exportTimeLayout))
+		} else {
+			row = append(row, "")
+		}
+	}
+
+	statusSince := app.AppliedAt
+	daysToStatus := ""
+	if app.StatusUpdatedAt != nil {
+		statusSince = *app.StatusUpdatedAt
+		daysToStatus = strconv.Itoa(daysBetween(app.AppliedAt, *app.StatusUpdatedAt))
+	}
+	return append(row, daysToStatus, strconv.Itoa(daysBetween(statusSince, time.Now())))
+}
+
+// savedCandidateExportRow returns the values of a saved candidate export row
+func savedCandidateExportRow(saved *domain.SavedCandidate) []string {
+	row := []string{saved.CandidateID.String()}
+
+	candidate := saved.Candidate
+	if candidate == nil {
+		candidate = &domain.User{}
+	}
+	row = append(row, candidate.FirstName, candidate.LastName)
+
+	profile := candidate.Profile
+	if profile == nil {
+		row = append(row, make([]string, 10)...)
+	} else {
+		email, phone := "", ""
+		if profile.ShowEmail {
+			email = candidate.Email
+		}
+		if profile.ShowPhone {
+			phone = stringValue(profile.Phone)
+		}
+		experience := ""
+		if profile.TotalExperienceYears != nil {
+			experience = strconv.FormatFloat(float64(*profile.TotalExperienceYears), 'f', -1, 32)
+		}
+		row = append(row,
+			email,
+			phone,
+			stringValue(profile.Headline),
+			stringValue(profile.CurrentTitle),
+			stringValue(profile.CurrentCompany),
+			profileLocation(profile),
+			experience,
+			yesNo(profile.OpenToOpportunities),
+			stringValue(profile.LinkedInURL),
+			stringValue(profile.PortfolioURL),
+		)
+	}
+
+	return append(row, stringValue(saved.Folder), stringValue(saved.Notes), saved.CreatedAt.UTC().Format(exportTimeLayout))
+}
+
+// parseScreeningAnswers decodes the screening answers of an application
+func parseScreeningAnswers(app *domain.Application) []domain.ScreeningAnswer {
+	var answers []domain.ScreeningAnswer
+	if len(app.Answers) > 0 {
+		_ = json.Unmarshal(app.Answers, &answers)
+	}
+	return answers
+}
+
+// formatAnswerValue formats a screening answer for a spreadsheet cell
+func formatAnswerValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case bool:
+		return yesNo(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []interface{}:
+		parts := make([]string, len(v))
+		for i, item := range v {
+			parts[i] = formatAnswerValue(item)
+		}
+		return strings.Join(parts, ", ")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// profileLocation joins the city, state and country of a profile
+func profileLocation(profile *domain.UserProfile) string {
+	var parts []string
+	for _, part := range []*string{profile.City, profile.State, profile.Country} {
+		if part != nil && *part != "" {
+			parts = append(parts, *part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// exportFilenamePart reduces text to characters that are safe in a download file name
+func exportFilenamePart(text string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case b.Len() > 0 && !strings.HasSuffix(b.String(), "-"):
+			b.WriteByte('-')
+		}
+	}
+	return strings.Trim(b.String(), "-")
+}
+
+// formatExportTime formats an optional time, empty when not set
+func formatExportTime(t *time.Time, layout string) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(layout)
+}
+
+// stringValue returns an optional string, empty when not set
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// intValue formats an optional number, empty when not set
+func intValue(n *int) string {
+	if n == nil {
+		return ""
+	}
+	return strconv.Itoa(*n)
+}
+
+// yesNo formats a flag for a spreadsheet cell
+func yesNo(b bool) string {
+	if b {
+		return "Yes"
+	}
+	return "No"
+}
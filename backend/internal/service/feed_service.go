@@ -0,0 +1,555 @@
+package service
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"job-platform/internal/domain"
+	"job-platform/internal/repository"
+	"strings"
+	"time"
+)
+
+// FeedFormat is the output format of a job feed
+type FeedFormat string
+
+const (
+	FeedFormatIndeed   FeedFormat = "indeed"   // Indeed XML
+	FeedFormatLinkedIn FeedFormat = "linkedin" // LinkedIn Limited Listings XML
+	FeedFormatRSS      FeedFormat = "rss"      // RSS 2.0
+	FeedFormatAtom     FeedFormat = "atom"     // Atom 1.0
+)
+
+const (
+	// feedBatchSize is how many jobs are loaded per query while a feed is written
+	feedBatchSize = 250
+	// DefaultFeedItems is the number of jobs in RSS and Atom feeds when no limit is given
+	DefaultFeedItems = 100
+	// MaxFeedItems is the maximum number of jobs in RSS and Atom feeds
+	MaxFeedItems = 1000
+)
+
+// ContentType returns the HTTP content type of the feed format
+func (f FeedFormat) ContentType() string {
+	switch f {
+	case FeedFormatRSS:
+		return "application/rss+xml; charset=utf-8"
+	case FeedFormatAtom:
+		return "application/atom+xml; charset=utf-8"
+	default:
+		return "application/xml; charset=utf-8"
+	}
+}
+
+// IsValid checks if the feed format is supported
+func (f FeedFormat) IsValid() bool {
+	switch f {
+	case FeedFormatIndeed, FeedFormatLinkedIn, FeedFormatRSS, FeedFormatAtom:
+		return true
+	}
+	return false
+}
+
+// IsSyndication checks if the format is a reader feed (RSS/Atom) rather than a full aggregator export
+func (f FeedFormat) IsSyndication() bool {
+	return f == FeedFormatRSS || f == FeedFormatAtom
+}
+
+// FeedConfig holds configuration for job feeds
+type FeedConfig struct {
+	PublisherName string
+	FrontendURL   string
+}
+
+// FeedService renders active jobs as XML feeds for job aggregators and feed readers
+type FeedService struct {
+	jobRepo      *repository.JobRepository
+	categoryRepo *repository.JobCategoryRepository
+	companyRepo  *repository.CompanyRepository
+	config       *FeedConfig
+}
+
+// NewFeedService creates a new feed service
+func NewFeedService(
+	jobRepo *repository.JobRepository,
+	categoryRepo *repository.JobCategoryRepository,
+	companyRepo *repository.CompanyRepository,
+	config *FeedConfig,
+) *FeedService {
+	config.FrontendURL = strings.TrimRight(config.FrontendURL, "/")
+	return &FeedService{
+		jobRepo:      jobRepo,
+		categoryRepo: categoryRepo,
+		companyRepo:  companyRepo,
+		config:       config,
+	}
+}
+
+// IsCacheable checks if a rendered feed may be cached. Only feeds of existing categories and
+// companies with the default number of jobs are, so arbitrary query values can't fill the cache.
+func (s *FeedService) IsCacheable(format FeedFormat, filters repository.FeedFilters) bool {
+	if filters.Location != "" {
+		return false
+	}
+	if format.IsSyndication() && filters.Limit != DefaultFeedItems {
+		return false
+	}
+	if filters.CategorySlug != "" {
+		if _, err := s.categoryRepo.GetBySlug(filters.CategorySlug); err != nil {
+			return false
+		}
+	}
+	if filters.CompanySlug != "" {
+		if _, err := s.companyRepo.GetBySlug(filters.CompanySlug); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// WriteFeed streams a feed of active jobs to w. Jobs are loaded and written in batches,
+// so the size of the feed doesn't depend on memory. selfURL is the public URL of the feed.
+func (s *FeedService) WriteFeed(w io.Writer, format FeedFormat, filters repository.FeedFilters, selfURL string) error {
+	if !format.IsValid() {
+		return domain.ErrInvalidFeedFormat
+	}
+
+	// LinkedIn wants the job count up front. Count before writing so a failure can still be reported.
+	var count int64
+	if format == FeedFormatLinkedIn {
+		var err error
+		if count, err = s.jobRepo.CountFeedJobs(filters); err != nil {
+			return err
+		}
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+
+	var root xml.StartElement
+	var header []interface{}
+	now := time.Now().UTC()
+
+	switch format {
+	case FeedFormatIndeed:
+		root = xml.StartElement{Name: xml.Name{Local: "source"}}
+		header = []interface{}{
+			xmlElement{"publisher", s.config.PublisherName},
+			xmlElement{"publisherurl", s.config.FrontendURL},
+			xmlElement{"lastBuildDate", now.Format(time.RFC1123)},
+		}
+	case FeedFormatLinkedIn:
+		root = xml.StartElement{Name: xml.Name{Local: "source"}}
+		header = []interface{}{
+			xmlElement{"lastBuildDate", now.Format(time.RFC1123)},
+			xmlElement{"publisherUrl", s.config.FrontendURL},
+			xmlElement{"publisher", s.config.PublisherName},
+			xmlElement{"expectedJobCount", fmt.Sprint(count)},
+		}
+	case FeedFormatRSS:
+		if err := enc.EncodeToken(xml.StartElement{
+			Name: xml.Name{Local: "rss"},
+			Attr: []xml.Attr{
+				{Name: xml.Name{Local: "version"}, Value: "2.0"},
+				{Name: xml.Name{Local: "xmlns:atom"}, Value: "http://www.w3.org/2005/Atom"},
+			},
+		}); err != nil {
+			return err
+		}
+		root = xml.StartElement{Name: xml.Name{Local: "channel"}}
+		header = []interface{}{
+			xmlElement{"title", s.feedTitle(filters)},
+			xmlElement{"link", s.config.FrontendURL + "/jobs"},
+			xmlElement{"description", "Latest jobs on " + s.config.PublisherName},
+			xmlElement{"language", "en"},
+			xmlElement{"lastBuildDate", now.Format(time.RFC1123Z)},
+			rssAtomLink{Href: selfURL, Rel: "self", Type: "application/rss+xml"},
+		}
+	case FeedFormatAtom:
+		root = xml.StartElement{
+			Name: xml.Name{Local: "feed"},
+			Attr: []xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: "http://www.w3.org/2005/Atom"}},
+		}
+		header = []interface{}{
+			xmlElement{"title", s.feedTitle(filters)},
+			xmlElement{"id", selfURL},
+			xmlElement{"updated", now.Format(time.RFC3339)},
+			atomLink{Href: selfURL, Rel: "self"},
+			atomLink{Href: s.config.FrontendURL + "/jobs", Rel: "alternate"},
+		}
+	}
+
+	if err := enc.EncodeToken(root); err != nil {
+		return err
+	}
+	for _, element := range header {
+		if err := enc.Encode(element); err != nil {
+			return err
+		}
+	}
+
+	err := s.jobRepo.StreamFeedJobs(filters, feedBatchSize, func(jobs []domain.Job) error {
+		for i := range jobs {
+			if err := enc.Encode(s.feedItem(format, &jobs[i])); err != nil {
+				return err
+			}
+		}
+		return enc.Flush()
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := enc.EncodeToken(root.End()); err != nil {
+		return err
+	}
+	if format == FeedFormatRSS {
+		if err := enc.EncodeToken(xml.EndElement{Name: xml.Name{Local: "rss"}}); err != nil {
+			return err
+		}
+	}
+	return enc.Flush()
+}
+
+// feedTitle describes the jobs in an RSS or Atom feed
+func (s *FeedService) feedTitle(filters repository.FeedFilters) string {
+	title := s.config.PublisherName + " jobs"
+	var parts []string
+	if filters.CategorySlug != "" {
+		parts = append(parts, "category "+filters.CategorySlug)
+	}
+	if filters.CompanySlug != "" {
+		parts = append(parts, "company "+filters.CompanySlug)
+	}
+	if filters.Location != "" {
+		parts = append(parts, "in "+filters.Location)
+	}
+	if len(parts) > 0 {
+		title += " (" + strings.Join(parts, ", ") + ")"
+	}
+	return title
+}
+
+// feedItem converts a job to the item element of a feed format
+func (s *FeedService) feedItem(format FeedFormat, job *domain.Job) interface{} {
+	jobURL := s.config.FrontendURL + "/jobs/" + job.Slug
+	posted := job.CreatedAt
+	if job.PublishedAt != nil {
+		posted = *job.PublishedAt
+	}
+
+	categories := make([]string, len(job.Categories))
+	for i, category := range job.Categories {
+		categories[i] = category.Name
+	}
+
+	switch format {
+	case FeedFormatIndeed:
+		item := indeedJob{
+			Title:           cdata{job.Title},
+			Date:            cdata{posted.UTC().Format(time.RFC1123)},
+			ReferenceNumber: cdata{job.ID.String()},
+			URL:             cdata{jobURL},
+			Company:         cdata{job.CompanyName},
+			City:            cdata{job.City},
+			State:           cdata{job.State},
+			Country:         cdata{job.Country},
+			Description:     cdata{job.Description},
+			Salary:          cdata{feedSalaryText(job)},
+			Education:       cdata{job.Education},
+			JobType:         cdata{indeedJobTypes[job.JobType]},
+			Category:        cdata{strings.Join(categories, ", ")},
+			Experience:      cdata{feedExperienceText(job)},
+		}
+		if job.ExpiresAt != nil {
+			item.ExpirationDate = &cdata{job.ExpiresAt.UTC().Format("2006-01-02")}
+		}
+		switch job.WorkplaceType {
+		case domain.WorkplaceTypeRemote:
+			item.RemoteType = &cdata{"Fully remote"}
+		case domain.WorkplaceTypeHybrid:
+			item.RemoteType = &cdata{"Hybrid remote"}
+		}
+		return item
+
+	case FeedFormatLinkedIn:
+		applyURL := job.ApplicationURL
+		if applyURL == "" {
+			applyURL = jobURL
+		}
+		item := linkedInJob{
+			PartnerJobID:    cdata{job.ID.String()},
+			Company:         cdata{job.CompanyName},
+			Title:           cdata{job.Title},
+			Description:     cdata{job.Description},
+			ApplyURL:        cdata{applyURL},
+			Location:        cdata{job.Location},
+			City:            cdata{job.City},
+			State:           cdata{job.State},
+			Country:         cdata{job.Country},
+			JobType:         cdata{linkedInJobTypes[job.JobType]},
+			ExperienceLevel: cdata{linkedInExperienceLevels[job.ExperienceLevel]},
+			WorkplaceTypes:  cdata{linkedInWorkplaceTypes[job.WorkplaceType]},
+		}
+		if !job.HideSalary && job.SalaryMin != nil && job.SalaryMax != nil {
+			item.Salaries = &linkedInSalaries{Salary: linkedInSalary{
+				HighEnd: linkedInAmount{Amount: *job.SalaryMax, CurrencyCode: job.SalaryCurrency},
+				LowEnd:  linkedInAmount{Amount: *job.SalaryMin, CurrencyCode: job.SalaryCurrency},
+				Period:  strings.ToUpper(job.SalaryPeriod),
+				Type:    "BASE_SALARY",
+			}}
+		}
+		return item
+
+	case FeedFormatRSS:
+		return rssItem{
+			Title:       job.Title + " at " + job.CompanyName,
+			Link:        jobURL,
+			GUID:        rssGUID{Value: jobURL, IsPermaLink: "true"},
+			PubDate:     posted.UTC().Format(time.RFC1123Z),
+			Description: cdata{feedSummary(job)},
+			Categories:  categories,
+		}
+
+	default:
+		atomCategories := make([]atomCategory, len(categories))
+		for i, category := range categories {
+			atomCategories[i] = atomCategory{Term: category}
+		}
+		return atomEntry{
+			Title:      job.Title + " at " + job.CompanyName,
+			ID:         "urn:uuid:" + job.ID.String(),
+			Link:       atomLink{Href: jobURL, Rel: "alternate"},
+			Published:  posted.UTC().Format(time.RFC3339),
+			Updated:    job.UpdatedAt.UTC().Format(time.RFC3339),
+			Author:     atomAuthor{Name: job.CompanyName},
+			Summary:    feedSummary(job),
+			Categories: atomCategories,
+		}
+	}
+}
+
+// ============================================================
+// FEED HELPERS
+// ============================================================
+
+// indeedJobTypes maps job types to Indeed job types
+var indeedJobTypes = map[domain.JobType]string{
+	domain.JobTypeFullTime:   "fulltime",
+	domain.JobTypePartTime:   "parttime",
+	domain.JobTypeContract:   "contract",
+	domain.JobTypeFreelance:  "contract",
+	domain.JobTypeInternship: "internship",
+}
+
+// linkedInJobTypes maps job types to LinkedIn job types
+var linkedInJobTypes = map[domain.JobType]string{
+	domain.JobTypeFullTime:   "FULL_TIME",
+	domain.JobTypePartTime:   "PART_TIME",
+	domain.JobTypeContract:   "CONTRACT",
+	domain.JobTypeFreelance:  "CONTRACT",
+	domain.JobTypeInternship: "INTERNSHIP",
+}
+
+// linkedInExperienceLevels maps experience levels to LinkedIn experience levels
+var linkedInExperienceLevels = map[domain.ExperienceLevel]string{
+	domain.ExperienceLevelEntry:     "ENTRY_LEVEL",
+	domain.ExperienceLevelMid:       "MID_SENIOR_LEVEL",
+	domain.ExperienceLevelSenior:    "MID_SENIOR_LEVEL",
+	domain.ExperienceLevelLead:      "DIRECTOR",
+	domain.ExperienceLevelExecutive: "EXECUTIVE",
+}
+
+// linkedInWorkplaceTypes maps workplace types to LinkedIn workplace types
+var linkedInWorkplaceTypes = map[domain.WorkplaceType]string{
+	domain.WorkplaceTypeOnsite: "On-site",
+	domain.WorkplaceTypeHybrid: "Hybrid",
+	domain.WorkplaceTypeRemote: "Remote",
+}
+
+// feedSalaryPeriods maps salary periods to the unit used in salary texts
+var feedSalaryPeriods = map[string]string{
+	"HOURLY":  "hour",
+	"DAILY":   "day",
+	"WEEKLY":  "week",
+	"MONTHLY": "month",
+	"YEARLY":  "year",
+}
+
+// feedSalaryText formats the salary of a job, e.g. "USD 50000 - 70000 per year"
+func feedSalaryText(job *domain.Job) string {
+	if job.HideSalary || (job.SalaryMin == nil && job.SalaryMax == nil) {
+		return ""
+	}
+
+	var amount string
+	switch {
+	case job.SalaryMin != nil && job.SalaryMax != nil:
+		amount = fmt.Sprintf("%d - %d", *job.SalaryMin, *job.SalaryMax)
+	case job.SalaryMin != nil:
+		amount = fmt.Sprintf("from %d", *job.SalaryMin)
+	default:
+		amount = fmt.Sprintf("up to %d", *job.SalaryMax)
+	}
+
+	period, ok := feedSalaryPeriods[strings.ToUpper(job.SalaryPeriod)]
+	if !ok {
+		period = "year"
+	}
+	return fmt.Sprintf("%s %s per %s", job.SalaryCurrency, amount, period)
+}
+
+// feedExperienceText formats the required years of experience of a job
+func feedExperienceText(job *domain.Job) string {
+	switch {
+	case job.YearsExperienceMax != nil && *job.YearsExperienceMax > job.YearsExperienceMin:
+		return fmt.Sprintf("%d-%d years", job.YearsExperienceMin, *job.YearsExperienceMax)
+	case job.YearsExperienceMin > 0:
+		return fmt.Sprintf("%d+ years", job.YearsExperienceMin)
+	}
+	return ""
+}
+
+// feedSummary returns the short description of a job, or the start of its description
+func feedSummary(job *domain.Job) string {
+	if job.ShortDescription != "" {
+		return job.ShortDescription
+	}
+	summary := strings.Join(strings.Fields(stripHTMLTagsSimple(job.Description)), " ")
+	if len(summary) > 300 {
+		summary = summary[:297] + "..."
+	}
+	return summary
+}
+
+// cdata is text written as a CDATA section
+type cdata struct {
+	Value string `xml:",cdata"`
+}
+
+// xmlElement is a simple text element
+type xmlElement struct {
+	Name  string
+	Value string
+}
+
+// MarshalXML writes the element with its name as the tag
+func (e xmlElement) MarshalXML(enc *xml.Encoder, _ xml.StartElement) error {
+	return enc.EncodeElement(e.Value, xml.StartElement{Name: xml.Name{Local: e.Name}})
+}
+
+// indeedJob is a job in the Indeed XML feed
+type indeedJob struct {
+	XMLName         xml.Name `xml:"job"`
+	Title           cdata    `xml:"title"`
+	Date            cdata    `xml:"date"`
+	ReferenceNumber cdata    `xml:"referencenumber"`
+	URL             cdata    `xml:"url"`
+	Company         cdata    `xml:"company"`
+	City            cdata    `xml:"city"`
+	State           cdata    `xml:"state"`
+	Country         cdata    `xml:"country"`
+	Description     cdata    `xml:"description"`
+	Salary          cdata    `xml:"salary"`
+	Education       cdata    `xml:"education"`
+	JobType         cdata    `xml:"jobtype"`
+	Category        cdata    `xml:"category"`
+	Experience      cdata    `xml:"experience"`
+	ExpirationDate  *cdata   `xml:"expirationdate,omitempty"`
+	RemoteType      *cdata   `xml:"remotetype,omitempty"`
+}
+
+// linkedInJob is a job in the LinkedIn XML feed
+type linkedInJob struct {
+	XMLName         xml.Name          `xml:"job"`
+	PartnerJobID    cdata             `xml:"partnerJobId"`
+	Company         cdata             `xml:"company"`
+	Title           cdata             `xml:"title"`
+	Description     cdata             `xml:"description"`
+	ApplyURL        cdata             `xml:"applyUrl"`
+	Location        cdata             `xml:"location"`
+	City            cdata             `xml:"city"`
+	State           cdata             `xml:"state"`
+	Country         cdata             `xml:"country"`
+	JobType         cdata             `xml:"jobtype"`
+	ExperienceLevel cdata             `xml:"experienceLevel"`
+	WorkplaceTypes  cdata             `xml:"workplaceTypes"`
+	Salaries        *linkedInSalaries `xml:"salaries,omitempty"`
+}
+
+// linkedInSalaries is the salary block of a LinkedIn job
+type linkedInSalaries struct {
+	Salary linkedInSalary `xml:"salary"`
+}
+
+// linkedInSalary is a salary range of a LinkedIn job
+type linkedInSalary struct {
+	HighEnd linkedInAmount `xml:"highEnd"`
+	LowEnd  linkedInAmount `xml:"lowEnd"`
+	Period  string         `xml:"period"`
+	Type    string         `xml:"type"`
+}
+
+// linkedInAmount is a salary amount of a LinkedIn job
+type linkedInAmount struct {
+	Amount       int    `xml:"amount"`
+	CurrencyCode string `xml:"currencyCode"`
+}
+
+// rssItem is an item of an RSS 2.0 feed
+type rssItem struct {
+	XMLName     xml.Name `xml:"item"`
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	GUID        rssGUID  `xml:"guid"`
+	PubDate     string   `xml:"pubDate"`
+	Description cdata    `xml:"description"`
+	Categories  []string `xml:"category"`
+}
+
+// rssGUID is the unique identifier of an RSS item
+type rssGUID struct {
+	Value       string `xml:",chardata"`
+	IsPermaLink string `xml:"isPermaLink,attr"`
+}
+
+// rssAtomLink is the atom:link self reference of an RSS feed
+type rssAtomLink struct {
+	XMLName xml.Name `xml:"atom:link"`
+	Href    string   `xml:"href,attr"`
+	Rel     string   `xml:"rel,attr"`
+	Type    string   `xml:"type,attr"`
+}
+
+// atomEntry is an entry of an Atom feed
+type atomEntry struct {
+	XMLName    xml.Name       `xml:"entry"`
+	Title      string         `xml:"title"`
+	ID         string         `xml:"id"`
+	Link       atomLink       `xml:"link"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Author     atomAuthor     `xml:"author"`
+	Summary    string         `xml:"summary"`
+	Categories []atomCategory `xml:"category"`
+}
+
+// atomLink is a link of an Atom feed or entry
+type atomLink struct {
+	XMLName xml.Name `xml:"link"`
+	Href    string   `xml:"href,attr"`
+	Rel     string   `xml:"rel,attr,omitempty"`
+}
+
+// atomAuthor is the author of an Atom entry
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+// atomCategory is a category of an Atom entry
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
@@ -3,14 +3,18 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"job-platform/internal/domain"
 	"job-platform/internal/dto"
+	"job-platform/internal/repository"
 	"log"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
 )
 
 // scrapedDataToInput converts scraped job data to AdminCreateJobInput
@@ -117,219 +121,242 @@ func stripHTMLTagsSimple(html string) string {
 	return strings.TrimSpace(result)
 }
 
-// ImportJobStatus represents the status of an import job
-type ImportJobStatus string
-
 const (
-	ImportStatusPending    ImportJobStatus = "pending"
-	ImportStatusProcessing ImportJobStatus = "processing"
-	ImportStatusCompleted  ImportJobStatus = "completed"
-	ImportStatusFailed     ImportJobStatus = "failed"
-	ImportStatusCancelled  ImportJobStatus = "cancelled"
+	// DefaultImportWorkers is the number of workers processing import jobs concurrently
+	DefaultImportWorkers = 3
+	// importPollInterval is how often idle workers check the database for new import jobs
+	importPollInterval = 3 * time.Second
+	// importJobDelay is a small delay between jobs to be respectful to the target site
+	importJobDelay = 500 * time.Millisecond
+	// importJobTimeout limits scraping and creating a single job
+	importJobTimeout = 10 * time.Minute
+	// linkExtractionTimeout limits extracting the job links of a listing page
+	linkExtractionTimeout = 5 * time.Minute
+	// importClaimLease is how long a claimed job or extraction task belongs to its worker. Longer than
+	// importJobTimeout and linkExtractionTimeout, so only work of a crashed instance is claimed again.
+	importClaimLease = 15 * time.Minute
 )
 
-// LinkExtractionTask represents a background link extraction task
-type LinkExtractionTask struct {
-	ID        string                   `json:"id"`
-	SourceURL string                   `json:"source_url"`
-	Status    ImportJobStatus          `json:"status"`
-	Links     []dto.ExtractedJobLink   `json:"links,omitempty"`
-	Total     int                      `json:"total"`
-	Error     string                   `json:"error,omitempty"`
-	CreatedAt time.Time                `json:"created_at"`
-	UpdatedAt time.Time                `json:"updated_at"`
-}
-
-// ImportJob represents a single job to be imported
-type ImportJob struct {
-	ID        string          `json:"id"`
-	URL       string          `json:"url"`
-	Title     string          `json:"title"`
-	Status    ImportJobStatus `json:"status"`
-	Error     string          `json:"error,omitempty"`
-	CreatedAt time.Time       `json:"created_at"`
-	UpdatedAt time.Time       `json:"updated_at"`
-}
-
-// ImportQueue represents a batch import queue
-type ImportQueue struct {
-	ID          string          `json:"id"`
-	Status      ImportJobStatus `json:"status"`
-	Jobs        []*ImportJob    `json:"jobs"`
-	TotalJobs   int             `json:"total_jobs"`
-	Completed   int             `json:"completed"`
-	Failed      int             `json:"failed"`
-	Cancelled   int             `json:"cancelled"`
-	CreatedAt   time.Time       `json:"created_at"`
-	UpdatedAt   time.Time       `json:"updated_at"`
-	SourceURL   string          `json:"source_url"`
-	AdminID     uuid.UUID       `json:"-"` // Admin who created the queue (not exposed in JSON)
-}
-
-// ImportQueueService manages background job imports
+// ImportQueueService manages background job imports.
+// Queues, import jobs and extraction tasks are persisted so that a restart
+// resumes where processing stopped.
 type ImportQueueService struct {
-	scraperService    *ScraperService
-	jobService        *JobService
-	queues            map[string]*ImportQueue
-	extractionTasks   map[string]*LinkExtractionTask
-	mu                sync.RWMutex
-	cancelChans       map[string]chan struct{}
+	importQueueRepo *repository.ImportQueueRepository
+	scraperService  *ScraperService
+	jobService      *JobService
+	wake            chan struct{}
+	stopChan        chan struct{}
+	wg              sync.WaitGroup
 }
 
 // NewImportQueueService creates a new import queue service
-func NewImportQueueService(scraperService *ScraperService, jobService *JobService) *ImportQueueService {
+func NewImportQueueService(importQueueRepo *repository.ImportQueueRepository, scraperService *ScraperService, jobService *JobService) *ImportQueueService {
 	return &ImportQueueService{
+		importQueueRepo: importQueueRepo,
 		scraperService:  scraperService,
 		jobService:      jobService,
-		queues:          make(map[string]*ImportQueue),
-		extractionTasks: make(map[string]*LinkExtractionTask),
-		cancelChans:     make(map[string]chan struct{}),
+		wake:            make(chan struct{}, 1),
+		stopChan:        make(chan struct{}),
 	}
 }
 
-// CreateQueue creates a new import queue with the given job URLs
-func (s *ImportQueueService) CreateQueue(adminID uuid.UUID, sourceURL string, urls []string, titles []string) *ImportQueue {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+// Start starts the worker pool. Work interrupted by a crash is claimed again once its lease expired.
+func (s *ImportQueueService) Start(workers int) {
+	for i := 0; i < workers; i++ {
+		s.wg.Add(1)
+		go s.worker(i + 1)
+	}
 
-	queueID := uuid.New().String()
-	now := time.Now()
+	log.Printf("✅ Import queue workers started (%d workers)", workers)
+}
 
-	jobs := make([]*ImportJob, len(urls))
-	for i, url := range urls {
-		title := ""
-		if i < len(titles) {
-			title = titles[i]
+// Stop stops the worker pool and waits for the jobs being processed to finish. Jobs of an
+// instance that crashed are claimed again once their lease expired.
+func (s *ImportQueueService) Stop() {
+	close(s.stopChan)
+	s.wg.Wait()
+	log.Println("🛑 Import queue workers stopped")
+}
+
+// notify wakes up an idle worker without blocking
+func (s *ImportQueueService) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// worker claims and processes link extraction tasks and pending import jobs until stopped.
+// Extraction tasks come first, an admin is waiting for their result.
+func (s *ImportQueueService) worker(id int) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(importPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stopChan:
+			return
+		default:
 		}
-		jobs[i] = &ImportJob{
-			ID:        uuid.New().String(),
-			URL:       url,
-			Title:     title,
-			Status:    ImportStatusPending,
-			CreatedAt: now,
-			UpdatedAt: now,
+
+		now := time.Now()
+		task, err := s.importQueueRepo.ClaimNextExtractionTask(now, now.Add(-importClaimLease))
+		if err != nil {
+			log.Printf("❌ Import worker %d failed to claim link extraction task: %v", id, err)
+		}
+		if task != nil {
+			ctx, cancel := context.WithTimeout(context.Background(), linkExtractionTimeout)
+			s.processLinkExtraction(ctx, task)
+			cancel()
+			continue
 		}
-	}
 
-	queue := &ImportQueue{
-		ID:        queueID,
-		Status:    ImportStatusPending,
-		Jobs:      jobs,
-		TotalJobs: len(urls),
-		CreatedAt: now,
-		UpdatedAt: now,
-		SourceURL: sourceURL,
-		AdminID:   adminID,
+		job, err := s.importQueueRepo.ClaimNextJob(now, now.Add(-importClaimLease))
+		if err != nil {
+			log.Printf("❌ Import worker %d failed to claim job: %v", id, err)
+		}
+
+		if job == nil {
+			// Nothing to do, wait for new work
+			select {
+			case <-s.stopChan:
+				return
+			case <-s.wake:
+			case <-ticker.C:
+			}
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), importJobTimeout)
+		s.processJob(ctx, job)
+		cancel()
+
+		// Small delay between jobs to be respectful to the target site
+		time.Sleep(importJobDelay)
 	}
+}
 
-	s.queues[queueID] = queue
-	s.cancelChans[queueID] = make(chan struct{})
+// CreateQueue creates a new import queue with the given job URLs
+func (s *ImportQueueService) CreateQueue(adminID uuid.UUID, sourceURL string, urls []string, titles []string) (*domain.ImportQueue, error) {
+	queue := newImportQueue(adminID, sourceURL, urls, titles)
 
-	return queue
+	if err := s.importQueueRepo.CreateQueue(queue); err != nil {
+		return nil, err
+	}
+
+	return queue, nil
 }
 
-// StartQueue starts processing a queue in the background
-func (s *ImportQueueService) StartQueue(ctx context.Context, queueID string) error {
-	s.mu.Lock()
-	queue, exists := s.queues[queueID]
-	cancelChan := s.cancelChans[queueID]
-	s.mu.Unlock()
+// CreateSourceQueue creates an import queue for links discovered by a job source crawl.
+// Jobs imported from the queue are linked to the source's company.
+func (s *ImportQueueService) CreateSourceQueue(source *domain.JobSource, urls []string, titles []string) (*domain.ImportQueue, error) {
+	queue := newImportQueue(source.CreatedBy, source.URL, urls, titles)
+	queue.JobSourceID = &source.ID
+	queue.CompanyID = source.CompanyID
 
-	if !exists {
-		return nil
+	if err := s.importQueueRepo.CreateQueue(queue); err != nil {
+		return nil, err
 	}
 
-	// Start processing in background
-	go s.processQueue(ctx, queue, cancelChan)
+	s.notify()
+	return queue, nil
+}
 
-	return nil
+// GetQueuedURLs returns which of the given URLs are already waiting in an active queue
+func (s *ImportQueueService) GetQueuedURLs(urls []string) (map[string]bool, error) {
+	queued, err := s.importQueueRepo.GetActiveJobURLs(urls)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]bool, len(queued))
+	for _, url := range queued {
+		result[url] = true
+	}
+	return result, nil
 }
 
-// processQueue processes all jobs in a queue
-func (s *ImportQueueService) processQueue(ctx context.Context, queue *ImportQueue, cancelChan chan struct{}) {
-	s.mu.Lock()
-	queue.Status = ImportStatusProcessing
-	queue.UpdatedAt = time.Now()
-	s.mu.Unlock()
+// GetDuplicateURLs returns which of the given URLs were skipped on import because the job already exists.
+// The kept job has a different original URL, so these URLs can't be found among the jobs.
+func (s *ImportQueueService) GetDuplicateURLs(urls []string) (map[string]bool, error) {
+	duplicates, err := s.importQueueRepo.GetDuplicateJobURLs(urls)
+	if err != nil {
+		return nil, err
+	}
 
-	log.Printf("🚀 Starting import queue %s with %d jobs", queue.ID, len(queue.Jobs))
+	result := make(map[string]bool, len(duplicates))
+	for _, url := range duplicates {
+		result[url] = true
+	}
+	return result, nil
+}
 
-	for _, job := range queue.Jobs {
-		// Check for cancellation
-		select {
-		case <-cancelChan:
-			log.Printf("🛑 Queue %s cancelled", queue.ID)
-			s.mu.Lock()
-			queue.Status = ImportStatusCancelled
-			queue.UpdatedAt = time.Now()
-			// Mark remaining pending jobs as cancelled
-			for _, j := range queue.Jobs {
-				if j.Status == ImportStatusPending {
-					j.Status = ImportStatusCancelled
-					j.UpdatedAt = time.Now()
-					queue.Cancelled++
-				}
-			}
-			s.mu.Unlock()
-			return
-		case <-ctx.Done():
-			log.Printf("🛑 Queue %s context cancelled", queue.ID)
-			return
-		default:
+// newImportQueue builds a pending queue with one pending job per URL
+func newImportQueue(adminID uuid.UUID, sourceURL string, urls []string, titles []string) *domain.ImportQueue {
+	jobs := make([]domain.ImportJob, len(urls))
+	for i, url := range urls {
+		title := ""
+		if i < len(titles) {
+			title = titles[i]
 		}
+		jobs[i] = domain.ImportJob{
+			Position: i,
+			URL:      url,
+			Title:    title,
+			Status:   domain.ImportStatusPending,
+		}
+	}
 
-		// Process this job
-		s.processJob(ctx, queue, job)
-
-		// Small delay between jobs to be respectful to the target site
-		time.Sleep(500 * time.Millisecond)
+	return &domain.ImportQueue{
+		AdminID:   adminID,
+		SourceURL: sourceURL,
+		Status:    domain.ImportStatusPending,
+		TotalJobs: len(urls),
+		Jobs:      jobs,
 	}
+}
 
-	// Mark queue as completed
-	s.mu.Lock()
-	queue.Status = ImportStatusCompleted
-	queue.UpdatedAt = time.Now()
-	s.mu.Unlock()
+// StartQueue signals the workers that a queue has pending jobs
+func (s *ImportQueueService) StartQueue(queueID string) error {
+	if _, err := s.getQueue(queueID, false); err != nil {
+		return err
+	}
 
-	log.Printf("✅ Import queue %s completed: %d success, %d failed", queue.ID, queue.Completed, queue.Failed)
+	s.notify()
+	return nil
 }
 
 // processJob processes a single import job
-func (s *ImportQueueService) processJob(ctx context.Context, queue *ImportQueue, job *ImportJob) {
-	s.mu.Lock()
-	job.Status = ImportStatusProcessing
-	job.UpdatedAt = time.Now()
-	s.mu.Unlock()
+func (s *ImportQueueService) processJob(ctx context.Context, job *domain.ImportJob) {
+	queue, err := s.importQueueRepo.GetQueueWithoutJobs(job.QueueID)
+	if err != nil {
+		log.Printf("❌ Failed to load import queue %s: %v", job.QueueID, err)
+		return
+	}
+
+	if _, err := s.importQueueRepo.UpdateQueueStatus(queue.ID, domain.ImportStatusProcessing, domain.ImportStatusPending); err != nil {
+		log.Printf("❌ Failed to mark import queue %s as processing: %v", queue.ID, err)
+	}
 
 	log.Printf("🔄 Processing job: %s", job.URL)
 
 	// Scrape the job
 	scrapedJob, _, err := s.scraperService.ScrapeJobURL(ctx, job.URL)
 	if err != nil {
-		s.mu.Lock()
-		job.Status = ImportStatusFailed
-		job.Error = err.Error()
-		job.UpdatedAt = time.Now()
-		queue.Failed++
-		queue.UpdatedAt = time.Now()
-		s.mu.Unlock()
+		s.failJob(job, err.Error())
 		log.Printf("❌ Failed to scrape job %s: %v", job.URL, err)
 		return
 	}
 
 	// Validate scraped data - reject low quality extractions
 	if scrapedJob.Title == "" || len(scrapedJob.Description) < 100 {
-		s.mu.Lock()
-		job.Status = ImportStatusFailed
 		if scrapedJob.Title == "" {
-			job.Error = "Failed to extract job title from page"
+			s.failJob(job, "Failed to extract job title from page")
 		} else {
-			job.Error = "Failed to extract sufficient job description from page"
+			s.failJob(job, "Failed to extract sufficient job description from page")
 		}
-		job.UpdatedAt = time.Now()
-		queue.Failed++
-		queue.UpdatedAt = time.Now()
-		s.mu.Unlock()
 		log.Printf("❌ Low quality extraction for %s: title=%q, desc_len=%d", job.URL, scrapedJob.Title, len(scrapedJob.Description))
 		return
 	}
@@ -343,319 +370,298 @@ func (s *ImportQueueService) processJob(ctx context.Context, queue *ImportQueue,
 	input.ScrapedData = &scrapedDataStr
 	input.ScrapeStatus = "scraped"
 	input.OriginalURL = &scrapedJob.OriginalURL
+	input.CompanyID = queue.CompanyID
+	input.JobSourceID = queue.JobSourceID
+
+	// Skip jobs that already exist, e.g. imported from another board or posted by the employer
+	duplicate, err := s.jobService.FindDuplicateJob(&domain.Job{
+		Title:       input.Title,
+		CompanyName: input.CompanyName,
+		Location:    input.Location,
+		Description: input.Description,
+		OriginalURL: input.OriginalURL,
+	})
+	if err != nil {
+		log.Printf("❌ Failed to check %s for duplicates: %v", job.URL, err)
+	} else if duplicate != nil {
+		job.Status = domain.ImportStatusCompleted
+		job.Error = ""
+		job.DuplicateOfID = &duplicate.JobID
+		job.Title = scrapedJob.Title
+		s.saveJob(job)
+		log.Printf("⏭️ Skipped duplicate of job %s: %s", duplicate.JobID, job.Title)
+		return
+	}
 
 	// Create the job in database using jobService with the admin who created the queue
-	_, err = s.jobService.AdminCreateJob(queue.AdminID, input)
+	createdJob, err := s.jobService.AdminCreateJob(queue.AdminID, input)
 	if err != nil {
-		s.mu.Lock()
-		job.Status = ImportStatusFailed
-		job.Error = err.Error()
-		job.UpdatedAt = time.Now()
-		queue.Failed++
-		queue.UpdatedAt = time.Now()
-		s.mu.Unlock()
+		s.failJob(job, err.Error())
 		log.Printf("❌ Failed to create job %s: %v", job.URL, err)
 		return
 	}
 
 	// Success
-	s.mu.Lock()
-	job.Status = ImportStatusCompleted
-	job.UpdatedAt = time.Now()
+	job.Status = domain.ImportStatusCompleted
+	job.Error = ""
+	job.JobID = &createdJob.ID
 	if scrapedJob.Title != "" {
 		job.Title = scrapedJob.Title
 	}
-	queue.Completed++
-	queue.UpdatedAt = time.Now()
-	s.mu.Unlock()
+	s.saveJob(job)
 
 	log.Printf("✅ Successfully imported: %s", job.Title)
 }
 
-// GetQueue returns a queue by ID
-func (s *ImportQueueService) GetQueue(queueID string) *ImportQueue {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-	return s.queues[queueID]
+// failJob marks an import job as failed with the given reason
+func (s *ImportQueueService) failJob(job *domain.ImportJob, reason string) {
+	job.Status = domain.ImportStatusFailed
+	job.Error = reason
+	s.saveJob(job)
 }
 
-// GetAllQueues returns all queues
-func (s *ImportQueueService) GetAllQueues() []*ImportQueue {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+// saveJob persists an import job and refreshes its queue counters
+func (s *ImportQueueService) saveJob(job *domain.ImportJob) {
+	if err := s.importQueueRepo.UpdateJob(job); err != nil {
+		log.Printf("❌ Failed to save import job %s: %v", job.ID, err)
+		return
+	}
+
+	if err := s.importQueueRepo.RefreshQueueCounts(job.QueueID); err != nil {
+		log.Printf("❌ Failed to refresh import queue %s: %v", job.QueueID, err)
+		return
+	}
 
-	queues := make([]*ImportQueue, 0, len(s.queues))
-	for _, q := range s.queues {
-		queues = append(queues, q)
+	queue, err := s.importQueueRepo.GetQueueWithoutJobs(job.QueueID)
+	if err == nil && queue.Status == domain.ImportStatusCompleted {
+		log.Printf("✅ Import queue %s completed: %d success, %d failed", queue.ID, queue.Completed, queue.Failed)
+	}
+}
+
+// getQueue loads a queue by its string ID
+func (s *ImportQueueService) getQueue(queueID string, withJobs bool) (*domain.ImportQueue, error) {
+	id, err := uuid.Parse(queueID)
+	if err != nil {
+		return nil, domain.ErrImportQueueNotFound
 	}
-	return queues
+
+	var queue *domain.ImportQueue
+	if withJobs {
+		queue, err = s.importQueueRepo.GetQueueByID(id)
+	} else {
+		queue, err = s.importQueueRepo.GetQueueWithoutJobs(id)
+	}
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrImportQueueNotFound
+		}
+		return nil, err
+	}
+
+	return queue, nil
+}
+
+// GetQueue returns a queue by ID
+func (s *ImportQueueService) GetQueue(queueID string) (*domain.ImportQueue, error) {
+	return s.getQueue(queueID, true)
+}
+
+// GetAllQueues returns all queues
+func (s *ImportQueueService) GetAllQueues() ([]*domain.ImportQueue, error) {
+	return s.importQueueRepo.GetAllQueues()
 }
 
 // CancelQueue cancels a running queue
 func (s *ImportQueueService) CancelQueue(queueID string) bool {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
-	queue, exists := s.queues[queueID]
-	if !exists {
+	queue, err := s.getQueue(queueID, false)
+	if err != nil {
 		return false
 	}
 
-	if queue.Status != ImportStatusProcessing && queue.Status != ImportStatusPending {
+	cancelled, err := s.importQueueRepo.UpdateQueueStatus(queue.ID, domain.ImportStatusCancelled, domain.ImportStatusPending, domain.ImportStatusProcessing)
+	if err != nil || !cancelled {
 		return false
 	}
 
-	// Signal cancellation
-	if cancelChan, ok := s.cancelChans[queueID]; ok {
-		close(cancelChan)
+	// Mark remaining pending jobs as cancelled
+	if _, err := s.importQueueRepo.UpdateJobStatus(queue.ID, nil, domain.ImportStatusCancelled, domain.ImportStatusPending); err != nil {
+		log.Printf("❌ Failed to cancel pending jobs of queue %s: %v", queue.ID, err)
+	}
+	if err := s.importQueueRepo.RefreshQueueCounts(queue.ID); err != nil {
+		log.Printf("❌ Failed to refresh import queue %s: %v", queue.ID, err)
 	}
 
+	log.Printf("🛑 Queue %s cancelled", queue.ID)
 	return true
 }
 
 // CancelJob cancels a specific pending job
 func (s *ImportQueueService) CancelJob(queueID, jobID string) bool {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
-	queue, exists := s.queues[queueID]
-	if !exists {
+	queue, err := s.getQueue(queueID, false)
+	if err != nil {
 		return false
 	}
 
-	for _, job := range queue.Jobs {
-		if job.ID == jobID && job.Status == ImportStatusPending {
-			job.Status = ImportStatusCancelled
-			job.UpdatedAt = time.Now()
-			queue.Cancelled++
-			queue.UpdatedAt = time.Now()
-			return true
-		}
-	}
-
-	return false
-}
-
-// RetryJob retries a failed job by resetting its status to pending and restarting the queue
-func (s *ImportQueueService) RetryJob(ctx context.Context, queueID, jobID string) bool {
-	s.mu.Lock()
-
-	queue, exists := s.queues[queueID]
-	if !exists {
-		s.mu.Unlock()
+	id, err := uuid.Parse(jobID)
+	if err != nil {
 		return false
 	}
 
-	// Check if queue is already processing
-	if queue.Status == ImportStatusProcessing {
-		s.mu.Unlock()
+	count, err := s.importQueueRepo.UpdateJobStatus(queue.ID, &id, domain.ImportStatusCancelled, domain.ImportStatusPending)
+	if err != nil || count == 0 {
 		return false
 	}
 
-	// Find and reset the failed job
-	var foundJob *ImportJob
-	for _, job := range queue.Jobs {
-		if job.ID == jobID && job.Status == ImportStatusFailed {
-			job.Status = ImportStatusPending
-			job.Error = ""
-			job.UpdatedAt = time.Now()
-			queue.Failed--
-			queue.UpdatedAt = time.Now()
-			foundJob = job
-			break
-		}
+	if err := s.importQueueRepo.RefreshQueueCounts(queue.ID); err != nil {
+		log.Printf("❌ Failed to refresh import queue %s: %v", queue.ID, err)
 	}
 
-	if foundJob == nil {
-		s.mu.Unlock()
+	return true
+}
+
+// RetryJob retries a failed job by resetting its status to pending and reactivating the queue
+func (s *ImportQueueService) RetryJob(queueID, jobID string) bool {
+	id, err := uuid.Parse(jobID)
+	if err != nil {
 		return false
 	}
 
-	// Reset queue status and create new cancel channel to restart processing
-	queue.Status = ImportStatusPending
-	queue.UpdatedAt = time.Now()
-	s.cancelChans[queueID] = make(chan struct{})
-	cancelChan := s.cancelChans[queueID]
-	s.mu.Unlock()
-
-	// Restart processing in background
-	go s.processQueue(ctx, queue, cancelChan)
+	return s.retry(queueID, &id)
+}
 
-	return true
+// RetryFailedJobs retries all failed jobs in a queue and reactivates it
+func (s *ImportQueueService) RetryFailedJobs(queueID string) bool {
+	return s.retry(queueID, nil)
 }
 
-// RetryFailedJobs retries all failed jobs in a queue and restarts processing
-func (s *ImportQueueService) RetryFailedJobs(ctx context.Context, queueID string) bool {
-	s.mu.Lock()
-	queue, exists := s.queues[queueID]
-	if !exists {
-		s.mu.Unlock()
+// retry resets failed jobs (or a single failed job) of a queue back to pending
+func (s *ImportQueueService) retry(queueID string, jobID *uuid.UUID) bool {
+	queue, err := s.getQueue(queueID, false)
+	if err != nil {
 		return false
 	}
 
 	// Check if queue is already processing
-	if queue.Status == ImportStatusProcessing {
-		s.mu.Unlock()
+	if queue.Status == domain.ImportStatusProcessing {
 		return false
 	}
 
-	// Reset all failed jobs to pending
-	hasFailedJobs := false
-	for _, job := range queue.Jobs {
-		if job.Status == ImportStatusFailed {
-			job.Status = ImportStatusPending
-			job.Error = ""
-			job.UpdatedAt = time.Now()
-			queue.Failed--
-			hasFailedJobs = true
-		}
+	count, err := s.importQueueRepo.UpdateJobStatus(queue.ID, jobID, domain.ImportStatusPending, domain.ImportStatusFailed)
+	if err != nil || count == 0 {
+		return false
 	}
 
-	if !hasFailedJobs {
-		s.mu.Unlock()
+	if _, err := s.importQueueRepo.UpdateQueueStatus(queue.ID, domain.ImportStatusPending); err != nil {
+		log.Printf("❌ Failed to reactivate import queue %s: %v", queue.ID, err)
 		return false
 	}
+	if err := s.importQueueRepo.RefreshQueueCounts(queue.ID); err != nil {
+		log.Printf("❌ Failed to refresh import queue %s: %v", queue.ID, err)
+	}
 
-	// Reset queue status and create new cancel channel
-	queue.Status = ImportStatusPending
-	queue.UpdatedAt = time.Now()
-	s.cancelChans[queueID] = make(chan struct{})
-	cancelChan := s.cancelChans[queueID]
-	s.mu.Unlock()
-
-	// Restart processing in background
-	go s.processQueue(ctx, queue, cancelChan)
-
+	s.notify()
 	return true
 }
 
 // DeleteQueue removes a queue (only if not processing)
 func (s *ImportQueueService) DeleteQueue(queueID string) bool {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
-	queue, exists := s.queues[queueID]
-	if !exists {
+	queue, err := s.getQueue(queueID, false)
+	if err != nil {
 		return false
 	}
 
-	if queue.Status == ImportStatusProcessing {
+	if queue.Status == domain.ImportStatusProcessing {
 		return false
 	}
 
-	delete(s.queues, queueID)
-	delete(s.cancelChans, queueID)
-	return true
+	return s.importQueueRepo.DeleteQueue(queue.ID) == nil
 }
 
-// CleanupOldQueues removes queues older than the given duration
+// CleanupOldQueues removes queues and extraction tasks older than the given duration
 func (s *ImportQueueService) CleanupOldQueues(maxAge time.Duration) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
 	cutoff := time.Now().Add(-maxAge)
-	for id, queue := range s.queues {
-		if queue.Status != ImportStatusProcessing && queue.UpdatedAt.Before(cutoff) {
-			delete(s.queues, id)
-			delete(s.cancelChans, id)
-		}
+
+	if _, err := s.importQueueRepo.DeleteFinishedQueuesBefore(cutoff); err != nil {
+		log.Printf("❌ Failed to clean up old import queues: %v", err)
 	}
 	// Also cleanup old extraction tasks
-	for id, task := range s.extractionTasks {
-		if task.Status != ImportStatusProcessing && task.UpdatedAt.Before(cutoff) {
-			delete(s.extractionTasks, id)
-		}
+	if _, err := s.importQueueRepo.DeleteFinishedExtractionTasksBefore(cutoff); err != nil {
+		log.Printf("❌ Failed to clean up old link extraction tasks: %v", err)
 	}
 }
 
-// StartLinkExtraction starts a background link extraction task
-func (s *ImportQueueService) StartLinkExtraction(sourceURL string) *LinkExtractionTask {
-	s.mu.Lock()
-	taskID := uuid.New().String()
-	now := time.Now()
-
-	task := &LinkExtractionTask{
-		ID:        taskID,
+// StartLinkExtraction queues a link extraction task for the import workers
+func (s *ImportQueueService) StartLinkExtraction(sourceURL string) (*domain.LinkExtractionTask, error) {
+	task := &domain.LinkExtractionTask{
 		SourceURL: sourceURL,
-		Status:    ImportStatusPending,
-		Links:     []dto.ExtractedJobLink{},
-		Total:     0,
-		CreatedAt: now,
-		UpdatedAt: now,
+		Status:    domain.ImportStatusPending,
+		Links:     datatypes.JSON("[]"),
 	}
 
-	s.extractionTasks[taskID] = task
-	s.mu.Unlock()
-
-	// Start extraction in background
-	go s.processLinkExtraction(task)
+	if err := s.importQueueRepo.CreateExtractionTask(task); err != nil {
+		return nil, err
+	}
 
-	return task
+	s.notify()
+	return task, nil
 }
 
-// processLinkExtraction runs the actual link extraction in background
-func (s *ImportQueueService) processLinkExtraction(task *LinkExtractionTask) {
-	s.mu.Lock()
-	task.Status = ImportStatusProcessing
-	task.UpdatedAt = time.Now()
-	s.mu.Unlock()
-
+// processLinkExtraction extracts the job links of a claimed task and saves the result
+func (s *ImportQueueService) processLinkExtraction(ctx context.Context, task *domain.LinkExtractionTask) {
 	log.Printf("🔗 Starting link extraction for: %s", task.SourceURL)
 
-	ctx := context.Background()
 	result, err := s.scraperService.ExtractJobLinks(ctx, task.SourceURL)
 
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
 	if err != nil {
-		task.Status = ImportStatusFailed
+		task.Status = domain.ImportStatusFailed
 		task.Error = err.Error()
-		task.UpdatedAt = time.Now()
 		log.Printf("❌ Link extraction failed for %s: %v", task.SourceURL, err)
-		return
+	} else {
+		links, _ := json.Marshal(result.Links)
+		task.Status = domain.ImportStatusCompleted
+		task.Links = datatypes.JSON(links)
+		task.Total = result.Total
+		log.Printf("✅ Link extraction completed for %s: found %d links", task.SourceURL, result.Total)
 	}
 
-	task.Status = ImportStatusCompleted
-	task.Links = result.Links
-	task.Total = result.Total
-	task.UpdatedAt = time.Now()
-	log.Printf("✅ Link extraction completed for %s: found %d links", task.SourceURL, result.Total)
+	if err := s.importQueueRepo.UpdateExtractionTask(task); err != nil {
+		log.Printf("❌ Failed to update link extraction task %s: %v", task.ID, err)
+	}
 }
 
 // GetExtractionTask returns an extraction task by ID
-func (s *ImportQueueService) GetExtractionTask(taskID string) *LinkExtractionTask {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-	return s.extractionTasks[taskID]
+func (s *ImportQueueService) GetExtractionTask(taskID string) (*domain.LinkExtractionTask, error) {
+	id, err := uuid.Parse(taskID)
+	if err != nil {
+		return nil, domain.ErrExtractionTaskNotFound
+	}
+
+	task, err := s.importQueueRepo.GetExtractionTaskByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrExtractionTaskNotFound
+		}
+		return nil, err
+	}
+
+	return task, nil
 }
 
 // GetAllExtractionTasks returns all extraction tasks
-func (s *ImportQueueService) GetAllExtractionTasks() []*LinkExtractionTask {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-
-	tasks := make([]*LinkExtractionTask, 0, len(s.extractionTasks))
-	for _, t := range s.extractionTasks {
-		tasks = append(tasks, t)
-	}
-	return tasks
+func (s *ImportQueueService) GetAllExtractionTasks() ([]*domain.LinkExtractionTask, error) {
+	return s.importQueueRepo.GetAllExtractionTasks()
 }
 
 // DeleteExtractionTask removes an extraction task
 func (s *ImportQueueService) DeleteExtractionTask(taskID string) bool {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
-	task, exists := s.extractionTasks[taskID]
-	if !exists {
+	task, err := s.GetExtractionTask(taskID)
+	if err != nil {
 		return false
 	}
 
-	if task.Status == ImportStatusProcessing {
+	if task.Status == domain.ImportStatusProcessing {
 		return false
 	}
 
-	delete(s.extractionTasks, taskID)
-	return true
+	return s.importQueueRepo.DeleteExtractionTask(task.ID) == nil
 }
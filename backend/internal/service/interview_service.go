@@ -0,0 +1,682 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"job-platform/internal/domain"
+	"job-platform/internal/repository"
+	"job-platform/internal/util/calendar"
+	"job-platform/internal/util/email"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	// MaxInterviewSlots is the maximum number of time slots proposed for one interview
+	MaxInterviewSlots = 10
+	// MinInterviewDuration and MaxInterviewDuration bound an interview's length in minutes
+	MinInterviewDuration = 15
+	MaxInterviewDuration = 480
+)
+
+// InterviewConfig holds configuration for interview emails and calendar invites
+type InterviewConfig struct {
+	CompanyName    string
+	SupportEmail   string
+	FrontendURL    string
+	OrganizerEmail string // Sender address used as the ORGANIZER of calendar invites
+}
+
+// InterviewService handles interview scheduling
+type InterviewService struct {
+	interviewRepo       *repository.InterviewRepository
+	applicationRepo     *repository.ApplicationRepository
+	teamRepo            *repository.TeamRepository
+	companyRepo         *repository.CompanyRepository
+	applicationService  *ApplicationService
+	notificationService *NotificationService
+	emailService        email.EmailSender
+	db                  *gorm.DB
+	config              *InterviewConfig
+}
+
+// NewInterviewService creates a new interview service
+func NewInterviewService(
+	interviewRepo *repository.InterviewRepository,
+	applicationRepo *repository.ApplicationRepository,
+	teamRepo *repository.TeamRepository,
+	companyRepo *repository.CompanyRepository,
+	applicationService *ApplicationService,
+	emailService email.EmailSender,
+	db *gorm.DB,
+	config *InterviewConfig,
+) *InterviewService {
+	return &InterviewService{
+		interviewRepo:      interviewRepo,
+		applicationRepo:    applicationRepo,
+		teamRepo:           teamRepo,
+		companyRepo:        companyRepo,
+		applicationService: applicationService,
+		emailService:       emailService,
+		db:                 db,
+		config:             config,
+	}
+}
+
+// SetNotificationService sets the notification service
+func (s *InterviewService) SetNotificationService(ns *NotificationService) {
+	s.notificationService = ns
+}
+
+// CreateInterviewInput represents input for scheduling an interview.
+// With a single slot the interview is confirmed right away; otherwise the candidate picks one.
+type CreateInterviewInput struct {
+	Title           string
+	Type            domain.InterviewType
+	DurationMinutes int
+	Location        string
+	MeetingURL      string
+	Notes           string
+	Timezone        string
+	InterviewerIDs  []uuid.UUID // Company team member IDs
+	Slots           []time.Time // Proposed start times
+}
+
+// RescheduleInterviewInput represents input for proposing new times for an interview
+type RescheduleInterviewInput struct {
+	Slots  []time.Time
+	Reason string
+}
+
+// CreateInterview creates an interview for an application (employer only)
+func (s *InterviewService) CreateInterview(applicationID, employerID uuid.UUID, input CreateInterviewInput) (*domain.Interview, error) {
+	application, err := s.applicationRepo.GetByID(applicationID)
+	if err != nil {
+		return nil, domain.ErrApplicationNotFound
+	}
+	if application.Job.EmployerID != employerID {
+		return nil, domain.ErrJobNotOwnedByEmployer
+	}
+	if application.IsInFinalStage() {
+		return nil, domain.ErrApplicationClosed
+	}
+
+	timezone := strings.TrimSpace(input.Timezone)
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return nil, domain.ErrInvalidTimezone
+	}
+
+	slots, err := buildInterviewSlots(input.Slots, input.DurationMinutes)
+	if err != nil {
+		return nil, err
+	}
+
+	interviewers, err := s.resolveInterviewers(&application.Job, input.InterviewerIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	interview := &domain.Interview{
+		ID:              uuid.New(),
+		ApplicationID:   applicationID,
+		CreatedBy:       employerID,
+		Title:           strings.TrimSpace(input.Title),
+		Type:            input.Type,
+		DurationMinutes: input.DurationMinutes,
+		Location:        strings.TrimSpace(input.Location),
+		MeetingURL:      strings.TrimSpace(input.MeetingURL),
+		Notes:           strings.TrimSpace(input.Notes),
+		Timezone:        timezone,
+		Status:          domain.InterviewStatusProposed,
+		Slots:           slots,
+		Interviewers:    interviewers,
+	}
+	if len(slots) == 1 {
+		slots[0].ID = uuid.New()
+		confirmSlot(interview, &slots[0])
+	}
+
+	// The interview is saved and the application moved into the interview stage together
+	tx := s.db.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := repository.NewInterviewRepository(tx).Create(interview); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	var move *stageMove
+	if domain.PipelineStatusRank(application.Status) < domain.PipelineStatusRank(domain.ApplicationStatusInterview) {
+		move, err = s.applicationService.applyMove(tx, application, employerID, MoveApplicationInput{
+			Status: domain.ApplicationStatusInterview,
+		})
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+
+	if move != nil {
+		if _, err := s.applicationService.afterMove(application, employerID, move); err != nil {
+			log.Printf("Error reloading application %s after scheduling an interview: %v", applicationID, err)
+		}
+	}
+
+	interview, err = s.interviewRepo.GetByID(interview.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if interview.IsScheduled() {
+		s.sendInvitations(interview, false)
+	} else {
+		s.notifyProposed(interview)
+	}
+
+	return interview, nil
+}
+
+// GetInterview retrieves an interview (employer only)
+func (s *InterviewService) GetInterview(interviewID, employerID uuid.UUID) (*domain.Interview, error) {
+	return s.getEmployerInterview(interviewID, employerID)
+}
+
+// GetApplicationInterviews retrieves the interviews of an application (employer only)
+func (s *InterviewService) GetApplicationInterviews(applicationID, employerID uuid.UUID) ([]domain.Interview, error) {
+	application, err := s.applicationRepo.GetByID(applicationID)
+	if err != nil {
+		return nil, domain.ErrApplicationNotFound
+	}
+	if application.Job.EmployerID != employerID {
+		return nil, domain.ErrJobNotOwnedByEmployer
+	}
+	return s.interviewRepo.GetByApplicationID(applicationID)
+}
+
+// GetCandidateInterviews retrieves the interviews of one of the candidate's applications
+func (s *InterviewService) GetCandidateInterviews(applicationID, candidateID uuid.UUID) ([]domain.Interview, error) {
+	application, err := s.applicationRepo.GetByID(applicationID)
+	if err != nil || application.ApplicantID != candidateID {
+		return nil, domain.ErrApplicationNotFound
+	}
+	return s.interviewRepo.GetByApplicationID(applicationID)
+}
+
+// SelectSlot confirms one of the proposed slots (candidate only)
+func (s *InterviewService) SelectSlot(interviewID, candidateID, slotID uuid.UUID) (*domain.Interview, error) {
+	interview, err := s.interviewRepo.GetByID(interviewID)
+	if err != nil || interview.Application == nil || interview.Application.ApplicantID != candidateID {
+		return nil, domain.ErrInterviewNotFound
+	}
+
+	switch interview.Status {
+	case domain.InterviewStatusScheduled:
+		return nil, domain.ErrInterviewAlreadyBooked
+	case domain.InterviewStatusProposed:
+	default:
+		return nil, domain.ErrInterviewNotOpen
+	}
+
+	slot := interview.SlotByID(slotID)
+	if slot == nil {
+		return nil, domain.ErrInterviewSlotNotFound
+	}
+	if !slot.StartTime.After(time.Now()) {
+		return nil, domain.ErrInvalidInterviewSlots
+	}
+
+	// A confirmed time after an earlier reschedule is announced as an update. The sequence is
+	// bumped again so calendars accept it after the cancellation of the previous time.
+	rescheduled := interview.Sequence > 0
+	if rescheduled {
+		interview.Sequence++
+	}
+
+	confirmSlot(interview, slot)
+	if err := s.interviewRepo.Update(interview); err != nil {
+		return nil, err
+	}
+
+	s.sendInvitations(interview, rescheduled)
+
+	return interview, nil
+}
+
+// RescheduleInterview proposes new times for an interview (employer only).
+// Any previously confirmed time is withdrawn from calendars.
+func (s *InterviewService) RescheduleInterview(interviewID, employerID uuid.UUID, input RescheduleInterviewInput) (*domain.Interview, error) {
+	interview, err := s.getEmployerInterview(interviewID, employerID)
+	if err != nil {
+		return nil, err
+	}
+	if !interview.IsOpen() {
+		return nil, domain.ErrInterviewNotOpen
+	}
+
+	slots, err := buildInterviewSlots(input.Slots, interview.DurationMinutes)
+	if err != nil {
+		return nil, err
+	}
+
+	wasScheduled := interview.IsScheduled()
+	previous := *interview
+
+	interview.Sequence++
+	interview.Status = domain.InterviewStatusProposed
+	interview.SelectedSlotID = nil
+	interview.ScheduledStart = nil
+	interview.ScheduledEnd = nil
+
+	// Update in transaction
+	tx := s.db.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	interviewRepoTx := repository.NewInterviewRepository(tx)
+	if err := interviewRepoTx.ReplaceSlots(interview.ID, slots); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if len(slots) == 1 {
+		confirmSlot(interview, &slots[0])
+	}
+	if err := interviewRepoTx.Update(interview); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+
+	interview, err = s.interviewRepo.GetByID(interview.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case interview.IsScheduled():
+		// Same UID with a higher sequence moves the existing calendar event
+		s.sendInvitations(interview, wasScheduled)
+	case wasScheduled:
+		previous.Sequence = interview.Sequence
+		s.sendCancellations(&previous, input.Reason, false)
+		s.notifyProposed(interview)
+	default:
+		s.notifyProposed(interview)
+	}
+
+	return interview, nil
+}
+
+// CancelInterview cancels an interview (employer only)
+func (s *InterviewService) CancelInterview(interviewID, employerID uuid.UUID, reason string) (*domain.Interview, error) {
+	interview, err := s.getEmployerInterview(interviewID, employerID)
+	if err != nil {
+		return nil, err
+	}
+	if !interview.IsOpen() {
+		return nil, domain.ErrInterviewNotOpen
+	}
+
+	now := time.Now()
+	interview.Status = domain.InterviewStatusCancelled
+	interview.CancelReason = strings.TrimSpace(reason)
+	interview.CancelledAt = &now
+	interview.Sequence++
+
+	if err := s.interviewRepo.Update(interview); err != nil {
+		return nil, err
+	}
+
+	s.sendCancellations(interview, interview.CancelReason, true)
+
+	return interview, nil
+}
+
+// CompleteInterview marks a scheduled interview as held (employer only)
+func (s *InterviewService) CompleteInterview(interviewID, employerID uuid.UUID) (*domain.Interview, error) {
+	interview, err := s.getEmployerInterview(interviewID, employerID)
+	if err != nil {
+		return nil, err
+	}
+	if !interview.IsScheduled() {
+		return nil, domain.ErrInterviewNotScheduled
+	}
+
+	interview.Status = domain.InterviewStatusCompleted
+	if err := s.interviewRepo.Update(interview); err != nil {
+		return nil, err
+	}
+	return interview, nil
+}
+
+// GetCalendarInvite returns the .ics file of a scheduled interview for one of its participants
+func (s *InterviewService) GetCalendarInvite(interviewID, userID uuid.UUID) (*email.Attachment, error) {
+	interview, err := s.interviewRepo.GetByID(interviewID)
+	if err != nil || interview.Application == nil || !isInterviewParticipant(interview, userID) {
+		return nil, domain.ErrInterviewNotFound
+	}
+	if !interview.IsScheduled() {
+		return nil, domain.ErrInterviewNotScheduled
+	}
+	return s.buildInvite(interview, calendar.MethodRequest), nil
+}
+
+// getEmployerInterview retrieves an interview and verifies the employer owns its job
+func (s *InterviewService) getEmployerInterview(interviewID, employerID uuid.UUID) (*domain.Interview, error) {
+	interview, err := s.interviewRepo.GetByID(interviewID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrInterviewNotFound
+		}
+		return nil, err
+	}
+	if interview.Application == nil || interview.Application.Job.EmployerID != employerID {
+		return nil, domain.ErrJobNotOwnedByEmployer
+	}
+	return interview, nil
+}
+
+// resolveInterviewers validates that team member IDs belong to the job's company
+func (s *InterviewService) resolveInterviewers(job *domain.Job, memberIDs []uuid.UUID) ([]domain.InterviewInterviewer, error) {
+	if len(memberIDs) == 0 {
+		return nil, nil
+	}
+
+	company, err := s.companyRepo.GetJobCompany(job)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrInvalidInterviewer
+		}
+		return nil, err
+	}
+
+	seen := make(map[uuid.UUID]bool, len(memberIDs))
+	interviewers := make([]domain.InterviewInterviewer, 0, len(memberIDs))
+	for _, id := range memberIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		member, err := s.teamRepo.GetByID(id)
+		if err != nil || member.CompanyID != company.ID || member.Status != domain.TeamMemberStatusActive {
+			return nil, domain.ErrInvalidInterviewer
+		}
+		interviewers = append(interviewers, domain.InterviewInterviewer{
+			TeamMemberID: member.ID,
+			UserID:       member.UserID,
+		})
+	}
+	return interviewers, nil
+}
+
+// buildInterviewSlots validates proposed start times and converts them to slots
+func buildInterviewSlots(starts []time.Time, durationMinutes int) ([]domain.InterviewSlot, error) {
+	if durationMinutes < MinInterviewDuration || durationMinutes > MaxInterviewDuration {
+		return nil, domain.ErrInvalidInterviewSlots
+	}
+	if len(starts) == 0 || len(starts) > MaxInterviewSlots {
+		return nil, domain.ErrInvalidInterviewSlots
+	}
+
+	sorted := append([]time.Time(nil), starts...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Before(sorted[j]) })
+
+	duration := time.Duration(durationMinutes) * time.Minute
+	now := time.Now()
+	slots := make([]domain.InterviewSlot, 0, len(sorted))
+	for i, start := range sorted {
+		if !start.After(now) {
+			return nil, domain.ErrInvalidInterviewSlots
+		}
+		if i > 0 && start.Before(sorted[i-1].Add(duration)) {
+			return nil, domain.ErrInvalidInterviewSlots
+		}
+		slots = append(slots, domain.InterviewSlot{
+			StartTime: start.UTC(),
+			EndTime:   start.Add(duration).UTC(),
+		})
+	}
+	return slots, nil
+}
+
+// confirmSlot sets the interview's confirmed time from a slot
+func confirmSlot(interview *domain.Interview, slot *domain.InterviewSlot) {
+	start, end := slot.StartTime, slot.EndTime
+	slotID := slot.ID
+	interview.Status = domain.InterviewStatusScheduled
+	interview.ScheduledStart = &start
+	interview.ScheduledEnd = &end
+	if slotID != uuid.Nil {
+		interview.SelectedSlotID = &slotID
+	}
+}
+
+// isInterviewParticipant checks if a user is the candidate, the employer or an interviewer
+func isInterviewParticipant(interview *domain.Interview, userID uuid.UUID) bool {
+	if interview.Application.ApplicantID == userID || interview.Application.Job.EmployerID == userID {
+		return true
+	}
+	for _, iv := range interview.Interviewers {
+		if iv.UserID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// buildInvite renders the interview as an .ics attachment
+func (s *InterviewService) buildInvite(interview *domain.Interview, method calendar.Method) *email.Attachment {
+	app := interview.Application
+	job := app.Job
+
+	description := interview.Notes
+	if interview.MeetingURL != "" {
+		description = strings.TrimSpace(description + "\n\nJoin: " + interview.MeetingURL)
+	}
+	location := interview.Location
+	if location == "" {
+		location = interview.MeetingURL
+	}
+
+	event := &calendar.Event{
+		UID:         interview.ID.String() + "@job-platform",
+		Sequence:    interview.Sequence,
+		Method:      method,
+		Summary:     fmt.Sprintf("%s - %s (%s)", interview.Title, job.Title, job.CompanyName),
+		Description: description,
+		Location:    location,
+		URL:         interview.MeetingURL,
+		Start:       *interview.ScheduledStart,
+		End:         *interview.ScheduledEnd,
+		Organizer: calendar.Attendee{
+			Name:  job.CompanyName,
+			Email: s.config.OrganizerEmail,
+		},
+	}
+	event.Attendees = append(event.Attendees, calendar.Attendee{
+		Name:  app.Applicant.FirstName + " " + app.Applicant.LastName,
+		Email: app.Applicant.Email,
+	})
+	for _, iv := range interview.Interviewers {
+		if iv.User != nil {
+			event.Attendees = append(event.Attendees, calendar.Attendee{
+				Name:  iv.User.FirstName + " " + iv.User.LastName,
+				Email: iv.User.Email,
+			})
+		}
+	}
+
+	return &email.Attachment{
+		Filename:    "interview.ics",
+		ContentType: event.ContentType(),
+		Content:     event.Build(),
+	}
+}
+
+// interviewRecipient is a participant who receives interview emails and notifications
+type interviewRecipient struct {
+	user *domain.User
+	link string
+}
+
+// recipients returns the candidate followed by the interviewers
+func (s *InterviewService) recipients(interview *domain.Interview) []interviewRecipient {
+	app := interview.Application
+	recipients := []interviewRecipient{{
+		user: &app.Applicant,
+		link: fmt.Sprintf("/dashboard/applications/%s", app.ID),
+	}}
+	for _, iv := range interview.Interviewers {
+		if iv.User != nil {
+			recipients = append(recipients, interviewRecipient{
+				user: iv.User,
+				link: fmt.Sprintf("/employer/applications/%s", app.ID),
+			})
+		}
+	}
+	return recipients
+}
+
+// emailData builds the common interview email data for a recipient
+func (s *InterviewService) emailData(interview *domain.Interview, r interviewRecipient) email.InterviewEmailData {
+	data := email.InterviewEmailData{
+		Name:         r.user.FirstName + " " + r.user.LastName,
+		Email:        r.user.Email,
+		JobTitle:     interview.Application.Job.Title,
+		EmployerName: interview.Application.Job.CompanyName,
+		Duration:     interview.DurationMinutes,
+		Location:     interview.Location,
+		MeetingURL:   interview.MeetingURL,
+		DetailsURL:   strings.TrimRight(s.config.FrontendURL, "/") + r.link,
+		CompanyName:  s.config.CompanyName,
+		SupportEmail: s.config.SupportEmail,
+		Year:         time.Now().Year(),
+	}
+	if interview.ScheduledStart != nil {
+		data.InterviewTime = formatInterviewTime(interview)
+	}
+	return data
+}
+
+// formatInterviewTime formats the confirmed time in the interview's time zone
+func formatInterviewTime(interview *domain.Interview) string {
+	return interview.ScheduledStart.In(interview.TimeLocation()).Format("Monday, January 2, 2006 at 3:04 PM MST")
+}
+
+// sendInvitations emails calendar invites and notifies all participants of a confirmed time (async)
+func (s *InterviewService) sendInvitations(interview *domain.Interview, rescheduled bool) {
+	invite := s.buildInvite(interview, calendar.MethodRequest)
+	when := formatInterviewTime(interview)
+
+	heading, message := "Interview Scheduled", "Your interview has been scheduled."
+	if rescheduled {
+		heading, message = "Interview Rescheduled", "Your interview has been moved to a new time."
+	}
+
+	for _, r := range s.recipients(interview) {
+		r := r
+		go func() {
+			if s.emailService != nil {
+				data := s.emailData(interview, r)
+				data.Heading = heading
+				data.Message = message
+				data.Invite = invite
+				if err := s.emailService.SendInterviewInvitation(data); err != nil {
+					log.Printf("Failed to send interview invitation to %s: %v", r.user.Email, err)
+				}
+			}
+			if s.notificationService != nil {
+				_ = s.notificationService.NotifyInterviewScheduled(
+					context.Background(),
+					r.user.ID,
+					interview.ID,
+					interview.ApplicationID,
+					interview.Application.Job.Title,
+					when,
+					rescheduled,
+					r.link,
+				)
+			}
+		}()
+	}
+}
+
+// sendCancellations withdraws the calendar event, if a time was confirmed, and notifies all
+// participants (async). When notify is false only the calendar event is withdrawn, e.g. before
+// new times are proposed.
+func (s *InterviewService) sendCancellations(interview *domain.Interview, reason string, notify bool) {
+	var invite *email.Attachment
+	if interview.ScheduledStart != nil && interview.ScheduledEnd != nil {
+		invite = s.buildInvite(interview, calendar.MethodCancel)
+	}
+
+	for _, r := range s.recipients(interview) {
+		r := r
+		go func() {
+			if s.emailService != nil && invite != nil {
+				data := s.emailData(interview, r)
+				data.Heading = "Interview Cancelled"
+				data.Message = "The interview below has been cancelled."
+				if !notify {
+					data.Message = "The interview time below has been withdrawn. New times will be proposed shortly."
+				}
+				data.Reason = reason
+				data.Invite = invite
+				if err := s.emailService.SendInterviewCancellation(data); err != nil {
+					log.Printf("Failed to send interview cancellation to %s: %v", r.user.Email, err)
+				}
+			}
+			if notify && s.notificationService != nil {
+				_ = s.notificationService.NotifyInterviewCancelled(
+					context.Background(),
+					r.user.ID,
+					interview.ID,
+					interview.ApplicationID,
+					interview.Application.Job.Title,
+					reason,
+					r.link,
+				)
+			}
+		}()
+	}
+}
+
+// notifyProposed asks the candidate to pick one of the proposed slots (async)
+func (s *InterviewService) notifyProposed(interview *domain.Interview) {
+	if s.notificationService == nil {
+		return
+	}
+	go func() {
+		_ = s.notificationService.NotifyInterviewProposed(
+			context.Background(),
+			interview.Application.ApplicantID,
+			interview.ID,
+			interview.ApplicationID,
+			interview.Application.Job.Title,
+			len(interview.Slots),
+		)
+	}()
+}
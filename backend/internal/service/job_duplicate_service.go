@@ -0,0 +1,607 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"hash/fnv"
+	"job-platform/internal/domain"
+	"job-platform/internal/repository"
+	"math/bits"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+const (
+	// DuplicateScoreThreshold is the minimum similarity score for two jobs to be flagged as duplicates
+	DuplicateScoreThreshold = 0.85
+	// duplicateTitleThreshold is the minimum title overlap before descriptions are compared
+	duplicateTitleThreshold = 0.5
+	// fingerprintBatchSize is how many jobs are fingerprinted per batch when rebuilding
+	fingerprintBatchSize = 200
+)
+
+// titleAbbreviations expands common abbreviations so "Sr. Software Eng" matches "Senior Software Engineer"
+var titleAbbreviations = map[string]string{
+	"sr":    "senior",
+	"snr":   "senior",
+	"jr":    "junior",
+	"jnr":   "junior",
+	"mgr":   "manager",
+	"eng":   "engineer",
+	"engr":  "engineer",
+	"dev":   "developer",
+	"swe":   "software engineer",
+	"fe":    "frontend",
+	"be":    "backend",
+	"ops":   "operations",
+	"assoc": "associate",
+	"asst":  "assistant",
+	"admin": "administrator",
+	"ii":    "2",
+	"iii":   "3",
+}
+
+// titleNoiseWords are dropped from titles because they don't change the role
+var titleNoiseWords = map[string]bool{
+	"a": true, "an": true, "the": true, "and": true, "of": true, "for": true, "in": true, "at": true,
+	"m": true, "f": true, "d": true, "w": true, "x": true, "all": true, "genders": true,
+	"remote": true, "hybrid": true, "onsite": true, "fulltime": true, "parttime": true,
+	"full": true, "part": true, "time": true, "urgent": true, "hiring": true, "new": true,
+}
+
+// companySuffixes are legal suffixes dropped from company names
+var companySuffixes = map[string]bool{
+	"inc": true, "incorporated": true, "llc": true, "ltd": true, "limited": true, "corp": true,
+	"corporation": true, "co": true, "company": true, "gmbh": true, "ag": true, "plc": true,
+	"sa": true, "bv": true, "pvt": true, "private": true, "pty": true, "lp": true, "llp": true,
+}
+
+// JobDuplicateMatch is an existing job that looks like a duplicate of another job
+type JobDuplicateMatch struct {
+	JobID uuid.UUID
+	Score float64
+}
+
+// JobDuplicateService fingerprints jobs, flags likely duplicates and merges them
+type JobDuplicateService struct {
+	duplicateRepo   *repository.JobDuplicateRepository
+	jobRepo         *repository.JobRepository
+	companyRepo     *repository.CompanyRepository
+	pipelineService *PipelineService
+	db              *gorm.DB
+}
+
+// NewJobDuplicateService creates a new job duplicate service
+func NewJobDuplicateService(
+	duplicateRepo *repository.JobDuplicateRepository,
+	jobRepo *repository.JobRepository,
+	db *gorm.DB,
+) *JobDuplicateService {
+	companyRepo := repository.NewCompanyRepository(db)
+	return &JobDuplicateService{
+		duplicateRepo:   duplicateRepo,
+		jobRepo:         jobRepo,
+		companyRepo:     companyRepo,
+		pipelineService: NewPipelineService(repository.NewPipelineRepository(db), companyRepo),
+		db:              db,
+	}
+}
+
+// ============================================================
+// DETECTION
+// ============================================================
+
+// Fingerprint computes the fingerprint of a job
+func (s *JobDuplicateService) Fingerprint(job *domain.Job) *domain.JobFingerprint {
+	return &domain.JobFingerprint{
+		JobID:           job.ID,
+		TitleKey:        normalizeJobTitle(job.Title),
+		CompanyKey:      normalizeCompanyName(job.CompanyName),
+		LocationKey:     normalizeLocation(job.Location),
+		DescriptionHash: int64(simhash(job.Description)),
+		UpdatedAt:       time.Now(),
+	}
+}
+
+// FindDuplicates finds existing jobs that look like duplicates of a job, best match first.
+// The job doesn't need to be saved, so scraped jobs can be checked before they are created.
+func (s *JobDuplicateService) FindDuplicates(job *domain.Job) ([]JobDuplicateMatch, error) {
+	scores := make(map[uuid.UUID]float64)
+
+	// The same source URL is always the same job
+	if job.OriginalURL != nil && *job.OriginalURL != "" {
+		existingID, err := s.duplicateRepo.GetJobIDByOriginalURL(*job.OriginalURL, job.ID)
+		if err != nil {
+			return nil, err
+		}
+		if existingID != nil {
+			scores[*existingID] = 1
+		}
+	}
+
+	fingerprint := s.Fingerprint(job)
+	if fingerprint.CompanyKey != "" && fingerprint.TitleKey != "" {
+		candidates, err := s.duplicateRepo.GetCandidates(fingerprint.CompanyKey, fingerprint.TitleKey, job.ID)
+		if err != nil {
+			return nil, err
+		}
+		for i := range candidates {
+			score := fingerprintSimilarity(fingerprint, &candidates[i])
+			if score >= DuplicateScoreThreshold && score > scores[candidates[i].JobID] {
+				scores[candidates[i].JobID] = score
+			}
+		}
+	}
+
+	matches := make([]JobDuplicateMatch, 0, len(scores))
+	for jobID, score := range scores {
+		matches = append(matches, JobDuplicateMatch{JobID: jobID, Score: score})
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+	return matches, nil
+}
+
+// FindDuplicate returns the best duplicate match for a job, or nil if there is none
+func (s *JobDuplicateService) FindDuplicate(job *domain.Job) (*JobDuplicateMatch, error) {
+	matches, err := s.FindDuplicates(job)
+	if err != nil || len(matches) == 0 {
+		return nil, err
+	}
+	return &matches[0], nil
+}
+
+// CheckJob refreshes the fingerprint of a saved job and flags the existing jobs it duplicates
+func (s *JobDuplicateService) CheckJob(job *domain.Job) error {
+	if err := s.duplicateRepo.UpsertFingerprint(s.Fingerprint(job)); err != nil {
+		return err
+	}
+
+	matches, err := s.FindDuplicates(job)
+	if err != nil {
+		return err
+	}
+
+	for _, match := range matches {
+		// A pair is only flagged once, whichever job was saved last
+		exists, err := s.duplicateRepo.FlagExists(job.ID, match.JobID)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+
+		flag := &domain.JobDuplicate{
+			ID:            uuid.New(),
+			JobID:         job.ID,
+			DuplicateOfID: match.JobID,
+			Score:         match.Score,
+			Status:        domain.JobDuplicateStatusPending,
+		}
+		if err := s.duplicateRepo.CreateFlag(flag); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RebuildFingerprints fingerprints every live job, e.g. after the normalisation rules changed.
+// It returns the number of jobs fingerprinted.
+func (s *JobDuplicateService) RebuildFingerprints() (int, error) {
+	count := 0
+	var afterID *uuid.UUID
+
+	for {
+		jobs, err := s.duplicateRepo.GetJobsForFingerprinting(afterID, fingerprintBatchSize)
+		if err != nil {
+			return count, err
+		}
+		if len(jobs) == 0 {
+			return count, nil
+		}
+
+		for i := range jobs {
+			if err := s.duplicateRepo.UpsertFingerprint(s.Fingerprint(&jobs[i])); err != nil {
+				return count, err
+			}
+			count++
+		}
+
+		afterID = &jobs[len(jobs)-1].ID
+	}
+}
+
+// ============================================================
+// REVIEW (ADMIN)
+// ============================================================
+
+// ListDuplicates retrieves duplicate flags, optionally filtered by status
+func (s *JobDuplicateService) ListDuplicates(status *domain.JobDuplicateStatus, limit, offset int) ([]domain.JobDuplicate, int64, error) {
+	return s.duplicateRepo.ListFlags(status, limit, offset)
+}
+
+// GetJobDuplicates retrieves the duplicate flags a job is part of
+func (s *JobDuplicateService) GetJobDuplicates(jobID uuid.UUID) ([]domain.JobDuplicate, error) {
+	if _, err := s.jobRepo.GetByID(jobID); err != nil {
+		return nil, domain.ErrJobNotFound
+	}
+	return s.duplicateRepo.GetFlagsByJobID(jobID)
+}
+
+// DismissDuplicate marks a flag as reviewed without merging the jobs
+func (s *JobDuplicateService) DismissDuplicate(flagID, adminID uuid.UUID) (*domain.JobDuplicate, error) {
+	flag, err := s.getPendingFlag(flagID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	flag.Status = domain.JobDuplicateStatusDismissed
+	flag.ResolvedBy = &adminID
+	flag.ResolvedAt = &now
+
+	if err := s.duplicateRepo.UpdateFlag(flag); err != nil {
+		return nil, err
+	}
+
+	return flag, nil
+}
+
+// MergeDuplicate merges the two jobs of a flag. The job to keep defaults to the older job.
+// Applications, saved jobs and views move to the kept job, and the other job is closed and deleted.
+// Jobs of different employers or companies are not merged, their applicants applied to someone else.
+func (s *JobDuplicateService) MergeDuplicate(flagID, adminID uuid.UUID, keepJobID *uuid.UUID) (*domain.JobDuplicate, error) {
+	flag, err := s.getPendingFlag(flagID)
+	if err != nil {
+		return nil, err
+	}
+
+	keepID, removeID := flag.DuplicateOfID, flag.JobID
+	if keepJobID != nil {
+		switch *keepJobID {
+		case flag.DuplicateOfID:
+		case flag.JobID:
+			keepID, removeID = flag.JobID, flag.DuplicateOfID
+		default:
+			return nil, domain.ErrInvalidMergeTarget
+		}
+	}
+
+	removed, err := s.jobRepo.GetByID(removeID)
+	if err != nil {
+		return nil, domain.ErrJobNotFound
+	}
+	kept, err := s.jobRepo.GetByID(keepID)
+	if err != nil {
+		return nil, domain.ErrJobNotFound
+	}
+
+	sameOwner, err := s.sameOwner(kept, removed)
+	if err != nil {
+		return nil, err
+	}
+	if !sameOwner {
+		return nil, domain.ErrMergeAcrossEmployers
+	}
+
+	// Moved applications continue in the kept job's pipeline
+	pipeline, err := s.pipelineService.GetJobPipeline(kept)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := s.db.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := mergeJobs(tx, kept, removed, pipeline); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	now := time.Now()
+	flag.Status = domain.JobDuplicateStatusMerged
+	flag.ResolvedBy = &adminID
+	flag.ResolvedAt = &now
+
+	txDuplicateRepo := repository.NewJobDuplicateRepository(tx)
+	if err := txDuplicateRepo.UpdateFlag(flag); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	// Other flags of the removed job no longer need a review
+	if err := txDuplicateRepo.DismissPendingFlags(removeID, adminID); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+
+	return s.duplicateRepo.GetFlag(flag.ID)
+}
+
+// sameOwner reports whether two jobs belong to the same company, or to the same employer
+// when neither belongs to a company
+func (s *JobDuplicateService) sameOwner(a, b *domain.Job) (bool, error) {
+	companyA, err := s.jobCompanyID(a)
+	if err != nil {
+		return false, err
+	}
+	companyB, err := s.jobCompanyID(b)
+	if err != nil {
+		return false, err
+	}
+
+	if companyA != nil || companyB != nil {
+		return companyA != nil && companyB != nil && *companyA == *companyB, nil
+	}
+	return a.EmployerID == b.EmployerID, nil
+}
+
+// jobCompanyID returns the ID of the company a job belongs to, or nil if it has none
+func (s *JobDuplicateService) jobCompanyID(job *domain.Job) (*uuid.UUID, error) {
+	company, err := s.companyRepo.GetJobCompany(job)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &company.ID, nil
+}
+
+// mergeJobs moves the activity of a job to the kept job and removes it.
+// Candidates who applied to or saved both jobs keep their record on the kept job.
+// Moved applications are placed in the kept job's pipeline stage for their status, and their
+// screening answers are linked to the kept job's questions with the same text.
+func mergeJobs(tx *gorm.DB, kept, removed *domain.Job, pipeline *domain.Pipeline) error {
+	var moved []domain.Application
+	if err := tx.
+		Where("job_id = ? AND applicant_id NOT IN (SELECT applicant_id FROM applications WHERE job_id = ?)", removed.ID, kept.ID).
+		Find(&moved).Error; err != nil {
+		return err
+	}
+
+	questions := make(map[string]*domain.ScreeningQuestion, len(kept.ScreeningQuestions))
+	for i := range kept.ScreeningQuestions {
+		q := &kept.ScreeningQuestions[i]
+		questions[strings.ToLower(strings.TrimSpace(q.Question))] = q
+	}
+
+	for i := range moved {
+		app := &moved[i]
+		updates := map[string]interface{}{
+			"job_id":   kept.ID,
+			"stage_id": persistedStageID(pipeline.CurrentStage(app)),
+		}
+		if answers, ok := remapScreeningAnswers(app.Answers, questions); ok {
+			updates["answers"] = answers
+		}
+		if err := tx.Model(&domain.Application{}).Where("id = ?", app.ID).Updates(updates).Error; err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Exec(`
+		UPDATE saved_jobs SET job_id = ?
+		WHERE job_id = ? AND user_id NOT IN (SELECT user_id FROM saved_jobs WHERE job_id = ?)`,
+		kept.ID, removed.ID, kept.ID).Error; err != nil {
+		return err
+	}
+
+	if err := tx.Exec(`
+		UPDATE jobs SET
+			views_count = views_count + ?,
+			applications_count = (SELECT COUNT(*) FROM applications WHERE job_id = ?)
+		WHERE id = ?`,
+		removed.ViewsCount, kept.ID, kept.ID).Error; err != nil {
+		return err
+	}
+
+	return tx.Model(&domain.Job{}).
+		Where("id = ?", removed.ID).
+		Updates(map[string]interface{}{
+			"status":             domain.JobStatusClosed,
+			"applications_count": gorm.Expr("(SELECT COUNT(*) FROM applications WHERE job_id = ?)", removed.ID),
+			"deleted_at":         time.Now(),
+		}).Error
+}
+
+// remapScreeningAnswers links answers to the questions with the same text and type.
+// Answers without a matching question keep their question text. Returns false when nothing changed.
+func remapScreeningAnswers(data datatypes.JSON, questions map[string]*domain.ScreeningQuestion) (datatypes.JSON, bool) {
+	if len(data) == 0 || len(questions) == 0 {
+		return nil, false
+	}
+
+	var answers []domain.ScreeningAnswer
+	if err := json.Unmarshal(data, &answers); err != nil {
+		return nil, false
+	}
+
+	changed := false
+	for i := range answers {
+		q, ok := questions[strings.ToLower(strings.TrimSpace(answers[i].Question))]
+		if ok && q.Type == answers[i].Type && q.ID != answers[i].QuestionID {
+			answers[i].QuestionID = q.ID
+			changed = true
+		}
+	}
+	if !changed {
+		return nil, false
+	}
+
+	remapped, err := json.Marshal(answers)
+	if err != nil {
+		return nil, false
+	}
+	return datatypes.JSON(remapped), true
+}
+
+// getPendingFlag retrieves a flag that has not been reviewed yet
+func (s *JobDuplicateService) getPendingFlag(flagID uuid.UUID) (*domain.JobDuplicate, error) {
+	flag, err := s.duplicateRepo.GetFlag(flagID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrJobDuplicateNotFound
+		}
+		return nil, err
+	}
+	if flag.Status != domain.JobDuplicateStatusPending {
+		return nil, domain.ErrJobDuplicateResolved
+	}
+	return flag, nil
+}
+
+// ============================================================
+// FINGERPRINTING HELPERS
+// ============================================================
+
+// fingerprintSimilarity scores how alike two fingerprints are, from 0 to 1
+func fingerprintSimilarity(a, b *domain.JobFingerprint) float64 {
+	// The same role in two cities is two jobs
+	if a.LocationKey != "" && b.LocationKey != "" && a.LocationKey != b.LocationKey {
+		return 0
+	}
+
+	titleScore := jaccard(strings.Fields(a.TitleKey), strings.Fields(b.TitleKey))
+	if titleScore < duplicateTitleThreshold {
+		return 0
+	}
+
+	// Without both descriptions the title has to carry the decision
+	if a.DescriptionHash == 0 || b.DescriptionHash == 0 {
+		return titleScore
+	}
+
+	distance := bits.OnesCount64(uint64(a.DescriptionHash) ^ uint64(b.DescriptionHash))
+	descriptionScore := 1 - float64(distance)/64
+	return 0.4*titleScore + 0.6*descriptionScore
+}
+
+// normalizeJobTitle lowercases a title, expands abbreviations and drops noise words
+func normalizeJobTitle(title string) string {
+	var tokens []string
+	for _, word := range tokenize(title) {
+		if expanded, ok := titleAbbreviations[word]; ok {
+			word = expanded
+		}
+		for _, token := range strings.Fields(word) {
+			if !titleNoiseWords[token] {
+				tokens = append(tokens, token)
+			}
+		}
+	}
+	return strings.Join(tokens, " ")
+}
+
+// normalizeCompanyName lowercases a company name and drops legal suffixes
+func normalizeCompanyName(name string) string {
+	var tokens []string
+	for _, token := range tokenize(name) {
+		if !companySuffixes[token] {
+			tokens = append(tokens, token)
+		}
+	}
+	return strings.Join(tokens, " ")
+}
+
+// normalizeLocation keeps the city part of a location, e.g. "Berlin, Germany" becomes "berlin"
+func normalizeLocation(location string) string {
+	city := strings.SplitN(location, ",", 2)[0]
+	key := strings.Join(tokenize(city), " ")
+	if key == "remote" || key == "anywhere" || key == "worldwide" {
+		return ""
+	}
+	return key
+}
+
+// tokenize lowercases text and splits it into alphanumeric words
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// simhash computes a 64-bit simhash over the 3-word shingles of a description.
+// Similar descriptions get hashes with a small Hamming distance. Empty descriptions hash to 0.
+func simhash(description string) uint64 {
+	words := tokenize(stripHTMLTagsSimple(description))
+	if len(words) == 0 {
+		return 0
+	}
+
+	var shingles []string
+	if len(words) < 3 {
+		shingles = []string{strings.Join(words, " ")}
+	} else {
+		for i := 0; i+3 <= len(words); i++ {
+			shingles = append(shingles, strings.Join(words[i:i+3], " "))
+		}
+	}
+
+	var weights [64]int
+	for _, shingle := range shingles {
+		h := fnv.New64a()
+		h.Write([]byte(shingle))
+		sum := h.Sum64()
+		for bit := 0; bit < 64; bit++ {
+			if sum&(1<<uint(bit)) != 0 {
+				weights[bit]++
+			} else {
+				weights[bit]--
+			}
+		}
+	}
+
+	var hash uint64
+	for bit := 0; bit < 64; bit++ {
+		if weights[bit] > 0 {
+			hash |= 1 << uint(bit)
+		}
+	}
+	return hash
+}
+
+// jaccard computes the overlap of two token sets
+func jaccard(a, b []string) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 1
+	}
+
+	set := make(map[string]bool, len(a))
+	for _, token := range a {
+		set[token] = true
+	}
+
+	union := len(set)
+	intersection := 0
+	seen := make(map[string]bool, len(b))
+	for _, token := range b {
+		if seen[token] {
+			continue
+		}
+		seen[token] = true
+		if set[token] {
+			intersection++
+		} else {
+			union++
+		}
+	}
+
+	return float64(intersection) / float64(union)
+}
@@ -1,10 +1,14 @@
 package service
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"job-platform/internal/cache"
 	"job-platform/internal/domain"
 	"job-platform/internal/repository"
 	"job-platform/internal/util/slug"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -18,6 +22,13 @@ type JobService struct {
 	applicationRepo *repository.ApplicationRepository
 	viewRepo        *repository.JobViewRepository
 	userRepo        *repository.UserRepository
+	companyRepo     *repository.CompanyRepository
+	teamRepo        *repository.TeamRepository
+	revisionRepo    *repository.JobRevisionRepository
+	dedupService    *JobDuplicateService
+	webhookService  *WebhookService
+	searchService   *SearchService
+	cacheService    *cache.CacheService
 	db              *gorm.DB
 	config          *JobConfig
 }
@@ -47,11 +58,113 @@ func NewJobService(
 		applicationRepo: applicationRepo,
 		viewRepo:        viewRepo,
 		userRepo:        userRepo,
+		revisionRepo:    repository.NewJobRevisionRepository(db),
 		db:              db,
 		config:          config,
 	}
 }
 
+// SetTeamRepositories sets the repositories used to resolve a job's company team
+func (s *JobService) SetTeamRepositories(companyRepo *repository.CompanyRepository, teamRepo *repository.TeamRepository) {
+	s.companyRepo = companyRepo
+	s.teamRepo = teamRepo
+}
+
+// SetDuplicateService sets the service used to flag duplicate jobs
+func (s *JobService) SetDuplicateService(dedupService *JobDuplicateService) {
+	s.dedupService = dedupService
+}
+
+// SetWebhookService sets the service used to send job events to company webhooks
+func (s *JobService) SetWebhookService(webhookService *WebhookService) {
+	s.webhookService = webhookService
+}
+
+// SetSearchAndCache sets the search index and cache that jobs closed in the background are removed from
+func (s *JobService) SetSearchAndCache(searchService *SearchService, cacheService *cache.CacheService) {
+	s.searchService = searchService
+	s.cacheService = cacheService
+}
+
+// CloseIfFilled closes an active job once its hired applications fill all its openings, and
+// then handles it like any other job closed in the background. It reports whether the job was closed.
+func (s *JobService) CloseIfFilled(ctx context.Context, jobID uuid.UUID) (bool, error) {
+	closed, err := s.jobRepo.CloseIfFilled(jobID)
+	if err != nil || !closed {
+		return closed, err
+	}
+
+	job, err := s.jobRepo.GetByID(jobID)
+	if err != nil {
+		return true, err
+	}
+	s.HandleClosedJobs(ctx, []domain.Job{*job})
+	return true, nil
+}
+
+// HandleClosedJobs removes jobs that were closed without an explicit close request (crawls,
+// filled openings) from the search index, drops the cached job lists, feeds and sitemaps that may still
+// contain them and dispatches job.closed
+func (s *JobService) HandleClosedJobs(ctx context.Context, jobs []domain.Job) {
+	if len(jobs) == 0 {
+		return
+	}
+
+	if s.searchService != nil && s.searchService.IsAvailable() {
+		for i := range jobs {
+			if err := s.searchService.DeleteJob(&jobs[i]); err != nil {
+				fmt.Printf("Failed to remove closed job %s from search index: %v\n", jobs[i].ID, err)
+			}
+		}
+	}
+
+	if s.cacheService != nil && s.cacheService.IsAvailable() {
+		if err := s.cacheService.InvalidateAllJobCaches(ctx); err != nil {
+			fmt.Printf("Failed to invalidate job caches after closing jobs: %v\n", err)
+		}
+		if err := s.cacheService.InvalidateLocations(ctx); err != nil {
+			fmt.Printf("Failed to invalidate location cache after closing jobs: %v\n", err)
+		}
+	}
+
+	if s.webhookService != nil {
+		for i := range jobs {
+			s.webhookService.JobClosed(&jobs[i])
+		}
+	}
+}
+
+// dispatchPublished sends job.published when a job went live
+func (s *JobService) dispatchPublished(job *domain.Job) {
+	if s.webhookService != nil && job.Status == domain.JobStatusActive {
+		s.webhookService.JobPublished(job)
+	}
+}
+
+// checkDuplicates flags the jobs a saved job duplicates. Failures are logged and don't fail the save.
+func (s *JobService) checkDuplicates(job *domain.Job) {
+	if s.dedupService == nil {
+		return
+	}
+	if err := s.dedupService.CheckJob(job); err != nil {
+		fmt.Printf("Failed to check job %s for duplicates: %v\n", job.ID, err)
+	}
+}
+
+// FindDuplicateJob returns the existing job that an unsaved job duplicates, or nil if there is none
+func (s *JobService) FindDuplicateJob(job *domain.Job) (*JobDuplicateMatch, error) {
+	if s.dedupService == nil {
+		return nil, nil
+	}
+	return s.dedupService.FindDuplicate(job)
+}
+
+// MaxJobOpenings is the maximum headcount of a job
+const MaxJobOpenings = 1000
+
+// MaxScreeningQuestions is the maximum number of screening questions per job
+const MaxScreeningQuestions = 20
+
 // CreateJobInput represents input for creating a job
 type CreateJobInput struct {
 	Title              string
@@ -79,18 +192,41 @@ type CreateJobInput struct {
 	CategoryIDs        []uuid.UUID
 	ApplicationURL     string
 	ApplicationEmail   string
+	ScreeningQuestions []ScreeningQuestionInput
+	Openings           int        // Defaults to 1
+	RequisitionID      string
+	HiringManagerID    *uuid.UUID // Company team member ID
+	SaveAsDraft        bool       // Keeps the job as a draft instead of submitting it
+	PublishAt          *time.Time // Schedules publishing; nil or a past time publishes immediately
+	// OnCreate runs in the transaction that creates the job, so callers can record the job together with it
+	OnCreate func(tx *gorm.DB, job *domain.Job) error
+}
+
+// ScreeningQuestionInput represents a screening question defined on a job
+type ScreeningQuestionInput struct {
+	ID              *uuid.UUID // Existing question of the job to update, keeping its ID
+	Question        string
+	Type            domain.ScreeningQuestionType
+	Options         []string
+	MinValue        *float64
+	MaxValue        *float64
+	IsRequired      bool
+	KnockoutAction  domain.KnockoutAction
+	KnockoutAnswers []string
 }
 
 // AdminCreateJobInput represents input for admin creating a job
 type AdminCreateJobInput struct {
 	CreateJobInput
+	CompanyID      *uuid.UUID // Optional: link the job to a company profile
 	CompanyName    string
 	CompanyLogoURL string
 	Status         string // Optional: ACTIVE, DRAFT, PENDING_APPROVAL
 	// Scraper fields
 	OriginalURL  *string
 	ScrapedData  *string
-	ScrapeStatus string // manual, scraped, failed
+	ScrapeStatus string     // manual, scraped, failed
+	JobSourceID  *uuid.UUID // Set when a job source crawl imported the job
 }
 
 // UpdateJobInput represents input for updating a job
@@ -120,6 +256,11 @@ type UpdateJobInput struct {
 	CategoryIDs        []uuid.UUID
 	ApplicationURL     *string
 	ApplicationEmail   *string
+	ScreeningQuestions []ScreeningQuestionInput // nil leaves questions unchanged, empty removes them
+	Openings           *int
+	RequisitionID      *string
+	HiringManagerID    *uuid.UUID // Company team member ID
+	ClearHiringManager bool       // Removes the hiring manager
 }
 
 // AdminUpdateJobInput represents input for admin updating a job
@@ -152,6 +293,19 @@ func (s *JobService) CreateJob(employerID uuid.UUID, input CreateJobInput) (*dom
 		return nil, domain.ErrMaxJobsReached
 	}
 
+	questions, err := buildScreeningQuestions(input.ScreeningQuestions, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	openings := input.Openings
+	if openings == 0 {
+		openings = 1
+	}
+	if openings < 1 || openings > MaxJobOpenings {
+		return nil, domain.ErrInvalidOpenings
+	}
+
 	// Generate unique slug
 	baseSlug := slug.Generate(input.Title)
 	finalSlug := slug.MakeUnique(baseSlug, func(slugStr string) bool {
@@ -170,12 +324,6 @@ func (s *JobService) CreateJob(employerID uuid.UUID, input CreateJobInput) (*dom
 		companyLogoURL = *employer.Profile.AvatarURL
 	}
 
-	// Determine initial status
-	status := domain.JobStatusActive
-	if s.config.ModerationEnabled {
-		status = domain.JobStatusPendingApproval
-	}
-
 	// Set expiry date
 	expiresAt := time.Now().AddDate(0, 0, s.config.DefaultExpiryDays)
 
@@ -210,13 +358,24 @@ func (s *JobService) CreateJob(employerID uuid.UUID, input CreateJobInput) (*dom
 		Benefits:           input.Benefits,
 		ApplicationURL:     input.ApplicationURL,
 		ApplicationEmail:   input.ApplicationEmail,
-		Status:             status,
+		Status:             domain.JobStatusDraft,
 		ExpiresAt:          &expiresAt,
+		ScreeningQuestions: questions,
+		Openings:           openings,
+		RequisitionID:      strings.TrimSpace(input.RequisitionID),
 	}
 
-	if status == domain.JobStatusActive {
-		now := time.Now()
-		job.PublishedAt = &now
+	if input.HiringManagerID != nil {
+		if err := s.resolveHiringManager(job, *input.HiringManagerID); err != nil {
+			return nil, err
+		}
+	}
+
+	// Drafts stay private, everything else goes through review, moderation and scheduling
+	if !input.SaveAsDraft {
+		if err := s.submitJob(job, input.PublishAt); err != nil {
+			return nil, err
+		}
 	}
 
 	// Create job in transaction
@@ -247,10 +406,20 @@ func (s *JobService) CreateJob(employerID uuid.UUID, input CreateJobInput) (*dom
 		}
 	}
 
+	if input.OnCreate != nil {
+		if err := input.OnCreate(tx, job); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
 	if err := tx.Commit().Error; err != nil {
 		return nil, err
 	}
 
+	s.checkDuplicates(job)
+	s.dispatchPublished(job)
+
 	// Reload job with associations
 	return s.jobRepo.GetByID(job.ID)
 }
@@ -268,6 +437,11 @@ func (s *JobService) AdminCreateJob(adminID uuid.UUID, input AdminCreateJobInput
 		return nil, domain.ErrInvalidRole
 	}
 
+	questions, err := buildScreeningQuestions(input.ScreeningQuestions, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	// Generate unique slug
 	baseSlug := slug.Generate(input.Title)
 	finalSlug := slug.MakeUnique(baseSlug, func(slugStr string) bool {
@@ -301,6 +475,7 @@ func (s *JobService) AdminCreateJob(adminID uuid.UUID, input AdminCreateJobInput
 	job := &domain.Job{
 		ID:                 uuid.New(),
 		EmployerID:         adminID, // Use admin ID as employer ID
+		CompanyID:          input.CompanyID,
 		Title:              input.Title,
 		Slug:               finalSlug,
 		Description:        input.Description,
@@ -330,10 +505,12 @@ func (s *JobService) AdminCreateJob(adminID uuid.UUID, input AdminCreateJobInput
 		ApplicationEmail:   input.ApplicationEmail,
 		Status:             status,
 		ExpiresAt:          &expiresAt,
+		ScreeningQuestions: questions,
 		// Scraper fields
 		OriginalURL:  input.OriginalURL,
 		ScrapedData:  input.ScrapedData,
 		ScrapeStatus: scrapeStatus,
+		JobSourceID:  input.JobSourceID,
 	}
 
 	if status == domain.JobStatusActive {
@@ -369,14 +546,31 @@ func (s *JobService) AdminCreateJob(adminID uuid.UUID, input AdminCreateJobInput
 		}
 	}
 
+	if input.OnCreate != nil {
+		if err := input.OnCreate(tx, job); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
 	if err := tx.Commit().Error; err != nil {
 		return nil, err
 	}
 
+	s.checkDuplicates(job)
+	s.dispatchPublished(job)
+
 	// Reload job with associations
 	return s.jobRepo.GetByID(job.ID)
 }
 
+// jobChange describes who changed a job and how, for its revision history
+type jobChange struct {
+	changedBy    uuid.UUID
+	source       domain.JobRevisionSource
+	restoredFrom *int // Revision number, set when a revision is restored
+}
+
 // UpdateJob updates an existing job
 func (s *JobService) UpdateJob(jobID, employerID uuid.UUID, input UpdateJobInput) (*domain.Job, error) {
 	// Get job
@@ -390,6 +584,13 @@ func (s *JobService) UpdateJob(jobID, employerID uuid.UUID, input UpdateJobInput
 		return nil, domain.ErrJobNotOwnedByEmployer
 	}
 
+	return s.updateJob(job, input, jobChange{changedBy: employerID, source: domain.JobRevisionSourceEmployer})
+}
+
+// updateJob applies an employer update to a job and records the new revision
+func (s *JobService) updateJob(job *domain.Job, input UpdateJobInput, change jobChange) (*domain.Job, error) {
+	before := domain.NewJobSnapshot(job)
+
 	// Update fields if provided
 	if input.Title != nil {
 		job.Title = *input.Title
@@ -474,6 +675,32 @@ func (s *JobService) UpdateJob(jobID, employerID uuid.UUID, input UpdateJobInput
 	if input.ApplicationEmail != nil {
 		job.ApplicationEmail = *input.ApplicationEmail
 	}
+	if input.Openings != nil {
+		if *input.Openings < 1 || *input.Openings > MaxJobOpenings {
+			return nil, domain.ErrInvalidOpenings
+		}
+		job.Openings = *input.Openings
+	}
+	if input.RequisitionID != nil {
+		job.RequisitionID = strings.TrimSpace(*input.RequisitionID)
+	}
+	if input.ClearHiringManager {
+		job.HiringManagerID = nil
+		job.HiringManager = nil
+	} else if input.HiringManagerID != nil {
+		if err := s.resolveHiringManager(job, *input.HiringManagerID); err != nil {
+			return nil, err
+		}
+	}
+
+	var questions []domain.ScreeningQuestion
+	if input.ScreeningQuestions != nil {
+		var err error
+		questions, err = buildScreeningQuestions(input.ScreeningQuestions, job.ScreeningQuestions)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// Update in transaction
 	tx := s.db.Begin()
@@ -497,22 +724,87 @@ func (s *JobService) UpdateJob(jobID, employerID uuid.UUID, input UpdateJobInput
 		}
 	}
 
+	// Update screening questions if provided
+	if input.ScreeningQuestions != nil {
+		if err := jobRepoTx.SaveScreeningQuestions(job.ID, questions); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	// Lowering the openings to the number already hired fills the job
+	filled := false
+	if input.Openings != nil {
+		closed, err := jobRepoTx.CloseIfFilled(job.ID)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		filled = closed
+	}
+
+	if err := s.recordRevision(tx, job, before, change); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
 	if err := tx.Commit().Error; err != nil {
 		return nil, err
 	}
 
+	s.checkDuplicates(job)
+
 	// Reload job with associations
-	return s.jobRepo.GetByID(job.ID)
+	updated, err := s.jobRepo.GetByID(job.ID)
+	if err != nil {
+		return nil, err
+	}
+	if filled {
+		s.HandleClosedJobs(context.Background(), []domain.Job{*updated})
+	}
+	return updated, nil
+}
+
+// resolveHiringManager validates that a team member belongs to the job's company and assigns it
+func (s *JobService) resolveHiringManager(job *domain.Job, memberID uuid.UUID) error {
+	if s.companyRepo == nil || s.teamRepo == nil {
+		return domain.ErrInvalidHiringManager
+	}
+
+	company, err := s.companyRepo.GetJobCompany(job)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.ErrInvalidHiringManager
+		}
+		return err
+	}
+
+	member, err := s.teamRepo.GetByID(memberID)
+	if err != nil || member.CompanyID != company.ID || member.Status != domain.TeamMemberStatusActive {
+		return domain.ErrInvalidHiringManager
+	}
+
+	job.HiringManagerID = &member.ID
+	job.HiringManager = nil
+	return nil
 }
 
 // AdminUpdateJob updates a job by admin (no ownership check)
-func (s *JobService) AdminUpdateJob(jobID uuid.UUID, input AdminUpdateJobInput) (*domain.Job, error) {
+func (s *JobService) AdminUpdateJob(jobID, adminID uuid.UUID, input AdminUpdateJobInput) (*domain.Job, error) {
 	// Get job
 	job, err := s.jobRepo.GetByID(jobID)
 	if err != nil {
 		return nil, domain.ErrJobNotFound
 	}
 
+	return s.adminUpdateJob(job, input, jobChange{changedBy: adminID, source: domain.JobRevisionSourceAdmin})
+}
+
+// adminUpdateJob applies an admin update to a job and records the new revision
+func (s *JobService) adminUpdateJob(job *domain.Job, input AdminUpdateJobInput, change jobChange) (*domain.Job, error) {
+	before := domain.NewJobSnapshot(job)
+	wasUnpublished := job.IsUnpublished()
+
 	// Update fields if provided
 	if input.Title != nil {
 		job.Title = *input.Title
@@ -609,6 +901,15 @@ func (s *JobService) AdminUpdateJob(jobID uuid.UUID, input AdminUpdateJobInput)
 		job.Status = domain.JobStatus(*input.Status)
 	}
 
+	var questions []domain.ScreeningQuestion
+	if input.ScreeningQuestions != nil {
+		var err error
+		questions, err = buildScreeningQuestions(input.ScreeningQuestions, job.ScreeningQuestions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Update in transaction
 	tx := s.db.Begin()
 	defer func() {
@@ -631,14 +932,286 @@ func (s *JobService) AdminUpdateJob(jobID uuid.UUID, input AdminUpdateJobInput)
 		}
 	}
 
+	// Update screening questions if provided
+	if input.ScreeningQuestions != nil {
+		if err := jobRepoTx.SaveScreeningQuestions(job.ID, questions); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if err := s.recordRevision(tx, job, before, change); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
 	if err := tx.Commit().Error; err != nil {
 		return nil, err
 	}
 
+	s.checkDuplicates(job)
+	if wasUnpublished {
+		s.dispatchPublished(job)
+	}
+
 	// Reload job with associations
 	return s.jobRepo.GetByID(job.ID)
 }
 
+// recordRevision stores the job's content after an update as a new revision when it changed.
+// The first tracked update also stores the content from before it as the original revision.
+func (s *JobService) recordRevision(tx *gorm.DB, job *domain.Job, before domain.JobSnapshot, change jobChange) error {
+	updated, err := repository.NewJobRepository(tx).GetByID(job.ID)
+	if err != nil {
+		return err
+	}
+	after := domain.NewJobSnapshot(updated)
+
+	revisionRepo := repository.NewJobRevisionRepository(tx)
+	latest, err := revisionRepo.GetLatest(job.ID)
+	if err != nil {
+		return err
+	}
+
+	if latest == nil {
+		latest = &domain.JobRevision{
+			ID:             uuid.New(),
+			JobID:          job.ID,
+			RevisionNumber: 1,
+			Snapshot:       before,
+			Source:         domain.JobRevisionSourceOriginal,
+			ChangedBy:      &job.EmployerID,
+			CreatedAt:      job.CreatedAt,
+		}
+		if err := revisionRepo.Create(latest); err != nil {
+			return err
+		}
+	}
+
+	if len(after.Diff(latest.Snapshot)) == 0 {
+		return nil
+	}
+
+	changedBy := change.changedBy
+	return revisionRepo.Create(&domain.JobRevision{
+		ID:             uuid.New(),
+		JobID:          job.ID,
+		RevisionNumber: latest.RevisionNumber + 1,
+		Snapshot:       after,
+		Source:         change.source,
+		ChangedBy:      &changedBy,
+		RestoredFrom:   change.restoredFrom,
+		CreatedAt:      time.Now(),
+	})
+}
+
+// GetJobRevisions retrieves the revisions of an employer's job, oldest first
+func (s *JobService) GetJobRevisions(jobID, employerID uuid.UUID) ([]domain.JobRevision, error) {
+	job, err := s.jobRepo.GetByID(jobID)
+	if err != nil {
+		return nil, domain.ErrJobNotFound
+	}
+
+	if job.EmployerID != employerID {
+		return nil, domain.ErrJobNotOwnedByEmployer
+	}
+
+	return s.revisionRepo.GetByJobID(jobID)
+}
+
+// AdminGetJobRevisions retrieves the revisions of any job, oldest first
+func (s *JobService) AdminGetJobRevisions(jobID uuid.UUID) ([]domain.JobRevision, error) {
+	if _, err := s.jobRepo.GetByID(jobID); err != nil {
+		return nil, domain.ErrJobNotFound
+	}
+
+	return s.revisionRepo.GetByJobID(jobID)
+}
+
+// RestoreJobRevision restores an employer's job to a previous revision. The restore is recorded as a new revision.
+func (s *JobService) RestoreJobRevision(jobID, revisionID, employerID uuid.UUID) (*domain.Job, error) {
+	job, err := s.jobRepo.GetByID(jobID)
+	if err != nil {
+		return nil, domain.ErrJobNotFound
+	}
+
+	if job.EmployerID != employerID {
+		return nil, domain.ErrJobNotOwnedByEmployer
+	}
+
+	revision, err := s.getRevision(jobID, revisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.updateJob(job, restoreInput(job, revision.Snapshot), jobChange{
+		changedBy:    employerID,
+		source:       domain.JobRevisionSourceRestore,
+		restoredFrom: &revision.RevisionNumber,
+	})
+}
+
+// AdminRestoreJobRevision restores any job to a previous revision, including its company details.
+// Like other admin updates it leaves the employer's hiring fields unchanged.
+func (s *JobService) AdminRestoreJobRevision(jobID, revisionID, adminID uuid.UUID) (*domain.Job, error) {
+	job, err := s.jobRepo.GetByID(jobID)
+	if err != nil {
+		return nil, domain.ErrJobNotFound
+	}
+
+	revision, err := s.getRevision(jobID, revisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	input := AdminUpdateJobInput{
+		UpdateJobInput: restoreInput(job, revision.Snapshot),
+		CompanyName:    &revision.Snapshot.CompanyName,
+		CompanyLogoURL: &revision.Snapshot.CompanyLogoURL,
+	}
+
+	return s.adminUpdateJob(job, input, jobChange{
+		changedBy:    adminID,
+		source:       domain.JobRevisionSourceRestore,
+		restoredFrom: &revision.RevisionNumber,
+	})
+}
+
+// getRevision retrieves a revision of a job
+func (s *JobService) getRevision(jobID, revisionID uuid.UUID) (*domain.JobRevision, error) {
+	revision, err := s.revisionRepo.GetByID(jobID, revisionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrJobRevisionNotFound
+		}
+		return nil, err
+	}
+	return revision, nil
+}
+
+// restoreInput builds the update that brings a job back to a snapshot. The title, categories,
+// screening questions and hiring fields are only included when they differ, so restoring
+// doesn't regenerate the slug, recreate questions or fail on a hiring manager who left.
+func restoreInput(job *domain.Job, snapshot domain.JobSnapshot) UpdateJobInput {
+	current := domain.NewJobSnapshot(job)
+
+	input := UpdateJobInput{
+		Description:        &snapshot.Description,
+		ShortDescription:   &snapshot.ShortDescription,
+		JobType:            &snapshot.JobType,
+		ExperienceLevel:    &snapshot.ExperienceLevel,
+		WorkplaceType:      &snapshot.WorkplaceType,
+		Location:           &snapshot.Location,
+		City:               &snapshot.City,
+		State:              &snapshot.State,
+		Country:            &snapshot.Country,
+		Latitude:           snapshot.Latitude,
+		Longitude:          snapshot.Longitude,
+		SalaryMin:          snapshot.SalaryMin,
+		SalaryMax:          snapshot.SalaryMax,
+		SalaryCurrency:     &snapshot.SalaryCurrency,
+		SalaryPeriod:       &snapshot.SalaryPeriod,
+		HideSalary:         &snapshot.HideSalary,
+		Skills:             append([]string{}, snapshot.Skills...),
+		Education:          &snapshot.Education,
+		YearsExperienceMin: &snapshot.YearsExperienceMin,
+		YearsExperienceMax: snapshot.YearsExperienceMax,
+		Benefits:           append([]string{}, snapshot.Benefits...),
+		ApplicationURL:     &snapshot.ApplicationURL,
+		ApplicationEmail:   &snapshot.ApplicationEmail,
+	}
+
+	for _, change := range snapshot.Diff(current) {
+		switch change.Field {
+		case "title":
+			input.Title = &snapshot.Title
+		case "category_ids":
+			input.CategoryIDs = append([]uuid.UUID{}, snapshot.CategoryIDs...)
+		case "screening_questions":
+			input.ScreeningQuestions = make([]ScreeningQuestionInput, 0, len(snapshot.ScreeningQuestions))
+			for _, q := range snapshot.ScreeningQuestions {
+				input.ScreeningQuestions = append(input.ScreeningQuestions, ScreeningQuestionInput{
+					Question:        q.Question,
+					Type:            q.Type,
+					Options:         q.Options,
+					MinValue:        q.MinValue,
+					MaxValue:        q.MaxValue,
+					IsRequired:      q.IsRequired,
+					KnockoutAction:  q.KnockoutAction,
+					KnockoutAnswers: q.KnockoutAnswers,
+				})
+			}
+		case "openings":
+			input.Openings = &snapshot.Openings
+		case "requisition_id":
+			input.RequisitionID = &snapshot.RequisitionID
+		case "hiring_manager_id":
+			if snapshot.HiringManagerID == nil {
+				input.ClearHiringManager = true
+			} else {
+				input.HiringManagerID = snapshot.HiringManagerID
+			}
+		}
+	}
+
+	return input
+}
+
+// buildScreeningQuestions validates screening question inputs and converts them to ordered domain questions.
+// Questions that match an existing question of the job, by ID or else by text and type, keep its ID,
+// so forms loaded before the edit and stored answers still refer to them.
+func buildScreeningQuestions(inputs []ScreeningQuestionInput, existing []domain.ScreeningQuestion) ([]domain.ScreeningQuestion, error) {
+	if len(inputs) > MaxScreeningQuestions {
+		return nil, domain.ErrTooManyScreeningQuestions
+	}
+
+	kept := make(map[uuid.UUID]bool, len(existing))
+	existingID := func(in ScreeningQuestionInput, text string) (uuid.UUID, bool) {
+		if in.ID != nil {
+			for _, q := range existing {
+				if q.ID == *in.ID && !kept[q.ID] {
+					return q.ID, true
+				}
+			}
+		}
+		for _, q := range existing {
+			if q.Question == text && q.Type == in.Type && !kept[q.ID] {
+				return q.ID, true
+			}
+		}
+		return uuid.Nil, false
+	}
+
+	questions := make([]domain.ScreeningQuestion, 0, len(inputs))
+	for i, in := range inputs {
+		text := strings.TrimSpace(in.Question)
+		id, ok := existingID(in, text)
+		if ok {
+			kept[id] = true
+		} else {
+			id = uuid.New()
+		}
+
+		question := domain.ScreeningQuestion{
+			ID:              id,
+			Position:        i,
+			Question:        text,
+			Type:            in.Type,
+			Options:         in.Options,
+			MinValue:        in.MinValue,
+			MaxValue:        in.MaxValue,
+			IsRequired:      in.IsRequired,
+			KnockoutAction:  in.KnockoutAction,
+			KnockoutAnswers: in.KnockoutAnswers,
+		}
+		if err := question.Validate(); err != nil {
+			return nil, err
+		}
+		questions = append(questions, question)
+	}
+	return questions, nil
+}
+
 // DeleteJob deletes a job
 func (s *JobService) DeleteJob(jobID, employerID uuid.UUID) error {
 	// Get job
@@ -724,6 +1297,9 @@ func (s *JobService) RenewJob(jobID, employerID uuid.UUID, days int) error {
 	if job.Status == domain.JobStatusActive && !job.IsExpired() {
 		return domain.ErrCannotRenewActiveJob
 	}
+	if job.IsUnpublished() {
+		return domain.ErrJobNotPublished
+	}
 
 	// Set new expiry date
 	if days <= 0 {
@@ -731,6 +1307,7 @@ func (s *JobService) RenewJob(jobID, employerID uuid.UUID, days int) error {
 	}
 	newExpiresAt := time.Now().AddDate(0, 0, days)
 	job.ExpiresAt = &newExpiresAt
+	job.ExpiryWarningSentAt = nil // Warn again before the new expiry date
 	job.Status = domain.JobStatusActive
 
 	if job.PublishedAt == nil {
@@ -793,23 +1370,60 @@ func (s *JobService) CanEmployerPostJob(employerID uuid.UUID) (bool, error) {
 	return true, nil
 }
 
-// ExpireOverdueJobs marks expired jobs as expired
-func (s *JobService) ExpireOverdueJobs() (int, error) {
+// ExpireOverdueJobs marks expired jobs as expired and returns the jobs that were expired
+func (s *JobService) ExpireOverdueJobs() ([]domain.Job, error) {
 	jobs, err := s.jobRepo.GetExpiredJobs()
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	count := 0
+	expired := make([]domain.Job, 0, len(jobs))
 	for _, job := range jobs {
 		if err := s.jobRepo.UpdateStatus(job.ID, domain.JobStatusExpired); err != nil {
 			fmt.Printf("Failed to expire job %s: %v\n", job.ID, err)
 			continue
 		}
-		count++
+		job.Status = domain.JobStatusExpired
+		expired = append(expired, job)
+
+		if s.webhookService != nil {
+			s.webhookService.JobExpired(&job)
+		}
+	}
+
+	return expired, nil
+}
+
+// PublishScheduledJobs publishes scheduled jobs whose publish time has been reached and returns them
+func (s *JobService) PublishScheduledJobs() ([]domain.Job, error) {
+	jobs, err := s.jobRepo.GetDueScheduledJobs(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	published := make([]domain.Job, 0, len(jobs))
+	for _, job := range jobs {
+		// The listing runs for the full expiry period from its publish time
+		expiresAt := job.PublishedAt.AddDate(0, 0, s.config.DefaultExpiryDays)
+		ok, err := s.jobRepo.PublishScheduled(job.ID, expiresAt)
+		if err != nil {
+			fmt.Printf("Failed to publish scheduled job %s: %v\n", job.ID, err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+
+		updated, err := s.jobRepo.GetByID(job.ID)
+		if err != nil {
+			fmt.Printf("Failed to reload published job %s: %v\n", job.ID, err)
+			continue
+		}
+		published = append(published, *updated)
+		s.dispatchPublished(updated)
 	}
 
-	return count, nil
+	return published, nil
 }
 
 // GetJobsExpiringBefore retrieves jobs expiring before a date
@@ -846,12 +1460,16 @@ func (s *JobService) ApproveJob(jobID, adminID uuid.UUID) error {
 	}
 
 	now := time.Now()
-	job.Status = domain.JobStatusActive
-	job.PublishedAt = &now
 	job.ModeratedBy = &adminID
 	job.ModeratedAt = &now
+	s.publishOrSchedule(job)
 
-	return s.jobRepo.Update(job)
+	if err := s.jobRepo.Update(job); err != nil {
+		return err
+	}
+
+	s.dispatchPublished(job)
+	return nil
 }
 
 // RejectJob rejects a pending job
@@ -874,6 +1492,241 @@ func (s *JobService) RejectJob(jobID, adminID uuid.UUID, reason string) error {
 	return s.jobRepo.Update(job)
 }
 
+// PublishJob submits a draft for publishing, optionally scheduled for publishAt.
+// The job goes through company review and platform moderation first when they apply.
+func (s *JobService) PublishJob(jobID, employerID uuid.UUID, publishAt *time.Time) (*domain.Job, error) {
+	job, err := s.jobRepo.GetByID(jobID)
+	if err != nil {
+		return nil, domain.ErrJobNotFound
+	}
+
+	if job.EmployerID != employerID {
+		return nil, domain.ErrJobNotOwnedByEmployer
+	}
+
+	if job.Status != domain.JobStatusDraft {
+		return nil, domain.ErrInvalidJobStatus
+	}
+
+	if err := s.submitJob(job, publishAt); err != nil {
+		return nil, err
+	}
+
+	if err := s.jobRepo.Update(job); err != nil {
+		return nil, err
+	}
+
+	s.dispatchPublished(job)
+	return s.jobRepo.GetByID(job.ID)
+}
+
+// RevertJobToDraft takes a job that is waiting for review, moderation or its publish time back to draft
+func (s *JobService) RevertJobToDraft(jobID, employerID uuid.UUID) (*domain.Job, error) {
+	job, err := s.jobRepo.GetByID(jobID)
+	if err != nil {
+		return nil, domain.ErrJobNotFound
+	}
+
+	if job.EmployerID != employerID {
+		return nil, domain.ErrJobNotOwnedByEmployer
+	}
+
+	if !job.IsUnpublished() || job.Status == domain.JobStatusDraft {
+		return nil, domain.ErrInvalidJobStatus
+	}
+
+	job.Status = domain.JobStatusDraft
+	job.PublishedAt = nil
+
+	if err := s.jobRepo.Update(job); err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// GetJobsPendingReview retrieves the jobs waiting for review in the companies where the user is an ADMIN or OWNER
+func (s *JobService) GetJobsPendingReview(reviewerID uuid.UUID, limit, offset int) ([]domain.Job, int64, error) {
+	if s.teamRepo == nil {
+		return []domain.Job{}, 0, nil
+	}
+
+	memberships, err := s.teamRepo.GetUserCompanies(reviewerID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var companyIDs []uuid.UUID
+	for _, member := range memberships {
+		if member.CanApproveJobs() {
+			companyIDs = append(companyIDs, member.CompanyID)
+		}
+	}
+	if len(companyIDs) == 0 {
+		return []domain.Job{}, 0, nil
+	}
+
+	return s.jobRepo.GetPendingReviewJobs(companyIDs, limit, offset)
+}
+
+// ApproveJobReview approves a job on behalf of its company and sends it on to moderation or publishing
+func (s *JobService) ApproveJobReview(jobID, reviewerID uuid.UUID) (*domain.Job, error) {
+	job, err := s.getJobForReview(jobID, reviewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	job.ReviewedBy = &reviewerID
+	job.ReviewedAt = &now
+	job.ReviewNote = ""
+	s.releaseJob(job)
+
+	if err := s.jobRepo.Update(job); err != nil {
+		return nil, err
+	}
+
+	s.dispatchPublished(job)
+	return s.jobRepo.GetByID(job.ID)
+}
+
+// RejectJobReview sends a job back to draft with a note for the employer who posted it
+func (s *JobService) RejectJobReview(jobID, reviewerID uuid.UUID, note string) (*domain.Job, error) {
+	job, err := s.getJobForReview(jobID, reviewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	job.Status = domain.JobStatusDraft
+	job.PublishedAt = nil
+	job.ReviewedBy = &reviewerID
+	job.ReviewedAt = &now
+	job.ReviewNote = strings.TrimSpace(note)
+
+	if err := s.jobRepo.Update(job); err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// getJobForReview retrieves a job waiting for company review and checks the reviewer may approve it
+func (s *JobService) getJobForReview(jobID, reviewerID uuid.UUID) (*domain.Job, error) {
+	job, err := s.jobRepo.GetByID(jobID)
+	if err != nil {
+		return nil, domain.ErrJobNotFound
+	}
+
+	if job.Status != domain.JobStatusPendingReview || job.CompanyID == nil {
+		return nil, domain.ErrInvalidJobStatus
+	}
+
+	if s.teamRepo == nil {
+		return nil, domain.ErrNotJobReviewer
+	}
+
+	member, err := s.teamRepo.GetByCompanyAndUser(*job.CompanyID, reviewerID)
+	if err != nil || member.Status != domain.TeamMemberStatusActive || !member.CanApproveJobs() {
+		return nil, domain.ErrNotJobReviewer
+	}
+
+	return job, nil
+}
+
+// submitJob moves a job out of draft. Recruiters in companies that require approval wait for
+// company review, everything else continues to moderation or publishing.
+func (s *JobService) submitJob(job *domain.Job, publishAt *time.Time) error {
+	job.PublishedAt = nil
+	if publishAt != nil && publishAt.After(time.Now()) {
+		scheduled := *publishAt
+		job.PublishedAt = &scheduled
+	}
+
+	needsReview, err := s.requiresCompanyReview(job)
+	if err != nil {
+		return err
+	}
+	if needsReview {
+		job.Status = domain.JobStatusPendingReview
+		return nil
+	}
+
+	s.releaseJob(job)
+	return nil
+}
+
+// releaseJob sends a job to platform moderation when it is enabled, otherwise publishes or schedules it
+func (s *JobService) releaseJob(job *domain.Job) {
+	if s.config.ModerationEnabled {
+		job.Status = domain.JobStatusPendingApproval
+		return
+	}
+	s.publishOrSchedule(job)
+}
+
+// publishOrSchedule schedules a job with a future publish time and publishes any other job now
+func (s *JobService) publishOrSchedule(job *domain.Job) {
+	now := time.Now()
+	if job.PublishedAt != nil && job.PublishedAt.After(now) {
+		job.Status = domain.JobStatusScheduled
+		return
+	}
+
+	expiresAt := now.AddDate(0, 0, s.config.DefaultExpiryDays)
+	job.Status = domain.JobStatusActive
+	job.PublishedAt = &now
+	job.ExpiresAt = &expiresAt
+}
+
+// requiresCompanyReview checks if the employer's company requires approval for their postings.
+// The job is linked to that company so its admins can find it. Owners and admins never need approval.
+func (s *JobService) requiresCompanyReview(job *domain.Job) (bool, error) {
+	if s.companyRepo == nil || s.teamRepo == nil {
+		return false, nil
+	}
+
+	var member *domain.CompanyTeamMember
+	if job.CompanyID != nil {
+		m, err := s.teamRepo.GetByCompanyAndUser(*job.CompanyID, job.EmployerID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return false, nil
+			}
+			return false, err
+		}
+		member = m
+	} else {
+		// Employers belong to one company in practice, the first active membership is used
+		memberships, err := s.teamRepo.GetUserCompanies(job.EmployerID)
+		if err != nil {
+			return false, err
+		}
+		if len(memberships) == 0 {
+			return false, nil
+		}
+		member = memberships[0]
+	}
+
+	if member.Status != domain.TeamMemberStatusActive || member.CanApproveJobs() {
+		return false, nil
+	}
+
+	company, err := s.companyRepo.GetByID(member.CompanyID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	if !company.RequireJobApproval {
+		return false, nil
+	}
+
+	job.CompanyID = &company.ID
+	return true, nil
+}
+
 // FeatureJob features a job until a specific date
 func (s *JobService) FeatureJob(jobID uuid.UUID, until time.Time) error {
 	return s.jobRepo.FeatureJob(jobID, until)
@@ -889,6 +1742,16 @@ func (s *JobService) GetJobsNearExpiry() ([]domain.Job, error) {
 	return s.jobRepo.GetJobsNearExpiry(s.config.ExpiryWarningDays)
 }
 
+// GetJobsNeedingExpiryWarning retrieves jobs within the warning window whose employer has not been warned yet
+func (s *JobService) GetJobsNeedingExpiryWarning() ([]domain.Job, error) {
+	return s.jobRepo.GetJobsNeedingExpiryWarning(s.config.ExpiryWarningDays)
+}
+
+// MarkExpiryWarningSent records that the employer was warned about a job's expiry
+func (s *JobService) MarkExpiryWarningSent(jobID uuid.UUID) error {
+	return s.jobRepo.MarkExpiryWarningSent(jobID)
+}
+
 // GetJobStats retrieves job statistics
 func (s *JobService) GetJobStats() (map[string]interface{}, error) {
 	stats := make(map[string]interface{})
@@ -1049,6 +1912,158 @@ func (s *JobService) GetEmployerOverviewAnalytics(employerID uuid.UUID, period s
 	return analytics, nil
 }
 
+// RequisitionJob represents the hiring progress of one job posting of a requisition
+type RequisitionJob struct {
+	JobID    string     `json:"job_id"`
+	Title    string     `json:"title"`
+	Status   string     `json:"status"`
+	Openings int        `json:"openings"`
+	Hired    int64      `json:"hired"`
+	OpenedAt time.Time  `json:"opened_at"`
+	FilledAt *time.Time `json:"filled_at,omitempty"`
+}
+
+// RequisitionTimeToFill represents the hiring progress of one requisition across its job postings
+type RequisitionTimeToFill struct {
+	RequisitionID  string           `json:"requisition_id"`
+	HiringManagers []string         `json:"hiring_managers,omitempty"`
+	Openings       int              `json:"openings"`
+	Hired          int64            `json:"hired"`
+	OpenedAt       time.Time        `json:"opened_at"`
+	FilledAt       *time.Time       `json:"filled_at,omitempty"`
+	DaysToFill     *int             `json:"days_to_fill,omitempty"` // Only set once every posting is filled
+	DaysOpen       int              `json:"days_open"`
+	Jobs           []RequisitionJob `json:"jobs"`
+}
+
+// RequisitionAnalytics represents time-to-fill analytics across an employer's requisitions
+type RequisitionAnalytics struct {
+	TotalOpenings      int                     `json:"total_openings"`
+	TotalHired         int64                   `json:"total_hired"`
+	FilledRequisitions int                     `json:"filled_requisitions"`
+	OpenRequisitions   int                     `json:"open_requisitions"`
+	AverageDaysToFill  float64                 `json:"average_days_to_fill"`
+	Requisitions       []RequisitionTimeToFill `json:"requisitions"`
+}
+
+// GetRequisitionAnalytics retrieves time-to-fill per requisition for an employer, grouping the
+// job postings that share a requisition ID. Time to fill runs from the first posting being
+// published (or created, for unpublished jobs) until the last opening of the requisition was filled.
+func (s *JobService) GetRequisitionAnalytics(employerID uuid.UUID, requisitionID string) (*RequisitionAnalytics, error) {
+	jobs, err := s.jobRepo.GetEmployerRequisitions(employerID, strings.TrimSpace(requisitionID))
+	if err != nil {
+		return nil, err
+	}
+
+	jobIDs := make([]uuid.UUID, len(jobs))
+	for i := range jobs {
+		jobIDs[i] = jobs[i].ID
+	}
+	hired, err := s.applicationRepo.CountByStatusForJobs(jobIDs, domain.ApplicationStatusHired)
+	if err != nil {
+		return nil, err
+	}
+
+	// Group postings by requisition, keeping the newest-first order of each requisition's latest posting
+	var order []string
+	groups := make(map[string][]*domain.Job)
+	for i := range jobs {
+		key := jobs[i].RequisitionID
+		if _, ok := groups[key]; !ok {
+			order = append(order, key)
+		}
+		groups[key] = append(groups[key], &jobs[i])
+	}
+
+	analytics := &RequisitionAnalytics{
+		Requisitions: make([]RequisitionTimeToFill, 0, len(order)),
+	}
+	now := time.Now()
+	totalDaysToFill := 0
+	for _, key := range order {
+		row := RequisitionTimeToFill{
+			RequisitionID: key,
+			Jobs:          make([]RequisitionJob, 0, len(groups[key])),
+		}
+
+		filled, open := true, false
+		managers := make(map[string]bool)
+		for _, job := range groups[key] {
+			openedAt := job.CreatedAt
+			if job.PublishedAt != nil {
+				openedAt = *job.PublishedAt
+			}
+			if row.OpenedAt.IsZero() || openedAt.Before(row.OpenedAt) {
+				row.OpenedAt = openedAt
+			}
+
+			if job.IsFilled() {
+				if row.FilledAt == nil || job.FilledAt.After(*row.FilledAt) {
+					row.FilledAt = job.FilledAt
+				}
+			} else {
+				filled = false
+				if job.Status == domain.JobStatusActive {
+					open = true
+				}
+			}
+
+			if job.HiringManager != nil && job.HiringManager.User != nil {
+				name := job.HiringManager.User.FirstName + " " + job.HiringManager.User.LastName
+				if !managers[name] {
+					managers[name] = true
+					row.HiringManagers = append(row.HiringManagers, name)
+				}
+			}
+
+			row.Openings += job.Openings
+			row.Hired += hired[job.ID]
+			row.Jobs = append(row.Jobs, RequisitionJob{
+				JobID:    job.ID.String(),
+				Title:    job.Title,
+				Status:   string(job.Status),
+				Openings: job.Openings,
+				Hired:    hired[job.ID],
+				OpenedAt: openedAt,
+				FilledAt: job.FilledAt,
+			})
+		}
+
+		if filled {
+			row.DaysOpen = daysBetween(row.OpenedAt, *row.FilledAt)
+			days := row.DaysOpen
+			row.DaysToFill = &days
+			analytics.FilledRequisitions++
+			totalDaysToFill += days
+		} else {
+			// A requisition is only filled once its last posting is
+			row.FilledAt = nil
+			row.DaysOpen = daysBetween(row.OpenedAt, now)
+			if open {
+				analytics.OpenRequisitions++
+			}
+		}
+
+		analytics.TotalOpenings += row.Openings
+		analytics.TotalHired += row.Hired
+		analytics.Requisitions = append(analytics.Requisitions, row)
+	}
+
+	if analytics.FilledRequisitions > 0 {
+		analytics.AverageDaysToFill = float64(totalDaysToFill) / float64(analytics.FilledRequisitions)
+	}
+
+	return analytics, nil
+}
+
+// daysBetween returns the number of whole days between two times
+func daysBetween(from, to time.Time) int {
+	if to.Before(from) {
+		return 0
+	}
+	return int(to.Sub(from).Hours() / 24)
+}
+
 // CountByJobType returns active job counts grouped by job type
 func (s *JobService) CountByJobType() (map[string]int64, error) {
 	return s.jobRepo.CountByJobType()
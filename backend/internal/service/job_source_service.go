@@ -0,0 +1,438 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"job-platform/internal/domain"
+	"job-platform/internal/dto"
+	"job-platform/internal/repository"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	// DefaultCrawlIntervalHours is used when a source is created without an interval
+	DefaultCrawlIntervalHours = 24
+	// MaxCrawlIntervalHours caps the crawl interval at 30 days
+	MaxCrawlIntervalHours = 720
+	// jobSourceCrawlLease is how long a claimed source is skipped by other instances before it
+	// is considered abandoned and crawled again
+	jobSourceCrawlLease = 1 * time.Hour
+)
+
+// JobSourceService manages scheduled career-page sources and their diff-based re-import
+type JobSourceService struct {
+	jobSourceRepo      *repository.JobSourceRepository
+	jobRepo            *repository.JobRepository
+	companyRepo        *repository.CompanyRepository
+	scraperService     *ScraperService
+	importQueueService *ImportQueueService
+	jobService         *JobService
+}
+
+// NewJobSourceService creates a new job source service
+func NewJobSourceService(
+	jobSourceRepo *repository.JobSourceRepository,
+	jobRepo *repository.JobRepository,
+	companyRepo *repository.CompanyRepository,
+	scraperService *ScraperService,
+	importQueueService *ImportQueueService,
+	jobService *JobService,
+) *JobSourceService {
+	return &JobSourceService{
+		jobSourceRepo:      jobSourceRepo,
+		jobRepo:            jobRepo,
+		companyRepo:        companyRepo,
+		scraperService:     scraperService,
+		importQueueService: importQueueService,
+		jobService:         jobService,
+	}
+}
+
+// CreateJobSourceInput represents input for creating a job source
+type CreateJobSourceInput struct {
+	CompanyID          *uuid.UUID
+	Name               string
+	URL                string
+	ExtractionStrategy domain.JobSourceStrategy
+	CrawlIntervalHours int
+	CloseMissingJobs   *bool
+}
+
+// UpdateJobSourceInput represents input for updating a job source
+type UpdateJobSourceInput struct {
+	CompanyID          *uuid.UUID
+	Name               *string
+	URL                *string
+	ExtractionStrategy *domain.JobSourceStrategy
+	CrawlIntervalHours *int
+	CloseMissingJobs   *bool
+	IsActive           *bool
+}
+
+// JobSourceSyncResult summarizes a single crawl of a source
+type JobSourceSyncResult struct {
+	LinksFound int        `json:"links_found"`
+	NewJobs    int        `json:"new_jobs"`
+	ClosedJobs int        `json:"closed_jobs"`
+	QueueID    *uuid.UUID `json:"queue_id,omitempty"`
+}
+
+// CreateSource creates a new job source; the first crawl happens on the next scheduler tick
+func (s *JobSourceService) CreateSource(adminID uuid.UUID, input CreateJobSourceInput) (*domain.JobSource, error) {
+	if err := validateJobSourceURL(input.URL); err != nil {
+		return nil, err
+	}
+
+	strategy := input.ExtractionStrategy
+	if strategy == "" {
+		strategy = domain.JobSourceStrategyAuto
+	}
+	if !strategy.IsValid() {
+		return nil, domain.ErrInvalidExtractionStrategy
+	}
+
+	interval := input.CrawlIntervalHours
+	if interval == 0 {
+		interval = DefaultCrawlIntervalHours
+	}
+	if interval < 1 || interval > MaxCrawlIntervalHours {
+		return nil, domain.ErrInvalidCrawlInterval
+	}
+
+	if err := s.ensureCompanyExists(input.CompanyID); err != nil {
+		return nil, err
+	}
+
+	closeMissing := true
+	if input.CloseMissingJobs != nil {
+		closeMissing = *input.CloseMissingJobs
+	}
+
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		name = input.URL
+	}
+
+	source := &domain.JobSource{
+		CompanyID:          input.CompanyID,
+		CreatedBy:          adminID,
+		Name:               name,
+		URL:                strings.TrimSpace(input.URL),
+		ExtractionStrategy: strategy,
+		CrawlIntervalHours: interval,
+		CloseMissingJobs:   closeMissing,
+		IsActive:           true,
+	}
+
+	if err := s.jobSourceRepo.Create(source); err != nil {
+		return nil, err
+	}
+
+	return source, nil
+}
+
+// UpdateSource updates a job source
+func (s *JobSourceService) UpdateSource(id uuid.UUID, input UpdateJobSourceInput) (*domain.JobSource, error) {
+	source, err := s.GetSource(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.URL != nil {
+		if err := validateJobSourceURL(*input.URL); err != nil {
+			return nil, err
+		}
+		source.URL = strings.TrimSpace(*input.URL)
+	}
+	if input.Name != nil {
+		source.Name = strings.TrimSpace(*input.Name)
+	}
+	if input.ExtractionStrategy != nil {
+		if !input.ExtractionStrategy.IsValid() {
+			return nil, domain.ErrInvalidExtractionStrategy
+		}
+		source.ExtractionStrategy = *input.ExtractionStrategy
+	}
+	if input.CrawlIntervalHours != nil {
+		if *input.CrawlIntervalHours < 1 || *input.CrawlIntervalHours > MaxCrawlIntervalHours {
+			return nil, domain.ErrInvalidCrawlInterval
+		}
+		source.CrawlIntervalHours = *input.CrawlIntervalHours
+		// Reschedule relative to the last crawl
+		if source.LastCrawledAt != nil {
+			next := source.LastCrawledAt.Add(source.CrawlInterval())
+			source.NextCrawlAt = &next
+		}
+	}
+	if input.CompanyID != nil {
+		if err := s.ensureCompanyExists(input.CompanyID); err != nil {
+			return nil, err
+		}
+		source.CompanyID = input.CompanyID
+	}
+	if input.CloseMissingJobs != nil {
+		source.CloseMissingJobs = *input.CloseMissingJobs
+	}
+	if input.IsActive != nil {
+		source.IsActive = *input.IsActive
+	}
+
+	if err := s.jobSourceRepo.Update(source); err != nil {
+		return nil, err
+	}
+
+	return source, nil
+}
+
+// DeleteSource deletes a job source. Jobs already imported from it are kept.
+func (s *JobSourceService) DeleteSource(id uuid.UUID) error {
+	if _, err := s.GetSource(id); err != nil {
+		return err
+	}
+	return s.jobSourceRepo.Delete(id)
+}
+
+// GetSource retrieves a job source by ID
+func (s *JobSourceService) GetSource(id uuid.UUID) (*domain.JobSource, error) {
+	source, err := s.jobSourceRepo.GetByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrJobSourceNotFound
+		}
+		return nil, err
+	}
+	return source, nil
+}
+
+// ListSources retrieves job sources with pagination
+func (s *JobSourceService) ListSources(companyID *uuid.UUID, limit, offset int) ([]domain.JobSource, int64, error) {
+	return s.jobSourceRepo.List(companyID, limit, offset)
+}
+
+// SyncDueSources crawls every active source whose next crawl is due. Sources are claimed one at
+// a time, so several instances share the due sources instead of crawling each of them at once.
+// Returns the number of sources crawled.
+func (s *JobSourceService) SyncDueSources(ctx context.Context) (int, error) {
+	crawled := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return crawled, ctx.Err()
+		default:
+		}
+
+		now := time.Now()
+		source, err := s.jobSourceRepo.ClaimDueSource(now, now.Add(jobSourceCrawlLease))
+		if err != nil {
+			return crawled, err
+		}
+		if source == nil {
+			return crawled, nil
+		}
+
+		if _, err := s.syncSource(ctx, source); err != nil {
+			log.Printf("❌ Job source %s (%s) sync failed: %v", source.Name, source.URL, err)
+		}
+		crawled++
+	}
+}
+
+// SyncSource crawls a single source immediately, regardless of its schedule
+func (s *JobSourceService) SyncSource(ctx context.Context, id uuid.UUID) (*domain.JobSource, *JobSourceSyncResult, error) {
+	source, err := s.GetSource(id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result, err := s.syncSource(ctx, source)
+	return source, result, err
+}
+
+// syncSource extracts the current links of a source, imports the new ones and
+// closes previously imported jobs whose links are gone
+func (s *JobSourceService) syncSource(ctx context.Context, source *domain.JobSource) (*JobSourceSyncResult, error) {
+	log.Printf("🔄 Crawling job source %s: %s", source.Name, source.URL)
+
+	links, err := s.extractLinks(ctx, source)
+	if err != nil {
+		s.recordSync(source, nil, err)
+		return nil, err
+	}
+
+	result := &JobSourceSyncResult{LinksFound: len(links)}
+
+	// Diff discovered links against jobs that already exist or are waiting in a queue
+	urls := make([]string, 0, len(links))
+	for _, link := range links {
+		urls = append(urls, link.URL)
+	}
+
+	existing, err := s.jobRepo.GetExistingOriginalURLs(urls)
+	if err != nil {
+		s.recordSync(source, nil, err)
+		return nil, err
+	}
+	known := make(map[string]bool, len(existing))
+	for _, u := range existing {
+		known[u] = true
+	}
+
+	// Postings skipped as duplicates of another job are not imported again
+	duplicates, err := s.importQueueService.GetDuplicateURLs(urls)
+	if err != nil {
+		s.recordSync(source, nil, err)
+		return nil, err
+	}
+	for u := range duplicates {
+		known[u] = true
+	}
+
+	queued, err := s.importQueueService.GetQueuedURLs(urls)
+	if err != nil {
+		s.recordSync(source, nil, err)
+		return nil, err
+	}
+
+	var newURLs, newTitles []string
+	for _, link := range links {
+		if known[link.URL] || queued[link.URL] {
+			continue
+		}
+		newURLs = append(newURLs, link.URL)
+		newTitles = append(newTitles, link.Title)
+	}
+
+	// Import only new postings
+	if len(newURLs) > 0 {
+		queue, err := s.importQueueService.CreateSourceQueue(source, newURLs, newTitles)
+		if err != nil {
+			s.recordSync(source, nil, err)
+			return nil, err
+		}
+		result.NewJobs = len(newURLs)
+		result.QueueID = &queue.ID
+	}
+
+	// Close jobs whose postings disappeared. An empty crawl is treated as a broken
+	// page rather than a company with zero openings.
+	if source.CloseMissingJobs && len(links) > 0 {
+		current := make(map[string]bool, len(urls))
+		for _, u := range urls {
+			current[u] = true
+		}
+
+		jobs, err := s.jobSourceRepo.GetActiveSourceJobs(source.ID)
+		if err != nil {
+			s.recordSync(source, result, err)
+			return result, err
+		}
+
+		var closed []domain.Job
+		for _, job := range jobs {
+			if job.OriginalURL == nil || current[*job.OriginalURL] {
+				continue
+			}
+			if err := s.jobRepo.UpdateStatus(job.ID, domain.JobStatusClosed); err != nil {
+				log.Printf("❌ Failed to close job %s from source %s: %v", job.ID, source.Name, err)
+				continue
+			}
+			job.Status = domain.JobStatusClosed
+			closed = append(closed, job)
+		}
+		result.ClosedJobs = len(closed)
+
+		// Closed jobs must not linger in search results, cached lists, feeds or sitemaps
+		s.jobService.HandleClosedJobs(ctx, closed)
+	}
+
+	s.recordSync(source, result, nil)
+
+	log.Printf("✅ Job source %s crawled: %d links, %d new, %d closed", source.Name, result.LinksFound, result.NewJobs, result.ClosedJobs)
+	return result, nil
+}
+
+// extractLinks runs the configured extraction strategy and de-duplicates the links
+func (s *JobSourceService) extractLinks(ctx context.Context, source *domain.JobSource) ([]dto.ExtractedJobLink, error) {
+	var (
+		resp *dto.ExtractLinksResponse
+		err  error
+	)
+
+	switch source.ExtractionStrategy {
+	case domain.JobSourceStrategyListing:
+		resp, err = s.scraperService.ExtractJobLinks(ctx, source.URL)
+	default:
+		resp, err = s.scraperService.ExtractJobLinksAuto(ctx, source.URL)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(resp.Links))
+	links := make([]dto.ExtractedJobLink, 0, len(resp.Links))
+	for _, link := range resp.Links {
+		link.URL = strings.TrimSpace(link.URL)
+		if link.URL == "" || seen[link.URL] {
+			continue
+		}
+		seen[link.URL] = true
+		links = append(links, link)
+	}
+
+	return links, nil
+}
+
+// recordSync stores the outcome of a crawl and schedules the next one
+func (s *JobSourceService) recordSync(source *domain.JobSource, result *JobSourceSyncResult, syncErr error) {
+	now := time.Now()
+	next := now.Add(source.CrawlInterval())
+	source.LastCrawledAt = &now
+	source.NextCrawlAt = &next
+
+	status := domain.JobSourceSyncSuccess
+	source.LastError = ""
+	if syncErr != nil {
+		status = domain.JobSourceSyncFailed
+		source.LastError = syncErr.Error()
+	}
+	source.LastStatus = &status
+
+	if result != nil {
+		source.LastLinksFound = result.LinksFound
+		source.LastNewJobs = result.NewJobs
+		source.LastClosedJobs = result.ClosedJobs
+		if result.QueueID != nil {
+			source.LastQueueID = result.QueueID
+		}
+	}
+
+	if err := s.jobSourceRepo.Update(source); err != nil {
+		log.Printf("❌ Failed to record crawl of job source %s: %v", source.ID, err)
+	}
+}
+
+// ensureCompanyExists checks that an optional company ID refers to an existing company
+func (s *JobSourceService) ensureCompanyExists(companyID *uuid.UUID) error {
+	if companyID == nil {
+		return nil
+	}
+	if _, err := s.companyRepo.GetByID(*companyID); err != nil {
+		return domain.ErrCompanyNotFound
+	}
+	return nil
+}
+
+// validateJobSourceURL checks that a source URL is an absolute http(s) URL
+func validateJobSourceURL(raw string) error {
+	parsed, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || parsed.Host == "" || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return domain.ErrInvalidJobSourceURL
+	}
+	return nil
+}
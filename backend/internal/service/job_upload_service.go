@@ -0,0 +1,775 @@
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"job-platform/internal/domain"
+	"job-platform/internal/dto"
+	"job-platform/internal/repository"
+	"job-platform/internal/util/spreadsheet"
+	"log"
+	"mime/multipart"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+const (
+	// MaxJobUploadSizeMB is the largest bulk job upload file accepted
+	MaxJobUploadSizeMB = 5
+	// MaxJobUploadRows is the number of jobs a single upload can create
+	MaxJobUploadRows = 500
+	// jobUploadPollInterval is how often the idle worker checks the database for confirmed uploads
+	jobUploadPollInterval = 5 * time.Second
+	// jobUploadClaimLease is how long a claimed upload belongs to its worker without progress. The
+	// claim is renewed after every row, so only uploads of a crashed instance are claimed again.
+	jobUploadClaimLease = 10 * time.Minute
+)
+
+// errJobUploadRowProcessed rolls back a row's job when another worker already processed the row
+var errJobUploadRowProcessed = errors.New("job upload row was already processed")
+
+// JobUploadColumnType is how a cell of a bulk upload column is parsed
+type JobUploadColumnType string
+
+const (
+	JobUploadColumnText     JobUploadColumnType = "text"
+	JobUploadColumnChoice   JobUploadColumnType = "choice" // Case-insensitive, spaces and hyphens count as underscores
+	JobUploadColumnInteger  JobUploadColumnType = "integer"
+	JobUploadColumnNumber   JobUploadColumnType = "number"
+	JobUploadColumnBoolean  JobUploadColumnType = "boolean"  // true/false, yes/no or 1/0
+	JobUploadColumnList     JobUploadColumnType = "list"     // Values separated by ";" or "|"
+	JobUploadColumnDateTime JobUploadColumnType = "datetime" // RFC 3339, "2006-01-02 15:04" or "2006-01-02" in UTC
+)
+
+// JobUploadColumn documents a column of a bulk job upload file. The header of the column is
+// its name, which is also the JSON field of the create job request the column maps to.
+type JobUploadColumn struct {
+	Name        string                 `json:"name"`
+	Type        JobUploadColumnType    `json:"type"`
+	Required    bool                   `json:"required"`
+	Description string                 `json:"description,omitempty"`
+	Modes       []domain.JobUploadMode `json:"-"` // Empty when the column applies to all uploads
+}
+
+// appliesTo checks if the column is part of uploads of a mode
+func (c JobUploadColumn) appliesTo(mode domain.JobUploadMode) bool {
+	if len(c.Modes) == 0 {
+		return true
+	}
+	for _, m := range c.Modes {
+		if m == mode {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	employerUploadOnly = []domain.JobUploadMode{domain.JobUploadModeEmployer}
+	adminUploadOnly    = []domain.JobUploadMode{domain.JobUploadModeAdmin}
+)
+
+// JobUploadColumns is the column mapping of bulk job upload files to CreateJobInput and AdminCreateJobInput
+var JobUploadColumns = []JobUploadColumn{
+	{Name: "title", Type: JobUploadColumnText, Required: true, Description: "Job title, 5 to 255 characters"},
+	{Name: "description", Type: JobUploadColumnText, Required: true, Description: "Full job description, at least 100 characters"},
+	{Name: "short_description", Type: JobUploadColumnText, Description: "Summary shown in listings, up to 500 characters"},
+	{Name: "job_type", Type: JobUploadColumnChoice, Required: true, Description: "FULL_TIME, PART_TIME, CONTRACT, FREELANCE or INTERNSHIP"},
+	{Name: "experience_level", Type: JobUploadColumnChoice, Required: true, Description: "ENTRY, MID, SENIOR, LEAD or EXECUTIVE"},
+	{Name: "workplace_type", Type: JobUploadColumnChoice, Required: true, Description: "ONSITE, REMOTE or HYBRID"},
+	{Name: "location", Type: JobUploadColumnText, Required: true, Description: "Location as shown on the job"},
+	{Name: "city", Type: JobUploadColumnText},
+	{Name: "state", Type: JobUploadColumnText},
+	{Name: "country", Type: JobUploadColumnText},
+	{Name: "latitude", Type: JobUploadColumnNumber},
+	{Name: "longitude", Type: JobUploadColumnNumber},
+	{Name: "salary_min", Type: JobUploadColumnInteger},
+	{Name: "salary_max", Type: JobUploadColumnInteger},
+	{Name: "salary_currency", Type: JobUploadColumnText, Description: "ISO currency code, e.g. USD"},
+	{Name: "salary_period", Type: JobUploadColumnText, Description: "e.g. YEARLY, MONTHLY or HOURLY"},
+	{Name: "hide_salary", Type: JobUploadColumnBoolean},
+	{Name: "skills", Type: JobUploadColumnList},
+	{Name: "education", Type: JobUploadColumnText},
+	{Name: "years_experience_min", Type: JobUploadColumnInteger},
+	{Name: "years_experience_max", Type: JobUploadColumnInteger},
+	{Name: "benefits", Type: JobUploadColumnList},
+	{Name: "category_ids", Type: JobUploadColumnList, Description: "Category IDs; categories are assigned automatically when empty"},
+	{Name: "application_url", Type: JobUploadColumnText, Description: "External application link"},
+	{Name: "application_email", Type: JobUploadColumnText},
+	{Name: "openings", Type: JobUploadColumnInteger, Description: "Number of positions, 1 to 1000, defaults to 1", Modes: employerUploadOnly},
+	{Name: "requisition_id", Type: JobUploadColumnText, Description: "Internal requisition reference", Modes: employerUploadOnly},
+	{Name: "hiring_manager_id", Type: JobUploadColumnText, Description: "Team member ID of the hiring manager", Modes: employerUploadOnly},
+	{Name: "save_as_draft", Type: JobUploadColumnBoolean, Description: "Keep the job as a draft instead of submitting it", Modes: employerUploadOnly},
+	{Name: "publish_at", Type: JobUploadColumnDateTime, Description: "Schedule publishing for a later time", Modes: employerUploadOnly},
+	{Name: "company_name", Type: JobUploadColumnText, Required: true, Description: "Company shown on the job", Modes: adminUploadOnly},
+	{Name: "company_logo_url", Type: JobUploadColumnText, Modes: adminUploadOnly},
+	{Name: "status", Type: JobUploadColumnChoice, Description: "ACTIVE, DRAFT or PENDING_APPROVAL, defaults to ACTIVE", Modes: adminUploadOnly},
+}
+
+// GetJobUploadColumns returns the columns of upload files for a mode
+func GetJobUploadColumns(mode domain.JobUploadMode) []JobUploadColumn {
+	columns := make([]JobUploadColumn, 0, len(JobUploadColumns))
+	for _, column := range JobUploadColumns {
+		if column.appliesTo(mode) {
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
+
+// JobUploadTemplate returns an empty CSV upload file with the header row of a mode
+func JobUploadTemplate(mode domain.JobUploadMode) ([]byte, error) {
+	columns := GetJobUploadColumns(mode)
+	header := make([]string, len(columns))
+	for i, column := range columns {
+		header[i] = column.Name
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(header); err != nil {
+		return nil, err
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// JobUploadService creates jobs in bulk from CSV and XLSX files. Uploads are validated first;
+// confirmed uploads are processed by a background worker. An upload whose worker died is claimed
+// again once its lease expired.
+type JobUploadService struct {
+	jobUploadRepo *repository.JobUploadRepository
+	jobService    *JobService
+	wake          chan struct{}
+	stopChan      chan struct{}
+}
+
+// NewJobUploadService creates a new job upload service
+func NewJobUploadService(jobUploadRepo *repository.JobUploadRepository, jobService *JobService) *JobUploadService {
+	return &JobUploadService{
+		jobUploadRepo: jobUploadRepo,
+		jobService:    jobService,
+		wake:          make(chan struct{}, 1),
+		stopChan:      make(chan struct{}),
+	}
+}
+
+// Start starts the worker
+func (s *JobUploadService) Start() {
+	go s.worker()
+
+	log.Println("✅ Job upload worker started")
+}
+
+// Stop stops the worker. An upload still processing is claimed again once its lease expired.
+func (s *JobUploadService) Stop() {
+	close(s.stopChan)
+	log.Println("🛑 Job upload worker stopped")
+}
+
+// notify wakes up the worker without blocking
+func (s *JobUploadService) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// worker claims and processes confirmed uploads until stopped
+func (s *JobUploadService) worker() {
+	ticker := time.NewTicker(jobUploadPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stopChan:
+			return
+		default:
+		}
+
+		now := time.Now()
+		upload, err := s.jobUploadRepo.ClaimNext(now, now.Add(-jobUploadClaimLease))
+		if err != nil {
+			log.Printf("❌ Job upload worker failed to claim upload: %v", err)
+		}
+
+		if upload == nil {
+			select {
+			case <-s.stopChan:
+				return
+			case <-s.wake:
+			case <-ticker.C:
+			}
+			continue
+		}
+
+		s.processUpload(upload)
+	}
+}
+
+// ValidateUpload parses an upload file and validates every row without creating jobs.
+// The report is stored so the upload can be confirmed without sending the file again.
+func (s *JobUploadService) ValidateUpload(userID uuid.UUID, mode domain.JobUploadMode, file *multipart.FileHeader) (*domain.JobUpload, error) {
+	format, err := spreadsheet.FormatFromFilename(file.Filename)
+	if err != nil {
+		return nil, domain.ErrInvalidJobUploadFile
+	}
+	if file.Size > MaxJobUploadSizeMB<<20 {
+		return nil, domain.ErrJobUploadTooLarge
+	}
+
+	f, err := file.Open()
+	if err != nil {
+		return nil, domain.ErrInvalidJobUploadFile
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(io.LimitReader(f, MaxJobUploadSizeMB<<20+1))
+	if err != nil {
+		return nil, domain.ErrInvalidJobUploadFile
+	}
+	if len(data) > MaxJobUploadSizeMB<<20 {
+		return nil, domain.ErrJobUploadTooLarge
+	}
+
+	records, err := spreadsheet.Read(format, data)
+	if err != nil || len(records) < 2 {
+		return nil, domain.ErrInvalidJobUploadFile
+	}
+
+	columns, ignored := mapJobUploadHeader(records[0], mode)
+	if len(columns) == 0 {
+		return nil, domain.ErrInvalidJobUploadFile
+	}
+
+	upload := &domain.JobUpload{
+		ID:             uuid.New(),
+		UploadedBy:     userID,
+		Mode:           mode,
+		Filename:       clipUploadText(file.Filename, 255),
+		Status:         domain.JobUploadStatusValidated,
+		IgnoredColumns: ignored,
+	}
+
+	for i, record := range records[1:] {
+		if isBlankRecord(record) {
+			continue
+		}
+		if len(upload.Rows) == MaxJobUploadRows {
+			return nil, domain.ErrJobUploadTooManyRows
+		}
+
+		row, err := validateJobUploadRow(mode, columns, record)
+		if err != nil {
+			return nil, err
+		}
+		row.RowNumber = i + 2
+		upload.Rows = append(upload.Rows, *row)
+
+		if row.Status == domain.JobUploadRowStatusValid {
+			upload.ValidRows++
+		}
+	}
+	if len(upload.Rows) == 0 {
+		return nil, domain.ErrInvalidJobUploadFile
+	}
+	upload.TotalRows = len(upload.Rows)
+
+	if err := s.jobUploadRepo.Create(upload); err != nil {
+		return nil, err
+	}
+	return upload, nil
+}
+
+// GetUploads retrieves a user's uploads without their rows
+func (s *JobUploadService) GetUploads(userID uuid.UUID, mode domain.JobUploadMode, limit, offset int) ([]domain.JobUpload, int64, error) {
+	return s.jobUploadRepo.GetByUploader(userID, mode, limit, offset)
+}
+
+// GetUpload retrieves an upload of a user with the report of every row
+func (s *JobUploadService) GetUpload(id, userID uuid.UUID, mode domain.JobUploadMode) (*domain.JobUpload, error) {
+	return s.getUpload(id, userID, mode, true)
+}
+
+// ConfirmUpload queues the valid rows of a validated upload to be created as jobs.
+// Invalid rows are skipped.
+func (s *JobUploadService) ConfirmUpload(id, userID uuid.UUID, mode domain.JobUploadMode) (*domain.JobUpload, error) {
+	upload, err := s.getUpload(id, userID, mode, false)
+	if err != nil {
+		return nil, err
+	}
+	if upload.Status != domain.JobUploadStatusValidated {
+		return nil, domain.ErrJobUploadNotValidated
+	}
+	if upload.ValidRows == 0 {
+		return nil, domain.ErrJobUploadHasNoValidRows
+	}
+
+	confirmed, err := s.jobUploadRepo.Confirm(upload.ID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if !confirmed {
+		return nil, domain.ErrJobUploadNotValidated
+	}
+
+	s.notify()
+	return s.getUpload(id, userID, mode, false)
+}
+
+// getUpload loads an upload, hiding uploads of other users and modes
+func (s *JobUploadService) getUpload(id, userID uuid.UUID, mode domain.JobUploadMode, withRows bool) (*domain.JobUpload, error) {
+	upload, err := s.jobUploadRepo.GetByID(id, withRows)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrJobUploadNotFound
+		}
+		return nil, err
+	}
+	if upload.UploadedBy != userID || upload.Mode != mode {
+		return nil, domain.ErrJobUploadNotFound
+	}
+	return upload, nil
+}
+
+// processUpload creates the jobs of the valid rows of an upload
+func (s *JobUploadService) processUpload(upload *domain.JobUpload) {
+	rows, err := s.jobUploadRepo.GetRowsByStatus(upload.ID, domain.JobUploadRowStatusValid)
+	if err != nil {
+		log.Printf("❌ Failed to load rows of job upload %s: %v", upload.ID, err)
+		return
+	}
+
+	log.Printf("🔄 Processing job upload %s: %d jobs", upload.ID, len(rows))
+
+	for i := range rows {
+		select {
+		case <-s.stopChan:
+			return
+		default:
+		}
+
+		s.processRow(upload, &rows[i])
+
+		if err := s.jobUploadRepo.RenewClaim(upload.ID, time.Now()); err != nil {
+			log.Printf("❌ Failed to renew claim of job upload %s: %v", upload.ID, err)
+		}
+	}
+
+	if err := s.jobUploadRepo.Complete(upload.ID, time.Now()); err != nil {
+		log.Printf("❌ Failed to complete job upload %s: %v", upload.ID, err)
+		return
+	}
+
+	log.Printf("✅ Job upload %s completed", upload.ID)
+}
+
+// processRow creates the job of a row and records the result. The row is finished in the
+// transaction that creates its job, and only while it is still valid, so a row is never created
+// twice, even when a second worker claimed the upload after its lease expired.
+func (s *JobUploadService) processRow(upload *domain.JobUpload, row *domain.JobUploadRow) {
+	_, err := s.createJob(upload, row, func(tx *gorm.DB, job *domain.Job) error {
+		row.Status = domain.JobUploadRowStatusCreated
+		row.Error = ""
+		row.JobID = &job.ID
+		finished, err := repository.NewJobUploadRepository(tx).FinishRow(row)
+		if err != nil {
+			return err
+		}
+		if !finished {
+			return errJobUploadRowProcessed
+		}
+		return nil
+	})
+	if errors.Is(err, errJobUploadRowProcessed) {
+		return
+	}
+	if err != nil {
+		row.Status = domain.JobUploadRowStatusFailed
+		row.Error = err.Error()
+		row.JobID = nil
+		log.Printf("❌ Failed to create job from row %d of upload %s: %v", row.RowNumber, upload.ID, err)
+
+		if _, err := s.jobUploadRepo.FinishRow(row); err != nil {
+			log.Printf("❌ Failed to save row %d of job upload %s: %v", row.RowNumber, upload.ID, err)
+			return
+		}
+	}
+
+	if err := s.jobUploadRepo.RefreshCounts(upload.ID); err != nil {
+		log.Printf("❌ Failed to refresh job upload %s: %v", upload.ID, err)
+	}
+}
+
+// createJob creates the job of a row as the uploader. Categories are assigned automatically
+// when the row has none. onCreate runs in the transaction that creates the job.
+func (s *JobUploadService) createJob(upload *domain.JobUpload, row *domain.JobUploadRow, onCreate func(tx *gorm.DB, job *domain.Job) error) (*domain.Job, error) {
+	if upload.Mode == domain.JobUploadModeAdmin {
+		var req dto.AdminCreateJobRequest
+		if err := json.Unmarshal(row.Data, &req); err != nil {
+			return nil, err
+		}
+		input, err := adminCreateJobInputFromRequest(&req)
+		if err != nil {
+			return nil, err
+		}
+		input.OnCreate = onCreate
+		return s.jobService.AdminCreateJob(upload.UploadedBy, input)
+	}
+
+	var req dto.CreateJobRequest
+	if err := json.Unmarshal(row.Data, &req); err != nil {
+		return nil, err
+	}
+	input, err := createJobInputFromRequest(&req)
+	if err != nil {
+		return nil, err
+	}
+	input.OnCreate = onCreate
+	return s.jobService.CreateJob(upload.UploadedBy, input)
+}
+
+// createJobInputFromRequest converts a row's create job request to the service input
+func createJobInputFromRequest(req *dto.CreateJobRequest) (CreateJobInput, error) {
+	categoryIDs, err := parseCategoryIDs(req.CategoryIDs)
+	if err != nil {
+		return CreateJobInput{}, err
+	}
+
+	input := CreateJobInput{
+		Title:              req.Title,
+		Description:        req.Description,
+		ShortDescription:   req.ShortDescription,
+		JobType:            domain.JobType(req.JobType),
+		ExperienceLevel:    domain.ExperienceLevel(req.ExperienceLevel),
+		WorkplaceType:      domain.WorkplaceType(req.WorkplaceType),
+		Location:           req.Location,
+		City:               req.City,
+		State:              req.State,
+		Country:            req.Country,
+		Latitude:           req.Latitude,
+		Longitude:          req.Longitude,
+		SalaryMin:          req.SalaryMin,
+		SalaryMax:          req.SalaryMax,
+		SalaryCurrency:     req.SalaryCurrency,
+		SalaryPeriod:       req.SalaryPeriod,
+		HideSalary:         req.HideSalary,
+		Skills:             req.Skills,
+		Education:          req.Education,
+		YearsExperienceMin: req.YearsExperienceMin,
+		YearsExperienceMax: req.YearsExperienceMax,
+		Benefits:           req.Benefits,
+		CategoryIDs:        categoryIDs,
+		ApplicationURL:     req.ApplicationURL,
+		ApplicationEmail:   req.ApplicationEmail,
+		Openings:           req.Openings,
+		RequisitionID:      req.RequisitionID,
+		SaveAsDraft:        req.SaveAsDraft,
+		PublishAt:          req.PublishAt,
+	}
+
+	if req.HiringManagerID != "" {
+		managerID, err := uuid.Parse(req.HiringManagerID)
+		if err != nil {
+			return CreateJobInput{}, domain.ErrInvalidHiringManager
+		}
+		input.HiringManagerID = &managerID
+	}
+
+	return input, nil
+}
+
+// adminCreateJobInputFromRequest converts a row's admin create job request to the service input
+func adminCreateJobInputFromRequest(req *dto.AdminCreateJobRequest) (AdminCreateJobInput, error) {
+	categoryIDs, err := parseCategoryIDs(req.CategoryIDs)
+	if err != nil {
+		return AdminCreateJobInput{}, err
+	}
+
+	return AdminCreateJobInput{
+		CreateJobInput: CreateJobInput{
+			Title:              req.Title,
+			Description:        req.Description,
+			ShortDescription:   req.ShortDescription,
+			JobType:            domain.JobType(req.JobType),
+			ExperienceLevel:    domain.ExperienceLevel(req.ExperienceLevel),
+			WorkplaceType:      domain.WorkplaceType(req.WorkplaceType),
+			Location:           req.Location,
+			City:               req.City,
+			State:              req.State,
+			Country:            req.Country,
+			Latitude:           req.Latitude,
+			Longitude:          req.Longitude,
+			SalaryMin:          req.SalaryMin,
+			SalaryMax:          req.SalaryMax,
+			SalaryCurrency:     req.SalaryCurrency,
+			SalaryPeriod:       req.SalaryPeriod,
+			HideSalary:         req.HideSalary,
+			Skills:             req.Skills,
+			Education:          req.Education,
+			YearsExperienceMin: req.YearsExperienceMin,
+			YearsExperienceMax: req.YearsExperienceMax,
+			Benefits:           req.Benefits,
+			CategoryIDs:        categoryIDs,
+			ApplicationURL:     req.ApplicationURL,
+			ApplicationEmail:   req.ApplicationEmail,
+		},
+		CompanyName:    req.CompanyName,
+		CompanyLogoURL: req.CompanyLogoURL,
+		Status:         req.Status,
+	}, nil
+}
+
+// parseCategoryIDs parses the category IDs of a create job request
+func parseCategoryIDs(ids []string) ([]uuid.UUID, error) {
+	var categoryIDs []uuid.UUID
+	for _, idStr := range ids {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			return nil, domain.ErrInvalidCategoryID
+		}
+		categoryIDs = append(categoryIDs, id)
+	}
+	return categoryIDs, nil
+}
+
+// mapJobUploadHeader maps the header cells of an upload file to columns by position.
+// Header cells that are not columns of the mode are returned as ignored.
+func mapJobUploadHeader(header []string, mode domain.JobUploadMode) (map[int]JobUploadColumn, []string) {
+	byName := make(map[string]JobUploadColumn)
+	for _, column := range GetJobUploadColumns(mode) {
+		byName[column.Name] = column
+	}
+
+	columns := make(map[int]JobUploadColumn)
+	seen := make(map[string]bool)
+	ignored := []string{}
+	for i, cell := range header {
+		name := normalizeJobUploadHeader(cell)
+		if name == "" {
+			continue
+		}
+		column, ok := byName[name]
+		if !ok || seen[name] {
+			ignored = append(ignored, strings.TrimSpace(cell))
+			continue
+		}
+		seen[name] = true
+		columns[i] = column
+	}
+	return columns, ignored
+}
+
+// normalizeJobUploadHeader turns headers like "Job Type" into column names like "job_type"
+func normalizeJobUploadHeader(header string) string {
+	name := strings.ToLower(strings.TrimSpace(header))
+	name = strings.NewReplacer(" ", "_", "-", "_").Replace(name)
+	return strings.Trim(name, "_")
+}
+
+// clipUploadText shortens text to fit a column of at most max characters
+func clipUploadText(text string, max int) string {
+	runes := []rune(text)
+	if len(runes) <= max {
+		return text
+	}
+	return string(runes[:max])
+}
+
+// isBlankRecord checks if every cell of a record is empty
+func isBlankRecord(record []string) bool {
+	for _, cell := range record {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// validateJobUploadRow converts a record to the create job request of the mode and validates it
+// with the request's binding rules, the same way the create job endpoints do
+func validateJobUploadRow(mode domain.JobUploadMode, columns map[int]JobUploadColumn, record []string) (*domain.JobUploadRow, error) {
+	var fieldErrors []domain.JobUploadFieldError
+
+	positions := make([]int, 0, len(columns))
+	for i := range columns {
+		positions = append(positions, i)
+	}
+	sort.Ints(positions)
+
+	values := make(map[string]interface{})
+	for _, i := range positions {
+		column := columns[i]
+		if i >= len(record) {
+			continue
+		}
+		cell := strings.TrimSpace(record[i])
+		if cell == "" {
+			continue
+		}
+		value, err := parseJobUploadCell(column.Type, cell)
+		if err != nil {
+			fieldErrors = append(fieldErrors, domain.JobUploadFieldError{Column: column.Name, Message: err.Error()})
+			continue
+		}
+		values[column.Name] = value
+	}
+
+	raw, err := json.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+
+	var req interface{} = &dto.CreateJobRequest{}
+	if mode == domain.JobUploadModeAdmin {
+		req = &dto.AdminCreateJobRequest{}
+	}
+	if err := json.Unmarshal(raw, req); err != nil {
+		return nil, err
+	}
+
+	if err := binding.Validator.ValidateStruct(req); err != nil {
+		var validationErrors validator.ValidationErrors
+		if !errors.As(err, &validationErrors) {
+			return nil, err
+		}
+		for _, fe := range validationErrors {
+			fieldErrors = append(fieldErrors, domain.JobUploadFieldError{
+				Column:  jsonFieldName(req, fe.StructField()),
+				Message: validationMessage(fe),
+			})
+		}
+	}
+
+	var categoryIDs []string
+	switch r := req.(type) {
+	case *dto.CreateJobRequest:
+		categoryIDs = r.CategoryIDs
+	case *dto.AdminCreateJobRequest:
+		categoryIDs = r.CategoryIDs
+	}
+	if _, err := parseCategoryIDs(categoryIDs); err != nil {
+		fieldErrors = append(fieldErrors, domain.JobUploadFieldError{Column: "category_ids", Message: "must be valid category IDs"})
+	}
+
+	data, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	row := &domain.JobUploadRow{
+		Data:   datatypes.JSON(data),
+		Status: domain.JobUploadRowStatusValid,
+	}
+	if title, ok := values["title"].(string); ok {
+		row.Title = clipUploadText(title, 255)
+	}
+	if len(fieldErrors) > 0 {
+		errorsJSON, err := json.Marshal(fieldErrors)
+		if err != nil {
+			return nil, err
+		}
+		row.Status = domain.JobUploadRowStatusInvalid
+		row.Errors = datatypes.JSON(errorsJSON)
+	}
+	return row, nil
+}
+
+// parseJobUploadCell parses a non-empty cell into the JSON value of its column
+func parseJobUploadCell(columnType JobUploadColumnType, cell string) (interface{}, error) {
+	switch columnType {
+	case JobUploadColumnChoice:
+		return strings.ToUpper(normalizeJobUploadHeader(cell)), nil
+	case JobUploadColumnInteger:
+		n, err := strconv.ParseFloat(cell, 64)
+		if err != nil || n != float64(int(n)) {
+			return nil, errors.New("must be a whole number")
+		}
+		return int(n), nil
+	case JobUploadColumnNumber:
+		n, err := strconv.ParseFloat(cell, 64)
+		if err != nil {
+			return nil, errors.New("must be a number")
+		}
+		return n, nil
+	case JobUploadColumnBoolean:
+		switch strings.ToLower(cell) {
+		case "true", "yes", "y", "1":
+			return true, nil
+		case "false", "no", "n", "0":
+			return false, nil
+		}
+		return nil, errors.New("must be true or false")
+	case JobUploadColumnList:
+		var items []string
+		for _, item := range strings.FieldsFunc(cell, func(r rune) bool { return r == ';' || r == '|' }) {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		return items, nil
+	case JobUploadColumnDateTime:
+		for _, layout := range []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02"} {
+			if t, err := time.Parse(layout, cell); err == nil {
+				return t, nil
+			}
+		}
+		return nil, errors.New("must be a date like 2006-01-02 or 2006-01-02 15:04")
+	default:
+		return cell, nil
+	}
+}
+
+// jsonFieldName returns the JSON name of a field of a request struct, which is its column name
+func jsonFieldName(req interface{}, field string) string {
+	t := reflect.TypeOf(req)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if f, ok := t.FieldByName(field); ok {
+		if name := strings.Split(f.Tag.Get("json"), ",")[0]; name != "" {
+			return name
+		}
+	}
+	return field
+}
+
+// validationMessage describes a failed binding rule of a column
+func validationMessage(fe validator.FieldError) string {
+	isText := fe.Kind() == reflect.String
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "min":
+		if isText {
+			return fmt.Sprintf("must be at least %s characters", fe.Param())
+		}
+		return fmt.Sprintf("must be at least %s", fe.Param())
+	case "max":
+		if isText {
+			return fmt.Sprintf("must be at most %s characters", fe.Param())
+		}
+		return fmt.Sprintf("must be at most %s", fe.Param())
+	case "oneof":
+		return "must be one of " + strings.ReplaceAll(fe.Param(), " ", ", ")
+	case "email":
+		return "must be a valid email address"
+	case "uuid":
+		return "must be a valid ID"
+	default:
+		return "is invalid"
+	}
+}
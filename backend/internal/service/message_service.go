@@ -0,0 +1,339 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"job-platform/internal/domain"
+	"job-platform/internal/repository"
+	"job-platform/internal/storage"
+	"job-platform/internal/util/email"
+	"mime/multipart"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	// MaxMessageLength is the maximum length of a message in characters
+	MaxMessageLength = 5000
+	// MaxMessageAttachments is the maximum number of files attached to one message
+	MaxMessageAttachments = 5
+	// MaxMessageAttachmentSizeMB is the maximum size of one attachment
+	MaxMessageAttachmentSizeMB = 10
+	// MessageAttachmentURLExpiry is how long attachment download links stay valid
+	MessageAttachmentURLExpiry = time.Hour
+	// messageExcerptLength is the length of the message excerpt in notifications
+	messageExcerptLength = 200
+)
+
+// messageAttachmentTypes are the file extensions allowed as message attachments
+var messageAttachmentTypes = []string{"pdf", "doc", "docx", "txt", "rtf", "odt", "png", "jpg", "jpeg", "gif", "webp"}
+
+// MessageConfig holds configuration for message notification emails
+type MessageConfig struct {
+	CompanyName  string
+	SupportEmail string
+	FrontendURL  string
+	Bucket       string
+}
+
+// MessageService handles messaging between employers and applicants
+type MessageService struct {
+	messageRepo         *repository.MessageRepository
+	applicationRepo     *repository.ApplicationRepository
+	storageClient       *storage.MinioClient
+	emailService        email.EmailSender
+	notificationService *NotificationService
+	config              *MessageConfig
+}
+
+// NewMessageService creates a new message service
+func NewMessageService(
+	messageRepo *repository.MessageRepository,
+	applicationRepo *repository.ApplicationRepository,
+	storageClient *storage.MinioClient,
+	emailService email.EmailSender,
+	config *MessageConfig,
+) *MessageService {
+	return &MessageService{
+		messageRepo:     messageRepo,
+		applicationRepo: applicationRepo,
+		storageClient:   storageClient,
+		emailService:    emailService,
+		config:          config,
+	}
+}
+
+// SetNotificationService sets the notification service (to avoid circular dependency)
+func (s *MessageService) SetNotificationService(ns *NotificationService) {
+	s.notificationService = ns
+}
+
+// SendMessageInput represents input for sending a message
+type SendMessageInput struct {
+	Body  string
+	Files []*multipart.FileHeader
+}
+
+// StartConversation retrieves or creates the conversation of an application.
+// Only the job's employer and the applicant can take part.
+func (s *MessageService) StartConversation(applicationID, userID uuid.UUID) (*domain.Conversation, error) {
+	application, err := s.applicationRepo.GetByID(applicationID)
+	if err != nil {
+		return nil, domain.ErrApplicationNotFound
+	}
+	if application.ApplicantID != userID && application.Job.EmployerID != userID {
+		return nil, domain.ErrApplicationNotFound
+	}
+
+	conversation, err := s.messageRepo.GetConversationByApplication(applicationID)
+	if err == nil {
+		return conversation, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	return s.messageRepo.GetOrCreateConversation(&domain.Conversation{
+		ApplicationID: application.ID,
+		EmployerID:    application.Job.EmployerID,
+		CandidateID:   application.ApplicantID,
+	})
+}
+
+// GetConversations retrieves a user's conversations with their latest message and unread count
+func (s *MessageService) GetConversations(userID uuid.UUID, page, limit int) ([]domain.Conversation, int64, error) {
+	offset := (page - 1) * limit
+	conversations, total, err := s.messageRepo.GetUserConversations(userID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]uuid.UUID, len(conversations))
+	for i := range conversations {
+		ids[i] = conversations[i].ID
+	}
+	lastMessages, err := s.messageRepo.GetLastMessages(ids)
+	if err != nil {
+		return nil, 0, err
+	}
+	unread, err := s.messageRepo.CountUnreadByConversation(ids, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	for i := range conversations {
+		conversations[i].LastMessage = lastMessages[conversations[i].ID]
+		conversations[i].UnreadCount = unread[conversations[i].ID]
+	}
+
+	return conversations, total, nil
+}
+
+// GetConversation retrieves a conversation the user takes part in
+func (s *MessageService) GetConversation(conversationID, userID uuid.UUID) (*domain.Conversation, error) {
+	conversation, err := s.messageRepo.GetConversationByID(conversationID)
+	if err != nil {
+		return nil, domain.ErrConversationNotFound
+	}
+	if !conversation.IsParticipant(userID) {
+		return nil, domain.ErrNotConversationMember
+	}
+	return conversation, nil
+}
+
+// GetMessages retrieves a page of messages (newest first) and marks received messages as read
+func (s *MessageService) GetMessages(conversationID, userID uuid.UUID, page, limit int) ([]domain.Message, int64, error) {
+	if _, err := s.GetConversation(conversationID, userID); err != nil {
+		return nil, 0, err
+	}
+
+	if _, err := s.messageRepo.MarkRead(conversationID, userID); err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * limit
+	return s.messageRepo.GetMessages(conversationID, limit, offset)
+}
+
+// MarkRead marks the messages a user received in a conversation as read
+func (s *MessageService) MarkRead(conversationID, userID uuid.UUID) error {
+	if _, err := s.GetConversation(conversationID, userID); err != nil {
+		return err
+	}
+	_, err := s.messageRepo.MarkRead(conversationID, userID)
+	return err
+}
+
+// GetUnreadCount returns the number of unread messages a user received
+func (s *MessageService) GetUnreadCount(userID uuid.UUID) (int64, error) {
+	return s.messageRepo.CountUnread(userID)
+}
+
+// SendMessage sends a message with optional attachments. The recipient is notified in-app
+// and by email when this is the first message they have not read yet.
+func (s *MessageService) SendMessage(conversationID, senderID uuid.UUID, input SendMessageInput) (*domain.Message, error) {
+	conversation, err := s.GetConversation(conversationID, senderID)
+	if err != nil {
+		return nil, err
+	}
+
+	body := strings.TrimSpace(input.Body)
+	if body == "" && len(input.Files) == 0 {
+		return nil, domain.ErrMessageEmpty
+	}
+	if utf8.RuneCountInString(body) > MaxMessageLength {
+		return nil, domain.ErrMessageTooLong
+	}
+	if len(input.Files) > MaxMessageAttachments {
+		return nil, domain.ErrTooManyAttachments
+	}
+	for _, file := range input.Files {
+		if err := s.storageClient.ValidateFile(file, messageAttachmentTypes, MaxMessageAttachmentSizeMB); err != nil {
+			return nil, domain.ErrInvalidAttachment
+		}
+	}
+
+	// Check before saving whether the recipient is already waiting on unread messages
+	unread, err := s.messageRepo.CountUnreadByConversation([]uuid.UUID{conversation.ID}, conversation.OtherParticipant(senderID))
+	if err != nil {
+		return nil, err
+	}
+
+	attachments, err := s.uploadAttachments(conversation, input.Files)
+	if err != nil {
+		return nil, err
+	}
+
+	message := &domain.Message{
+		ConversationID: conversation.ID,
+		SenderID:       senderID,
+		Body:           body,
+		Attachments:    attachments,
+	}
+	if err := s.messageRepo.CreateMessage(message); err != nil {
+		s.deleteAttachments(attachments)
+		return nil, err
+	}
+
+	if senderID == conversation.EmployerID {
+		message.Sender = conversation.Employer
+	} else {
+		message.Sender = conversation.Candidate
+	}
+
+	if unread[conversation.ID] == 0 {
+		s.notifyRecipient(conversation, message)
+	}
+
+	return message, nil
+}
+
+// GetAttachmentURL returns an attachment with a short-lived download URL
+func (s *MessageService) GetAttachmentURL(conversationID, attachmentID, userID uuid.UUID) (*domain.MessageAttachment, string, error) {
+	if _, err := s.GetConversation(conversationID, userID); err != nil {
+		return nil, "", err
+	}
+
+	attachment, err := s.messageRepo.GetAttachment(conversationID, attachmentID)
+	if err != nil {
+		return nil, "", domain.ErrAttachmentNotFound
+	}
+
+	url, err := s.storageClient.GetSignedURL(s.config.Bucket, attachment.FilePath, MessageAttachmentURLExpiry)
+	if err != nil {
+		return nil, "", domain.ErrStorageDownloadFailed
+	}
+	return attachment, url, nil
+}
+
+// uploadAttachments stores message attachments under the conversation's folder
+func (s *MessageService) uploadAttachments(conversation *domain.Conversation, files []*multipart.FileHeader) ([]domain.MessageAttachment, error) {
+	attachments := make([]domain.MessageAttachment, 0, len(files))
+	for _, file := range files {
+		path := storage.GenerateFilePath(conversation.ID, storage.GenerateUniqueFileName(file.Filename))
+		result, err := s.storageClient.UploadFile(s.config.Bucket, file, path)
+		if err != nil {
+			s.deleteAttachments(attachments)
+			return nil, domain.ErrAttachmentUploadFailed
+		}
+		attachments = append(attachments, domain.MessageAttachment{
+			FileName: file.Filename,
+			FilePath: result.Path,
+			FileSize: result.Size,
+			MimeType: result.ContentType,
+		})
+	}
+	return attachments, nil
+}
+
+// deleteAttachments removes uploaded attachment files
+func (s *MessageService) deleteAttachments(attachments []domain.MessageAttachment) {
+	if len(attachments) == 0 {
+		return
+	}
+	paths := make([]string, len(attachments))
+	for i, a := range attachments {
+		paths[i] = a.FilePath
+	}
+	_ = s.storageClient.DeleteFiles(s.config.Bucket, paths)
+}
+
+// notifyRecipient sends the in-app notification and, if the recipient allows it, an email
+func (s *MessageService) notifyRecipient(conversation *domain.Conversation, message *domain.Message) {
+	recipient := conversation.Candidate
+	link := fmt.Sprintf("/jobseeker/messages/%s", conversation.ID)
+	if message.SenderID == conversation.CandidateID {
+		recipient = conversation.Employer
+		link = fmt.Sprintf("/employer/messages/%s", conversation.ID)
+	}
+	if recipient == nil {
+		return
+	}
+
+	senderName := "Someone"
+	if message.Sender != nil {
+		senderName = message.Sender.FirstName + " " + message.Sender.LastName
+	}
+	jobTitle := ""
+	if conversation.Application != nil {
+		jobTitle = conversation.Application.Job.Title
+	}
+	excerpt := message.Body
+	if utf8.RuneCountInString(excerpt) > messageExcerptLength {
+		excerpt = string([]rune(excerpt)[:messageExcerptLength]) + "..."
+	}
+	if excerpt == "" {
+		excerpt = fmt.Sprintf("%d attachment(s)", len(message.Attachments))
+	}
+
+	go func() {
+		ctx := context.Background()
+		if s.notificationService != nil {
+			_ = s.notificationService.NotifyNewMessage(ctx, recipient.ID, conversation.ID, conversation.ApplicationID, senderName, jobTitle, excerpt, link)
+
+			prefs, err := s.notificationService.GetPreferences(ctx, recipient.ID)
+			if err != nil || !s.notificationService.ShouldSendEmail(prefs, domain.NotificationNewMessage) {
+				return
+			}
+		}
+		if s.emailService == nil {
+			return
+		}
+		_ = s.emailService.SendNewMessageEmail(email.MessageEmailData{
+			Name:            recipient.FirstName,
+			Email:           recipient.Email,
+			SenderName:      senderName,
+			JobTitle:        jobTitle,
+			Excerpt:         excerpt,
+			AttachmentCount: len(message.Attachments),
+			ConversationURL: s.config.FrontendURL + link,
+			CompanyName:     s.config.CompanyName,
+			SupportEmail:    s.config.SupportEmail,
+			Year:            time.Now().Year(),
+		})
+	}()
+}
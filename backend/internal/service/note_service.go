@@ -0,0 +1,375 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"job-platform/internal/domain"
+	"job-platform/internal/repository"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	// MaxNoteLength is the maximum length of a note in characters
+	MaxNoteLength = 5000
+	// noteExcerptLength is the length of the note excerpt used in mention notifications
+	noteExcerptLength = 120
+)
+
+// NoteService handles employer notes on applications and candidates
+type NoteService struct {
+	noteRepo            *repository.NoteRepository
+	applicationRepo     *repository.ApplicationRepository
+	userRepo            *repository.UserRepository
+	teamRepo            *repository.TeamRepository
+	companyRepo         *repository.CompanyRepository
+	notificationService *NotificationService
+}
+
+// NewNoteService creates a new note service
+func NewNoteService(
+	noteRepo *repository.NoteRepository,
+	applicationRepo *repository.ApplicationRepository,
+	userRepo *repository.UserRepository,
+	teamRepo *repository.TeamRepository,
+	companyRepo *repository.CompanyRepository,
+) *NoteService {
+	return &NoteService{
+		noteRepo:        noteRepo,
+		applicationRepo: applicationRepo,
+		userRepo:        userRepo,
+		teamRepo:        teamRepo,
+		companyRepo:     companyRepo,
+	}
+}
+
+// SetNotificationService sets the notification service (to avoid circular dependency)
+func (s *NoteService) SetNotificationService(ns *NotificationService) {
+	s.notificationService = ns
+}
+
+// CreateNoteInput represents input for adding a note or a reply
+type CreateNoteInput struct {
+	Content    string
+	ParentID   *uuid.UUID  // Set to reply to an existing note
+	MentionIDs []uuid.UUID // User IDs of mentioned team members
+}
+
+// UpdateNoteInput represents input for editing a note
+type UpdateNoteInput struct {
+	Content    string
+	MentionIDs []uuid.UUID
+}
+
+// noteSubject is a resolved application or candidate that notes are attached to
+type noteSubject struct {
+	companyID uuid.UUID
+	typ       domain.NoteSubjectType
+	id        uuid.UUID
+	name      string // Used in mention notifications
+	link      string
+}
+
+// GetApplicationNotes retrieves the company team's notes on an application
+func (s *NoteService) GetApplicationNotes(applicationID, userID uuid.UUID) ([]domain.Note, error) {
+	subject, err := s.applicationSubject(applicationID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.noteRepo.GetBySubject(subject.companyID, subject.typ, subject.id)
+}
+
+// AddApplicationNote adds a note on an application
+func (s *NoteService) AddApplicationNote(applicationID, userID uuid.UUID, input CreateNoteInput) (*domain.Note, error) {
+	subject, err := s.applicationSubject(applicationID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.createNote(subject, userID, input)
+}
+
+// GetCandidateNotes retrieves the company team's notes on a candidate profile
+func (s *NoteService) GetCandidateNotes(candidateID, userID uuid.UUID) ([]domain.Note, error) {
+	subject, err := s.candidateSubject(candidateID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.noteRepo.GetBySubject(subject.companyID, subject.typ, subject.id)
+}
+
+// AddCandidateNote adds a note on a candidate profile
+func (s *NoteService) AddCandidateNote(candidateID, userID uuid.UUID, input CreateNoteInput) (*domain.Note, error) {
+	subject, err := s.candidateSubject(candidateID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.createNote(subject, userID, input)
+}
+
+// UpdateNote edits a note (author only). Newly mentioned team members are notified.
+func (s *NoteService) UpdateNote(noteID, userID uuid.UUID, input UpdateNoteInput) (*domain.Note, error) {
+	note, subject, err := s.getAuthoredNote(noteID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := normalizeNoteContent(input.Content)
+	if err != nil {
+		return nil, err
+	}
+	mentionIDs, err := s.validateMentions(note.CompanyID, input.MentionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	alreadyMentioned := make(map[uuid.UUID]bool, len(note.Mentions))
+	for _, m := range note.Mentions {
+		alreadyMentioned[m.UserID] = true
+	}
+
+	now := time.Now()
+	note.Content = content
+	note.EditedAt = &now
+	if err := s.noteRepo.Update(note, mentionIDs); err != nil {
+		return nil, err
+	}
+
+	newMentions := make([]uuid.UUID, 0, len(mentionIDs))
+	for _, id := range mentionIDs {
+		if !alreadyMentioned[id] {
+			newMentions = append(newMentions, id)
+		}
+	}
+	updated, err := s.noteRepo.GetByID(note.ID)
+	if err != nil {
+		return nil, err
+	}
+	s.notifyMentions(updated, subject, newMentions)
+
+	return updated, nil
+}
+
+// DeleteNote deletes a note and its replies (author only)
+func (s *NoteService) DeleteNote(noteID, userID uuid.UUID) error {
+	note, _, err := s.getAuthoredNote(noteID, userID)
+	if err != nil {
+		return err
+	}
+	return s.noteRepo.Delete(note.ID)
+}
+
+// createNote validates and stores a note on a subject, then notifies mentioned team members
+func (s *NoteService) createNote(subject *noteSubject, authorID uuid.UUID, input CreateNoteInput) (*domain.Note, error) {
+	content, err := normalizeNoteContent(input.Content)
+	if err != nil {
+		return nil, err
+	}
+	mentionIDs, err := s.validateMentions(subject.companyID, input.MentionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	note := &domain.Note{
+		CompanyID:   subject.companyID,
+		SubjectType: subject.typ,
+		SubjectID:   subject.id,
+		AuthorID:    authorID,
+		Content:     content,
+	}
+
+	if input.ParentID != nil {
+		parent, err := s.noteRepo.GetByID(*input.ParentID)
+		if err != nil || parent.CompanyID != subject.companyID ||
+			parent.SubjectType != subject.typ || parent.SubjectID != subject.id {
+			return nil, domain.ErrNoteNotFound
+		}
+		// Threads are one level deep: replies to a reply join the original thread
+		parentID := parent.ID
+		if parent.IsReply() {
+			parentID = *parent.ParentID
+		}
+		note.ParentID = &parentID
+	}
+
+	if err := s.noteRepo.Create(note, mentionIDs); err != nil {
+		return nil, err
+	}
+
+	created, err := s.noteRepo.GetByID(note.ID)
+	if err != nil {
+		return nil, err
+	}
+	s.notifyMentions(created, subject, mentionIDs)
+
+	return created, nil
+}
+
+// getAuthoredNote retrieves a note the user wrote and may still access
+func (s *NoteService) getAuthoredNote(noteID, userID uuid.UUID) (*domain.Note, *noteSubject, error) {
+	note, err := s.noteRepo.GetByID(noteID)
+	if err != nil {
+		return nil, nil, domain.ErrNoteNotFound
+	}
+
+	var subject *noteSubject
+	switch note.SubjectType {
+	case domain.NoteSubjectApplication:
+		subject, err = s.applicationSubject(note.SubjectID, userID)
+	default:
+		subject, err = s.candidateSubject(note.SubjectID, userID)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if subject.companyID != note.CompanyID {
+		return nil, nil, domain.ErrNoteNotFound
+	}
+	if !note.IsAuthor(userID) {
+		return nil, nil, domain.ErrNotNoteAuthor
+	}
+	return note, subject, nil
+}
+
+// applicationSubject resolves an application and checks the user belongs to its job's company
+func (s *NoteService) applicationSubject(applicationID, userID uuid.UUID) (*noteSubject, error) {
+	application, err := s.applicationRepo.GetByID(applicationID)
+	if err != nil {
+		return nil, domain.ErrApplicationNotFound
+	}
+
+	company, err := s.companyRepo.GetJobCompany(&application.Job)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrCompanyNotFound
+		}
+		return nil, err
+	}
+	if err := s.requireMember(company.ID, userID); err != nil {
+		return nil, err
+	}
+
+	return &noteSubject{
+		companyID: company.ID,
+		typ:       domain.NoteSubjectApplication,
+		id:        application.ID,
+		name:      fmt.Sprintf("%s %s's application for %s", application.Applicant.FirstName, application.Applicant.LastName, application.Job.Title),
+		link:      fmt.Sprintf("/employer/applications/%s", application.ID),
+	}, nil
+}
+
+// candidateSubject resolves a candidate and the company whose team notes the user sees
+func (s *NoteService) candidateSubject(candidateID, userID uuid.UUID) (*noteSubject, error) {
+	candidate, err := s.userRepo.GetByID(candidateID)
+	if err != nil || candidate.Role != domain.RoleJobSeeker {
+		return nil, domain.ErrUserNotFound
+	}
+
+	companyID, err := s.userCompanyID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &noteSubject{
+		companyID: companyID,
+		typ:       domain.NoteSubjectCandidate,
+		id:        candidate.ID,
+		name:      candidate.FirstName + " " + candidate.LastName,
+		link:      fmt.Sprintf("/employer/candidates/%s", candidate.ID),
+	}, nil
+}
+
+// userCompanyID returns the company a user created, falling back to the first team they belong to
+func (s *NoteService) userCompanyID(userID uuid.UUID) (uuid.UUID, error) {
+	company, err := s.companyRepo.GetByUserID(userID)
+	if err == nil {
+		return company.ID, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return uuid.Nil, err
+	}
+
+	memberships, err := s.teamRepo.GetUserCompanies(userID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if len(memberships) == 0 {
+		return uuid.Nil, domain.ErrCompanyNotFound
+	}
+	return memberships[0].CompanyID, nil
+}
+
+// requireMember checks that a user is an active member of a company team
+func (s *NoteService) requireMember(companyID, userID uuid.UUID) error {
+	member, err := s.teamRepo.GetByCompanyAndUser(companyID, userID)
+	if err != nil || member.Status != domain.TeamMemberStatusActive {
+		return domain.ErrNotCompanyMember
+	}
+	return nil
+}
+
+// validateMentions deduplicates mentioned users and checks they are on the company team
+func (s *NoteService) validateMentions(companyID uuid.UUID, userIDs []uuid.UUID) ([]uuid.UUID, error) {
+	seen := make(map[uuid.UUID]bool, len(userIDs))
+	mentions := make([]uuid.UUID, 0, len(userIDs))
+	for _, id := range userIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if err := s.requireMember(companyID, id); err != nil {
+			return nil, domain.ErrInvalidNoteMention
+		}
+		mentions = append(mentions, id)
+	}
+	return mentions, nil
+}
+
+// notifyMentions notifies mentioned team members, except the author
+func (s *NoteService) notifyMentions(note *domain.Note, subject *noteSubject, userIDs []uuid.UUID) {
+	if s.notificationService == nil || len(userIDs) == 0 {
+		return
+	}
+
+	authorName := "A team member"
+	if note.Author != nil {
+		authorName = note.Author.FirstName + " " + note.Author.LastName
+	}
+	excerpt := note.Content
+	if utf8.RuneCountInString(excerpt) > noteExcerptLength {
+		excerpt = string([]rune(excerpt)[:noteExcerptLength]) + "..."
+	}
+
+	for _, userID := range userIDs {
+		if userID == note.AuthorID {
+			continue
+		}
+		go func(userID uuid.UUID) {
+			_ = s.notificationService.NotifyNoteMention(
+				context.Background(),
+				userID,
+				note.ID,
+				authorName,
+				subject.name,
+				excerpt,
+				subject.link,
+			)
+		}(userID)
+	}
+}
+
+// normalizeNoteContent trims note content and validates its length
+func normalizeNoteContent(content string) (string, error) {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return "", domain.ErrNoteEmpty
+	}
+	if utf8.RuneCountInString(content) > MaxNoteLength {
+		return "", domain.ErrNoteTooLong
+	}
+	return content, nil
+}
@@ -5,16 +5,60 @@ import (
 	"encoding/json"
 	"fmt"
 	"job-platform/internal/domain"
+	"job-platform/internal/realtime"
 	"job-platform/internal/repository"
+	"job-platform/internal/util/email"
+	"log"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+const (
+	// NotificationDigestPeriod is how long notifications are batched before a digest email is sent
+	NotificationDigestPeriod = 24 * time.Hour
+	// notificationDigestBatchSize is how many users' digests are sent per run
+	notificationDigestBatchSize = 500
+	// notificationDigestEmailItems is the number of notifications listed in a digest email
+	notificationDigestEmailItems = 20
+	// notificationDigestRetention is how long sent digest items are kept
+	notificationDigestRetention = 7 * 24 * time.Hour
+)
+
+// digestNotificationTypes are high-volume types batched into a daily digest email when the
+// user keeps the daily digest enabled, instead of an email per notification
+var digestNotificationTypes = map[domain.NotificationType]bool{
+	domain.NotificationNewApplication:    true,
+	domain.NotificationNewJobFromCompany: true,
+	domain.NotificationProfileViewed:     true,
+}
+
+// ownEmailNotificationTypes have dedicated emails sent by the service creating them
+// (message excerpts, calendar invites, matching jobs), so no generic email is sent
+var ownEmailNotificationTypes = map[domain.NotificationType]bool{
+	domain.NotificationNewMessage:         true,
+	domain.NotificationInterviewScheduled: true,
+	domain.NotificationInterviewCancelled: true,
+	domain.NotificationJobAlert:           true,
+}
+
+// NotificationEmailConfig holds configuration for notification emails
+type NotificationEmailConfig struct {
+	CompanyName  string
+	SupportEmail string
+	FrontendURL  string
+}
+
 // NotificationService handles notification business logic
 type NotificationService struct {
 	notificationRepo *repository.NotificationRepository
 	preferencesRepo  *repository.NotificationPreferencesRepository
+	realtimeHub      *realtime.Hub
+	emailService     email.EmailSender
+	userRepo         *repository.UserRepository
+	digestRepo       *repository.NotificationDigestRepository
+	emailConfig      *NotificationEmailConfig
+	pushService      *PushService
 }
 
 // NewNotificationService creates a new notification service
@@ -28,6 +72,29 @@ func NewNotificationService(
 	}
 }
 
+// SetEmailService enables notification emails. Without it notifications are only shown in-app.
+func (s *NotificationService) SetEmailService(
+	emailService email.EmailSender,
+	userRepo *repository.UserRepository,
+	digestRepo *repository.NotificationDigestRepository,
+	config *NotificationEmailConfig,
+) {
+	s.emailService = emailService
+	s.userRepo = userRepo
+	s.digestRepo = digestRepo
+	s.emailConfig = config
+}
+
+// SetPushService enables web push notifications to the users' subscribed browsers
+func (s *NotificationService) SetPushService(pushService *PushService) {
+	s.pushService = pushService
+}
+
+// SetRealtimeHub sets the hub used to push new notifications and unread counts to connected users
+func (s *NotificationService) SetRealtimeHub(hub *realtime.Hub) {
+	s.realtimeHub = hub
+}
+
 // CreateNotificationInput represents input for creating a notification
 type CreateNotificationInput struct {
 	UserID  uuid.UUID
@@ -38,7 +105,8 @@ type CreateNotificationInput struct {
 	Data    map[string]interface{}
 }
 
-// CreateNotification creates a notification for a user based on their preferences
+// CreateNotification creates a notification for a user based on their preferences.
+// The notification is also emailed, or queued for the daily digest, when the user's email preferences allow.
 func (s *NotificationService) CreateNotification(ctx context.Context, input CreateNotificationInput) (*domain.Notification, error) {
 	// Get user preferences
 	prefs, err := s.preferencesRepo.GetOrCreate(input.UserID)
@@ -47,12 +115,30 @@ func (s *NotificationService) CreateNotification(ctx context.Context, input Crea
 	}
 
 	// Check if user wants in-app notifications for this type
-	if !s.shouldSendInApp(prefs, input.Type) {
-		return nil, nil // User opted out of this notification type
+	var notification *domain.Notification
+	if s.shouldSendInApp(prefs, input.Type) {
+		notification, err = s.createInApp(ctx, input)
+		if err != nil {
+			return nil, err
+		}
 	}
 
+	if s.emailService != nil && !ownEmailNotificationTypes[input.Type] && s.ShouldSendEmail(prefs, input.Type) {
+		s.sendEmail(prefs, input)
+	}
+
+	if s.pushService != nil && s.pushService.IsEnabled() && s.ShouldSendPush(prefs, input.Type) {
+		s.sendPush(input, notification)
+	}
+
+	return notification, nil
+}
+
+// createInApp stores an in-app notification and pushes it to the connected user
+func (s *NotificationService) createInApp(ctx context.Context, input CreateNotificationInput) (*domain.Notification, error) {
 	// Convert data to JSON
 	var dataJSON []byte
+	var err error
 	if input.Data != nil {
 		dataJSON, err = json.Marshal(input.Data)
 		if err != nil {
@@ -76,15 +162,217 @@ func (s *NotificationService) CreateNotification(ctx context.Context, input Crea
 		return nil, fmt.Errorf("failed to create notification: %w", err)
 	}
 
+	if s.realtimeHub != nil {
+		if err := s.realtimeHub.Publish(ctx, notification.UserID, realtime.EventNotification, notification); err != nil {
+			log.Printf("Error publishing notification %s: %v", notification.ID, err)
+		}
+		s.publishUnreadCount(ctx, notification.UserID)
+	}
+
 	return notification, nil
 }
 
+// sendEmail emails a notification, or queues it for the daily digest when it is of a high-volume type
+func (s *NotificationService) sendEmail(prefs *domain.NotificationPreferences, input CreateNotificationInput) {
+	if prefs.EmailDailyDigest && digestNotificationTypes[input.Type] {
+		item := &domain.NotificationDigestItem{
+			ID:        uuid.New(),
+			UserID:    input.UserID,
+			Type:      input.Type,
+			Title:     input.Title,
+			Message:   input.Message,
+			Link:      input.Link,
+			CreatedAt: time.Now(),
+		}
+		if err := s.digestRepo.Create(item); err != nil {
+			log.Printf("Error queuing %s notification for digest of user %s: %v", input.Type, input.UserID, err)
+		}
+		return
+	}
+
+	// Send asynchronously, notifications are often created while handling a request
+	go func() {
+		user, err := s.userRepo.GetByID(input.UserID)
+		if err != nil {
+			log.Printf("Error loading user %s for notification email: %v", input.UserID, err)
+			return
+		}
+
+		data := email.NotificationEmailData{
+			Name:           user.FirstName,
+			Email:          user.Email,
+			Type:           string(input.Type),
+			Title:          input.Title,
+			Message:        input.Message,
+			Details:        emailDetails(input.Data),
+			PreferencesURL: s.preferencesURL(user),
+			CompanyName:    s.emailConfig.CompanyName,
+			SupportEmail:   s.emailConfig.SupportEmail,
+			Year:           time.Now().Year(),
+		}
+		if input.Link != nil {
+			data.ActionURL = s.emailConfig.FrontendURL + *input.Link
+		}
+
+		if err := s.emailService.SendNotificationEmail(data); err != nil {
+			log.Printf("Failed to send %s notification email to %s: %v", input.Type, user.Email, err)
+		}
+	}()
+}
+
+// emailDetails converts notification data to the values used by the email templates
+func emailDetails(data map[string]interface{}) map[string]string {
+	details := make(map[string]string, len(data))
+	for key, value := range data {
+		details[key] = fmt.Sprint(value)
+	}
+	return details
+}
+
+// sendPush delivers a notification to the user's subscribed browsers (async)
+func (s *NotificationService) sendPush(input CreateNotificationInput, notification *domain.Notification) {
+	message := PushMessage{
+		Type:  string(input.Type),
+		Title: input.Title,
+		Body:  input.Message,
+		Tag:   string(input.Type),
+	}
+	if input.Link != nil {
+		message.URL = *input.Link
+		message.Tag = *input.Link
+	}
+	if notification != nil {
+		message.NotificationID = notification.ID.String()
+	}
+
+	go func() {
+		if _, err := s.pushService.SendToUser(context.Background(), input.UserID, message); err != nil {
+			log.Printf("Error sending %s push notification to user %s: %v", input.Type, input.UserID, err)
+		}
+	}()
+}
+
+// SendEmailDigests emails the notifications batched for users whose digest is due.
+// Returns the number of digests sent.
+func (s *NotificationService) SendEmailDigests(ctx context.Context) (int, error) {
+	if s.emailService == nil {
+		return 0, nil
+	}
+
+	now := time.Now()
+	userIDs, err := s.digestRepo.GetDueUserIDs(now.Add(-NotificationDigestPeriod), notificationDigestBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, userID := range userIDs {
+		items, err := s.digestRepo.GetPending(userID, now)
+		if err != nil {
+			log.Printf("Error loading notification digest of user %s: %v", userID, err)
+			continue
+		}
+		if len(items) == 0 {
+			continue
+		}
+
+		user, err := s.userRepo.GetByID(userID)
+		if err != nil {
+			log.Printf("Error loading user %s for notification digest: %v", userID, err)
+			continue
+		}
+
+		if err := s.sendDigest(user, items); err != nil {
+			log.Printf("Failed to send notification digest to %s: %v", user.Email, err)
+			continue
+		}
+
+		if err := s.digestRepo.MarkSent(userID, now); err != nil {
+			log.Printf("Error marking notification digest of user %s as sent: %v", userID, err)
+			continue
+		}
+		sent++
+	}
+
+	if _, err := s.digestRepo.DeleteSentBefore(now.Add(-notificationDigestRetention)); err != nil {
+		log.Printf("Error cleaning up sent notification digests: %v", err)
+	}
+
+	return sent, nil
+}
+
+// sendDigest emails the batched notifications of a user, newest first
+func (s *NotificationService) sendDigest(user *domain.User, items []domain.NotificationDigestItem) error {
+	listed := items
+	if len(listed) > notificationDigestEmailItems {
+		listed = listed[:notificationDigestEmailItems]
+	}
+
+	digestItems := make([]email.NotificationDigestItem, len(listed))
+	for i, item := range listed {
+		digestItems[i] = email.NotificationDigestItem{
+			Title:   item.Title,
+			Message: item.Message,
+		}
+		if item.Link != nil {
+			digestItems[i].URL = s.emailConfig.FrontendURL + *item.Link
+		}
+	}
+
+	return s.emailService.SendNotificationDigestEmail(email.NotificationDigestEmailData{
+		Name:             user.FirstName,
+		Email:            user.Email,
+		Items:            digestItems,
+		TotalItems:       len(items),
+		NotificationsURL: s.notificationsURL(user),
+		PreferencesURL:   s.preferencesURL(user),
+		CompanyName:      s.emailConfig.CompanyName,
+		SupportEmail:     s.emailConfig.SupportEmail,
+		Year:             time.Now().Year(),
+	})
+}
+
+// notificationsURL returns the frontend notification list of a user
+func (s *NotificationService) notificationsURL(user *domain.User) string {
+	if user.Role == domain.RoleEmployer {
+		return s.emailConfig.FrontendURL + "/employer-notifications"
+	}
+	return s.emailConfig.FrontendURL + "/notifications"
+}
+
+// preferencesURL returns the frontend notification preferences page of a user
+func (s *NotificationService) preferencesURL(user *domain.User) string {
+	if user.Role == domain.RoleEmployer {
+		return s.emailConfig.FrontendURL + "/employer-settings/notifications"
+	}
+	return s.emailConfig.FrontendURL + "/settings/notifications"
+}
+
+// publishUnreadCount pushes the current unread count to the connected user
+func (s *NotificationService) publishUnreadCount(ctx context.Context, userID uuid.UUID) {
+	if s.realtimeHub == nil {
+		return
+	}
+
+	count, err := s.notificationRepo.GetUnreadCount(userID)
+	if err != nil {
+		log.Printf("Error getting unread count for user %s: %v", userID, err)
+		return
+	}
+
+	if err := s.realtimeHub.Publish(ctx, userID, realtime.EventUnreadCount, realtime.UnreadCountData{UnreadCount: count}); err != nil {
+		log.Printf("Error publishing unread count for user %s: %v", userID, err)
+	}
+}
+
 // shouldSendInApp checks if in-app notifications are enabled for a notification type
 func (s *NotificationService) shouldSendInApp(prefs *domain.NotificationPreferences, notifType domain.NotificationType) bool {
 	switch notifType {
-	case domain.NotificationApplicationStatus:
+	case domain.NotificationApplicationStatus,
+		domain.NotificationInterviewProposed, domain.NotificationInterviewScheduled, domain.NotificationInterviewCancelled,
+		domain.NotificationOfferReceived:
 		return prefs.AppApplicationStatus
-	case domain.NotificationNewApplication:
+	case domain.NotificationNewApplication, domain.NotificationOfferResponded:
 		return prefs.AppNewApplication
 	case domain.NotificationNewJobFromCompany:
 		return prefs.AppNewJob
@@ -100,6 +388,10 @@ func (s *NotificationService) shouldSendInApp(prefs *domain.NotificationPreferen
 		return prefs.AppJobModeration
 	case domain.NotificationCompanyVerified, domain.NotificationCompanyRejected:
 		return prefs.AppCompanyVerification
+	case domain.NotificationNewMessage:
+		return prefs.AppNewMessage
+	case domain.NotificationJobAlert:
+		return prefs.AppJobAlert
 	default:
 		return true
 	}
@@ -108,9 +400,11 @@ func (s *NotificationService) shouldSendInApp(prefs *domain.NotificationPreferen
 // ShouldSendEmail checks if email notifications are enabled for a notification type
 func (s *NotificationService) ShouldSendEmail(prefs *domain.NotificationPreferences, notifType domain.NotificationType) bool {
 	switch notifType {
-	case domain.NotificationApplicationStatus:
+	case domain.NotificationApplicationStatus,
+		domain.NotificationInterviewProposed, domain.NotificationInterviewScheduled, domain.NotificationInterviewCancelled,
+		domain.NotificationOfferReceived:
 		return prefs.EmailApplicationStatus
-	case domain.NotificationNewApplication:
+	case domain.NotificationNewApplication, domain.NotificationOfferResponded:
 		return prefs.EmailNewApplication
 	case domain.NotificationNewJobFromCompany:
 		return prefs.EmailNewJob
@@ -126,6 +420,42 @@ func (s *NotificationService) ShouldSendEmail(prefs *domain.NotificationPreferen
 		return prefs.EmailJobModeration
 	case domain.NotificationCompanyVerified, domain.NotificationCompanyRejected:
 		return prefs.EmailCompanyVerification
+	case domain.NotificationNewMessage:
+		return prefs.EmailNewMessage
+	case domain.NotificationJobAlert:
+		return prefs.EmailJobAlert
+	default:
+		return true
+	}
+}
+
+// ShouldSendPush checks if push notifications are enabled for a notification type
+func (s *NotificationService) ShouldSendPush(prefs *domain.NotificationPreferences, notifType domain.NotificationType) bool {
+	switch notifType {
+	case domain.NotificationApplicationStatus,
+		domain.NotificationInterviewProposed, domain.NotificationInterviewScheduled, domain.NotificationInterviewCancelled,
+		domain.NotificationOfferReceived:
+		return prefs.PushApplicationStatus
+	case domain.NotificationNewApplication, domain.NotificationOfferResponded:
+		return prefs.PushNewApplication
+	case domain.NotificationNewJobFromCompany:
+		return prefs.PushNewJob
+	case domain.NotificationJobExpiring:
+		return prefs.PushJobExpiring
+	case domain.NotificationProfileViewed:
+		return prefs.PushProfileViewed
+	case domain.NotificationCompanyReview:
+		return prefs.PushCompanyReview
+	case domain.NotificationTeamInvitation:
+		return prefs.PushTeamInvitation
+	case domain.NotificationJobApproved, domain.NotificationJobRejected:
+		return prefs.PushJobModeration
+	case domain.NotificationCompanyVerified, domain.NotificationCompanyRejected:
+		return prefs.PushCompanyVerification
+	case domain.NotificationNewMessage:
+		return prefs.PushNewMessage
+	case domain.NotificationJobAlert:
+		return prefs.PushJobAlert
 	default:
 		return true
 	}
@@ -150,17 +480,32 @@ func (s *NotificationService) GetUnreadCount(ctx context.Context, userID uuid.UU
 
 // MarkAsRead marks a notification as read
 func (s *NotificationService) MarkAsRead(ctx context.Context, id, userID uuid.UUID) error {
-	return s.notificationRepo.MarkAsRead(id, userID)
+	if err := s.notificationRepo.MarkAsRead(id, userID); err != nil {
+		return err
+	}
+
+	s.publishUnreadCount(ctx, userID)
+	return nil
 }
 
 // MarkAllAsRead marks all notifications as read for a user
 func (s *NotificationService) MarkAllAsRead(ctx context.Context, userID uuid.UUID) error {
-	return s.notificationRepo.MarkAllAsRead(userID)
+	if err := s.notificationRepo.MarkAllAsRead(userID); err != nil {
+		return err
+	}
+
+	s.publishUnreadCount(ctx, userID)
+	return nil
 }
 
 // Delete deletes a notification
 func (s *NotificationService) Delete(ctx context.Context, id, userID uuid.UUID) error {
-	return s.notificationRepo.Delete(id, userID)
+	if err := s.notificationRepo.Delete(id, userID); err != nil {
+		return err
+	}
+
+	s.publishUnreadCount(ctx, userID)
+	return nil
 }
 
 // ClearRead deletes all read notifications for a user
@@ -199,6 +544,7 @@ func (s *NotificationService) NotifyApplicationStatusChange(
 			"job_id":         jobID.String(),
 			"application_id": applicationID.String(),
 			"status":         newStatus,
+			"job_title":      jobTitle,
 		},
 	})
 
@@ -225,6 +571,8 @@ func (s *NotificationService) NotifyNewApplication(
 		Data: map[string]interface{}{
 			"job_id":         jobID.String(),
 			"application_id": applicationID.String(),
+			"job_title":      jobTitle,
+			"applicant_name": applicantName,
 		},
 	})
 
@@ -250,8 +598,10 @@ func (s *NotificationService) NotifyNewJobFromFollowedCompany(
 		Message: fmt.Sprintf("%s posted a new job: %s", companyName, jobTitle),
 		Link:    &link,
 		Data: map[string]interface{}{
-			"job_id":     jobID.String(),
-			"company_id": companyID.String(),
+			"job_id":       jobID.String(),
+			"company_id":   companyID.String(),
+			"job_title":    jobTitle,
+			"company_name": companyName,
 		},
 	})
 
@@ -275,8 +625,9 @@ func (s *NotificationService) NotifyJobExpiringSoon(
 		Message: fmt.Sprintf("Your job posting '%s' will expire in %d days", jobTitle, daysUntilExpiry),
 		Link:    &link,
 		Data: map[string]interface{}{
-			"job_id":           jobID.String(),
+			"job_id":            jobID.String(),
 			"days_until_expiry": daysUntilExpiry,
+			"job_title":         jobTitle,
 		},
 	})
 
@@ -330,8 +681,8 @@ func (s *NotificationService) NotifyCompanyReviewPosted(
 		Message: fmt.Sprintf("%s left a %d-star review on your company", reviewerName, rating),
 		Link:    &link,
 		Data: map[string]interface{}{
-			"company_id":   companyID.String(),
-			"rating":       rating,
+			"company_id":    companyID.String(),
+			"rating":        rating,
 			"reviewer_name": reviewerName,
 		},
 	})
@@ -360,6 +711,7 @@ func (s *NotificationService) NotifyTeamInvitation(
 			"company_id":   companyID.String(),
 			"company_name": companyName,
 			"role":         role,
+			"inviter_name": inviterName,
 		},
 	})
 
@@ -383,7 +735,8 @@ func (s *NotificationService) NotifyJobApproved(
 		Message: fmt.Sprintf("Your job posting '%s' has been approved and is now live", jobTitle),
 		Link:    &link,
 		Data: map[string]interface{}{
-			"job_id": jobID.String(),
+			"job_id":    jobID.String(),
+			"job_title": jobTitle,
 		},
 	})
 
@@ -407,8 +760,9 @@ func (s *NotificationService) NotifyJobRejected(
 		Message: fmt.Sprintf("Your job posting '%s' was not approved: %s", jobTitle, reason),
 		Link:    &link,
 		Data: map[string]interface{}{
-			"job_id": jobID.String(),
-			"reason": reason,
+			"job_id":    jobID.String(),
+			"reason":    reason,
+			"job_title": jobTitle,
 		},
 	})
 
@@ -431,7 +785,8 @@ func (s *NotificationService) NotifyCompanyVerified(
 		Message: fmt.Sprintf("Your company '%s' has been verified!", companyName),
 		Link:    &link,
 		Data: map[string]interface{}{
-			"company_id": companyID.String(),
+			"company_id":   companyID.String(),
+			"company_name": companyName,
 		},
 	})
 
@@ -455,8 +810,249 @@ func (s *NotificationService) NotifyCompanyRejected(
 		Message: fmt.Sprintf("Your company '%s' verification was rejected: %s", companyName, reason),
 		Link:    &link,
 		Data: map[string]interface{}{
-			"company_id": companyID.String(),
-			"reason":     reason,
+			"company_id":   companyID.String(),
+			"reason":       reason,
+			"company_name": companyName,
+		},
+	})
+
+	return err
+}
+
+// NotifyInterviewProposed sends notification to a candidate when interview slots are proposed
+func (s *NotificationService) NotifyInterviewProposed(
+	ctx context.Context,
+	candidateID uuid.UUID,
+	interviewID uuid.UUID,
+	applicationID uuid.UUID,
+	jobTitle string,
+	slotCount int,
+) error {
+	link := fmt.Sprintf("/dashboard/applications/%s", applicationID)
+
+	_, err := s.CreateNotification(ctx, CreateNotificationInput{
+		UserID:  candidateID,
+		Type:    domain.NotificationInterviewProposed,
+		Title:   "Interview Invitation",
+		Message: fmt.Sprintf("You have been invited to interview for %s. Please pick one of %d proposed times", jobTitle, slotCount),
+		Link:    &link,
+		Data: map[string]interface{}{
+			"interview_id":   interviewID.String(),
+			"application_id": applicationID.String(),
+			"job_title":      jobTitle,
+			"slot_count":     slotCount,
+		},
+	})
+
+	return err
+}
+
+// NotifyInterviewScheduled sends notification when an interview time is confirmed or changed
+func (s *NotificationService) NotifyInterviewScheduled(
+	ctx context.Context,
+	userID uuid.UUID,
+	interviewID uuid.UUID,
+	applicationID uuid.UUID,
+	jobTitle string,
+	when string,
+	rescheduled bool,
+	link string,
+) error {
+	title := "Interview Scheduled"
+	message := fmt.Sprintf("Interview for %s is scheduled for %s", jobTitle, when)
+	if rescheduled {
+		title = "Interview Rescheduled"
+		message = fmt.Sprintf("Interview for %s has been moved to %s", jobTitle, when)
+	}
+
+	_, err := s.CreateNotification(ctx, CreateNotificationInput{
+		UserID:  userID,
+		Type:    domain.NotificationInterviewScheduled,
+		Title:   title,
+		Message: message,
+		Link:    &link,
+		Data: map[string]interface{}{
+			"interview_id":   interviewID.String(),
+			"application_id": applicationID.String(),
+			"rescheduled":    rescheduled,
+		},
+	})
+
+	return err
+}
+
+// NotifyInterviewCancelled sends notification when an interview is cancelled
+func (s *NotificationService) NotifyInterviewCancelled(
+	ctx context.Context,
+	userID uuid.UUID,
+	interviewID uuid.UUID,
+	applicationID uuid.UUID,
+	jobTitle string,
+	reason string,
+	link string,
+) error {
+	message := fmt.Sprintf("Interview for %s has been cancelled", jobTitle)
+	if reason != "" {
+		message = fmt.Sprintf("%s: %s", message, reason)
+	}
+
+	_, err := s.CreateNotification(ctx, CreateNotificationInput{
+		UserID:  userID,
+		Type:    domain.NotificationInterviewCancelled,
+		Title:   "Interview Cancelled",
+		Message: message,
+		Link:    &link,
+		Data: map[string]interface{}{
+			"interview_id":   interviewID.String(),
+			"application_id": applicationID.String(),
+			"reason":         reason,
+		},
+	})
+
+	return err
+}
+
+// NotifyNoteMention sends notification when a team member is mentioned in a note
+func (s *NotificationService) NotifyNoteMention(
+	ctx context.Context,
+	userID uuid.UUID,
+	noteID uuid.UUID,
+	authorName string,
+	subjectName string,
+	excerpt string,
+	link string,
+) error {
+	_, err := s.CreateNotification(ctx, CreateNotificationInput{
+		UserID:  userID,
+		Type:    domain.NotificationNoteMention,
+		Title:   "You were mentioned in a note",
+		Message: fmt.Sprintf("%s mentioned you in a note on %s: %s", authorName, subjectName, excerpt),
+		Link:    &link,
+		Data: map[string]interface{}{
+			"note_id":      noteID.String(),
+			"author_name":  authorName,
+			"subject_name": subjectName,
+			"excerpt":      excerpt,
+		},
+	})
+
+	return err
+}
+
+// NotifyNewMessage sends notification when a user receives a message about an application
+func (s *NotificationService) NotifyNewMessage(
+	ctx context.Context,
+	userID uuid.UUID,
+	conversationID uuid.UUID,
+	applicationID uuid.UUID,
+	senderName string,
+	jobTitle string,
+	excerpt string,
+	link string,
+) error {
+	_, err := s.CreateNotification(ctx, CreateNotificationInput{
+		UserID:  userID,
+		Type:    domain.NotificationNewMessage,
+		Title:   fmt.Sprintf("New message from %s", senderName),
+		Message: fmt.Sprintf("%s (%s)", excerpt, jobTitle),
+		Link:    &link,
+		Data: map[string]interface{}{
+			"conversation_id": conversationID.String(),
+			"application_id":  applicationID.String(),
+		},
+	})
+
+	return err
+}
+
+// NotifyOfferReceived sends notification when a candidate receives an offer
+func (s *NotificationService) NotifyOfferReceived(
+	ctx context.Context,
+	candidateID uuid.UUID,
+	offerID uuid.UUID,
+	applicationID uuid.UUID,
+	jobTitle string,
+	companyName string,
+) error {
+	link := fmt.Sprintf("/dashboard/applications/%s", applicationID)
+
+	_, err := s.CreateNotification(ctx, CreateNotificationInput{
+		UserID:  candidateID,
+		Type:    domain.NotificationOfferReceived,
+		Title:   "You received an offer",
+		Message: fmt.Sprintf("%s sent you an offer for %s", companyName, jobTitle),
+		Link:    &link,
+		Data: map[string]interface{}{
+			"offer_id":       offerID.String(),
+			"application_id": applicationID.String(),
+			"job_title":      jobTitle,
+			"company_name":   companyName,
+		},
+	})
+
+	return err
+}
+
+// NotifyOfferResponded sends notification when a candidate accepts or declines an offer
+func (s *NotificationService) NotifyOfferResponded(
+	ctx context.Context,
+	employerID uuid.UUID,
+	offerID uuid.UUID,
+	applicationID uuid.UUID,
+	candidateName string,
+	jobTitle string,
+	accepted bool,
+) error {
+	link := fmt.Sprintf("/employer/applications/%s", applicationID)
+
+	title := "Offer declined"
+	verb := "declined"
+	if accepted {
+		title = "Offer accepted"
+		verb = "accepted"
+	}
+
+	_, err := s.CreateNotification(ctx, CreateNotificationInput{
+		UserID:  employerID,
+		Type:    domain.NotificationOfferResponded,
+		Title:   title,
+		Message: fmt.Sprintf("%s %s your offer for %s", candidateName, verb, jobTitle),
+		Link:    &link,
+		Data: map[string]interface{}{
+			"offer_id":       offerID.String(),
+			"application_id": applicationID.String(),
+			"accepted":       accepted,
+			"job_title":      jobTitle,
+			"candidate_name": candidateName,
+		},
+	})
+
+	return err
+}
+
+// NotifyJobAlert sends notification when new jobs match a saved search
+func (s *NotificationService) NotifyJobAlert(
+	ctx context.Context,
+	userID uuid.UUID,
+	savedSearchID uuid.UUID,
+	searchName string,
+	jobCount int,
+	link string,
+) error {
+	title := fmt.Sprintf("%d new jobs for \"%s\"", jobCount, searchName)
+	if jobCount == 1 {
+		title = fmt.Sprintf("1 new job for \"%s\"", searchName)
+	}
+
+	_, err := s.CreateNotification(ctx, CreateNotificationInput{
+		UserID:  userID,
+		Type:    domain.NotificationJobAlert,
+		Title:   title,
+		Message: fmt.Sprintf("New jobs match your saved search \"%s\"", searchName),
+		Link:    &link,
+		Data: map[string]interface{}{
+			"saved_search_id": savedSearchID.String(),
+			"job_count":       jobCount,
 		},
 	})
 
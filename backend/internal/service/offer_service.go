@@ -0,0 +1,523 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"job-platform/internal/domain"
+	"job-platform/internal/repository"
+	"job-platform/internal/storage"
+	"log"
+	"mime/multipart"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	// MaxOfferLetterSizeMB is the maximum size of an offer letter PDF
+	MaxOfferLetterSizeMB = 10
+	// OfferLetterURLExpiry is how long offer letter download links stay valid
+	OfferLetterURLExpiry = time.Hour
+)
+
+// OfferService handles offer letters and their acceptance by candidates
+type OfferService struct {
+	offerRepo           *repository.OfferRepository
+	applicationRepo     *repository.ApplicationRepository
+	applicationService  *ApplicationService
+	notificationService *NotificationService
+	storageClient       *storage.MinioClient
+	bucket              string
+	db                  *gorm.DB
+}
+
+// NewOfferService creates a new offer service
+func NewOfferService(
+	offerRepo *repository.OfferRepository,
+	applicationRepo *repository.ApplicationRepository,
+	applicationService *ApplicationService,
+	storageClient *storage.MinioClient,
+	bucket string,
+	db *gorm.DB,
+) *OfferService {
+	return &OfferService{
+		offerRepo:          offerRepo,
+		applicationRepo:    applicationRepo,
+		applicationService: applicationService,
+		storageClient:      storageClient,
+		bucket:             bucket,
+		db:                 db,
+	}
+}
+
+// SetNotificationService sets the notification service
+func (s *OfferService) SetNotificationService(ns *NotificationService) {
+	s.notificationService = ns
+}
+
+// OfferInput represents the terms of an offer
+type OfferInput struct {
+	Salary         int
+	SalaryCurrency string
+	SalaryPeriod   string
+	StartDate      time.Time
+	ExpiresAt      *time.Time
+	Message        string
+}
+
+// CreateOffer drafts an offer for an application (employer only)
+func (s *OfferService) CreateOffer(applicationID, employerID uuid.UUID, input OfferInput) (*domain.Offer, error) {
+	application, err := s.applicationRepo.GetByID(applicationID)
+	if err != nil {
+		return nil, domain.ErrApplicationNotFound
+	}
+	if application.Job.EmployerID != employerID {
+		return nil, domain.ErrJobNotOwnedByEmployer
+	}
+	if application.IsInFinalStage() {
+		return nil, domain.ErrApplicationClosed
+	}
+
+	open, err := s.offerRepo.HasOpenOffer(applicationID)
+	if err != nil {
+		return nil, err
+	}
+	if open {
+		return nil, domain.ErrOfferAlreadyOpen
+	}
+
+	offer := &domain.Offer{
+		ID:            uuid.New(),
+		ApplicationID: applicationID,
+		CreatedBy:     employerID,
+		Status:        domain.OfferStatusDraft,
+	}
+	if err := applyOfferInput(offer, input); err != nil {
+		return nil, err
+	}
+
+	if err := s.offerRepo.Create(offer); err != nil {
+		return nil, err
+	}
+	return s.offerRepo.GetByID(offer.ID)
+}
+
+// UpdateOffer changes the terms of a draft offer (employer only)
+func (s *OfferService) UpdateOffer(offerID, employerID uuid.UUID, input OfferInput) (*domain.Offer, error) {
+	offer, err := s.getEmployerOffer(offerID, employerID)
+	if err != nil {
+		return nil, err
+	}
+	if offer.Status != domain.OfferStatusDraft {
+		return nil, domain.ErrOfferNotDraft
+	}
+
+	if err := applyOfferInput(offer, input); err != nil {
+		return nil, err
+	}
+	if err := s.offerRepo.Update(offer); err != nil {
+		return nil, err
+	}
+	return offer, nil
+}
+
+// UploadOfferLetter attaches the offer letter PDF to a draft offer, replacing any previous one (employer only)
+func (s *OfferService) UploadOfferLetter(offerID, employerID uuid.UUID, file *multipart.FileHeader) (*domain.Offer, error) {
+	offer, err := s.getEmployerOffer(offerID, employerID)
+	if err != nil {
+		return nil, err
+	}
+	if offer.Status != domain.OfferStatusDraft {
+		return nil, domain.ErrOfferNotDraft
+	}
+
+	if err := s.storageClient.ValidateFile(file, []string{"pdf"}, MaxOfferLetterSizeMB); err != nil {
+		return nil, domain.ErrInvalidOfferDocument
+	}
+
+	path := storage.GenerateFilePath(offer.ApplicationID, storage.GenerateUniqueFileName(file.Filename))
+	result, err := s.storageClient.UploadFile(s.bucket, file, path)
+	if err != nil {
+		return nil, domain.ErrOfferUploadFailed
+	}
+
+	oldPath := offer.DocumentPath
+	offer.DocumentName = file.Filename
+	offer.DocumentPath = result.Path
+	if err := s.offerRepo.Update(offer); err != nil {
+		_ = s.storageClient.DeleteFile(s.bucket, result.Path)
+		return nil, err
+	}
+
+	if oldPath != "" {
+		_ = s.storageClient.DeleteFile(s.bucket, oldPath)
+	}
+	return offer, nil
+}
+
+// SendOffer sends a draft offer to the candidate and moves the application to the offer stage (employer only)
+func (s *OfferService) SendOffer(offerID, employerID uuid.UUID) (*domain.Offer, error) {
+	offer, err := s.getEmployerOffer(offerID, employerID)
+	if err != nil {
+		return nil, err
+	}
+	if offer.Status != domain.OfferStatusDraft {
+		return nil, domain.ErrOfferNotDraft
+	}
+	if offer.ExpiresAt != nil && !offer.ExpiresAt.After(time.Now()) {
+		return nil, domain.ErrInvalidOfferDates
+	}
+
+	application := offer.Application
+	if application.IsInFinalStage() {
+		return nil, domain.ErrApplicationClosed
+	}
+
+	// Remember where the application was so a declined offer can put it back
+	previous := application.Status
+	offer.PreviousStatus = &previous
+
+	now := time.Now()
+	offer.Status = domain.OfferStatusSent
+	offer.SentAt = &now
+
+	// The offer is sent and the application moved to the offer stage together
+	tx := s.db.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := repository.NewOfferRepository(tx).Update(offer); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	var move *stageMove
+	if domain.PipelineStatusRank(application.Status) < domain.PipelineStatusRank(domain.ApplicationStatusOffered) {
+		move, err = s.applicationService.applyMove(tx, application, employerID, MoveApplicationInput{
+			Status: domain.ApplicationStatusOffered,
+		})
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+
+	if move != nil {
+		s.afterMove(application, employerID, move)
+	}
+
+	if s.notificationService != nil {
+		go func() {
+			_ = s.notificationService.NotifyOfferReceived(
+				context.Background(),
+				application.ApplicantID,
+				offer.ID,
+				application.ID,
+				application.Job.Title,
+				application.Job.CompanyName,
+			)
+		}()
+	}
+
+	return offer, nil
+}
+
+// WithdrawOffer withdraws an offer the candidate has not answered yet (employer only).
+// A sent offer's application goes back to the stage it was in before the offer.
+func (s *OfferService) WithdrawOffer(offerID, employerID uuid.UUID) (*domain.Offer, error) {
+	offer, err := s.getEmployerOffer(offerID, employerID)
+	if err != nil {
+		return nil, err
+	}
+	if !offer.IsOpen() {
+		return nil, domain.ErrOfferNotSent
+	}
+
+	sent := offer.Status == domain.OfferStatusSent
+	offer.Status = domain.OfferStatusWithdrawn
+	if err := s.respond(offer, sent, employerID, "Offer withdrawn"); err != nil {
+		return nil, err
+	}
+	return offer, nil
+}
+
+// GetOffer retrieves an offer (employer only)
+func (s *OfferService) GetOffer(offerID, employerID uuid.UUID) (*domain.Offer, error) {
+	return s.getEmployerOffer(offerID, employerID)
+}
+
+// GetApplicationOffers retrieves the offers of an application (employer only)
+func (s *OfferService) GetApplicationOffers(applicationID, employerID uuid.UUID) ([]domain.Offer, error) {
+	application, err := s.applicationRepo.GetByID(applicationID)
+	if err != nil {
+		return nil, domain.ErrApplicationNotFound
+	}
+	if application.Job.EmployerID != employerID {
+		return nil, domain.ErrJobNotOwnedByEmployer
+	}
+	return s.offerRepo.GetByApplicationID(applicationID)
+}
+
+// GetCandidateOffers retrieves the offers a candidate received
+func (s *OfferService) GetCandidateOffers(candidateID uuid.UUID) ([]domain.Offer, error) {
+	return s.offerRepo.GetCandidateOffers(candidateID)
+}
+
+// GetCandidateOffer retrieves an offer sent to the candidate
+func (s *OfferService) GetCandidateOffer(offerID, candidateID uuid.UUID) (*domain.Offer, error) {
+	return s.getCandidateOffer(offerID, candidateID)
+}
+
+// AcceptOffer accepts an offer, which hires the candidate. The job is closed
+// once as many candidates were hired as it has openings.
+func (s *OfferService) AcceptOffer(offerID, candidateID uuid.UUID) (*domain.Offer, error) {
+	offer, err := s.getCandidateOffer(offerID, candidateID)
+	if err != nil {
+		return nil, err
+	}
+	if offer.Status != domain.OfferStatusSent {
+		return nil, domain.ErrOfferNotSent
+	}
+	if offer.IsExpired() {
+		return nil, domain.ErrOfferExpired
+	}
+
+	now := time.Now()
+	offer.Status = domain.OfferStatusAccepted
+	offer.RespondedAt = &now
+
+	// The offer is accepted and the candidate hired together, while the job is locked so
+	// candidates accepting at the same time can't fill more openings than the job has
+	tx := s.db.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	hasOpenings, err := repository.NewJobRepository(tx).LockOpenings(offer.Application.JobID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if !hasOpenings {
+		tx.Rollback()
+		return nil, domain.ErrOfferJobFilled
+	}
+
+	if err := repository.NewOfferRepository(tx).Update(offer); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	move, err := s.applicationService.applyMove(tx, offer.Application, candidateID, MoveApplicationInput{
+		Status: domain.ApplicationStatusHired,
+		Reason: "Offer accepted",
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+
+	s.afterMove(offer.Application, candidateID, move)
+	s.notifyResponse(offer, true)
+
+	return offer, nil
+}
+
+// DeclineOffer declines an offer. The application goes back to the stage it was in before the offer.
+func (s *OfferService) DeclineOffer(offerID, candidateID uuid.UUID, reason string) (*domain.Offer, error) {
+	offer, err := s.getCandidateOffer(offerID, candidateID)
+	if err != nil {
+		return nil, err
+	}
+	if offer.Status != domain.OfferStatusSent {
+		return nil, domain.ErrOfferNotSent
+	}
+
+	now := time.Now()
+	offer.Status = domain.OfferStatusDeclined
+	offer.RespondedAt = &now
+	offer.DeclineReason = strings.TrimSpace(reason)
+	if err := s.respond(offer, true, candidateID, "Offer declined"); err != nil {
+		return nil, err
+	}
+
+	s.notifyResponse(offer, false)
+
+	return offer, nil
+}
+
+// GetOfferLetterURL returns the offer with a short-lived download URL for its letter.
+// Both the job's employer and the candidate (once the offer is sent) can download it.
+func (s *OfferService) GetOfferLetterURL(offerID, userID uuid.UUID) (*domain.Offer, string, error) {
+	offer, err := s.offerRepo.GetByID(offerID)
+	if err != nil || offer.Application == nil {
+		return nil, "", domain.ErrOfferNotFound
+	}
+
+	isEmployer := offer.Application.Job.EmployerID == userID
+	isCandidate := offer.Application.ApplicantID == userID && offer.Status != domain.OfferStatusDraft
+	if !isEmployer && !isCandidate {
+		return nil, "", domain.ErrOfferNotFound
+	}
+	if !offer.HasDocument() {
+		return nil, "", domain.ErrOfferNotFound
+	}
+
+	url, err := s.storageClient.GetSignedURL(s.bucket, offer.DocumentPath, OfferLetterURLExpiry)
+	if err != nil {
+		return nil, "", domain.ErrStorageDownloadFailed
+	}
+	return offer, url, nil
+}
+
+// getEmployerOffer retrieves an offer and verifies the employer owns its job
+func (s *OfferService) getEmployerOffer(offerID, employerID uuid.UUID) (*domain.Offer, error) {
+	offer, err := s.offerRepo.GetByID(offerID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrOfferNotFound
+		}
+		return nil, err
+	}
+	if offer.Application == nil || offer.Application.Job.EmployerID != employerID {
+		return nil, domain.ErrJobNotOwnedByEmployer
+	}
+	return offer, nil
+}
+
+// getCandidateOffer retrieves an offer sent to the candidate; drafts are not visible
+func (s *OfferService) getCandidateOffer(offerID, candidateID uuid.UUID) (*domain.Offer, error) {
+	offer, err := s.offerRepo.GetByID(offerID)
+	if err != nil || offer.Application == nil || offer.Application.ApplicantID != candidateID ||
+		offer.Status == domain.OfferStatusDraft {
+		return nil, domain.ErrOfferNotFound
+	}
+	return offer, nil
+}
+
+// respond saves a withdrawn or declined offer. When restore is set, the application goes back
+// to its status before the offer in the same transaction.
+func (s *OfferService) respond(offer *domain.Offer, restore bool, changedBy uuid.UUID, reason string) error {
+	tx := s.db.Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := repository.NewOfferRepository(tx).Update(offer); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	var application *domain.Application
+	var move *stageMove
+	if restore {
+		var err error
+		application, move, err = s.restoreApplication(tx, offer, changedBy, reason)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return err
+	}
+
+	if move != nil {
+		s.afterMove(application, changedBy, move)
+	}
+	return nil
+}
+
+// restoreApplication moves an application in the offer stage back to its status before the offer in tx.
+// The move is nil when the application was not moved.
+func (s *OfferService) restoreApplication(tx *gorm.DB, offer *domain.Offer, changedBy uuid.UUID, reason string) (*domain.Application, *stageMove, error) {
+	application, err := s.applicationRepo.GetByID(offer.ApplicationID)
+	if err != nil {
+		return nil, nil, domain.ErrApplicationNotFound
+	}
+	if application.Status != domain.ApplicationStatusOffered ||
+		offer.PreviousStatus == nil || *offer.PreviousStatus == domain.ApplicationStatusOffered {
+		return application, nil, nil
+	}
+
+	move, err := s.applicationService.applyMove(tx, application, changedBy, MoveApplicationInput{
+		Status: *offer.PreviousStatus,
+		Reason: reason,
+	})
+	return application, move, err
+}
+
+// afterMove runs the notifications of a committed application move. The move already happened,
+// so failing to reload the application is only logged.
+func (s *OfferService) afterMove(application *domain.Application, changedBy uuid.UUID, move *stageMove) {
+	if _, err := s.applicationService.afterMove(application, changedBy, move); err != nil {
+		log.Printf("Error reloading application %s after offer update: %v", application.ID, err)
+	}
+}
+
+// notifyResponse tells the employer who sent the offer about the candidate's answer (async)
+func (s *OfferService) notifyResponse(offer *domain.Offer, accepted bool) {
+	if s.notificationService == nil {
+		return
+	}
+	application := offer.Application
+	go func() {
+		_ = s.notificationService.NotifyOfferResponded(
+			context.Background(),
+			offer.CreatedBy,
+			offer.ID,
+			application.ID,
+			application.Applicant.FirstName+" "+application.Applicant.LastName,
+			application.Job.Title,
+			accepted,
+		)
+	}()
+}
+
+// applyOfferInput validates offer terms and copies them onto the offer
+func applyOfferInput(offer *domain.Offer, input OfferInput) error {
+	if input.Salary <= 0 {
+		return domain.ErrInvalidOfferSalary
+	}
+	if input.StartDate.IsZero() {
+		return domain.ErrInvalidOfferDates
+	}
+	if input.ExpiresAt != nil && (!input.ExpiresAt.After(time.Now()) || input.ExpiresAt.After(input.StartDate)) {
+		return domain.ErrInvalidOfferDates
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(input.SalaryCurrency))
+	if currency == "" {
+		currency = "USD"
+	}
+	period := strings.ToUpper(strings.TrimSpace(input.SalaryPeriod))
+	if period == "" {
+		period = "YEARLY"
+	}
+
+	offer.Salary = input.Salary
+	offer.SalaryCurrency = currency
+	offer.SalaryPeriod = period
+	offer.StartDate = input.StartDate
+	offer.ExpiresAt = input.ExpiresAt
+	offer.Message = strings.TrimSpace(input.Message)
+	return nil
+}
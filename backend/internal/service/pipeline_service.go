@@ -0,0 +1,151 @@
+package service
+
+import (
+	"errors"
+	"job-platform/internal/domain"
+	"job-platform/internal/repository"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	// MinPipelineStages is the minimum number of stages in a custom pipeline
+	MinPipelineStages = 2
+	// MaxPipelineStages is the maximum number of stages in a custom pipeline
+	MaxPipelineStages = 20
+)
+
+// PipelineService handles company hiring pipelines
+type PipelineService struct {
+	pipelineRepo *repository.PipelineRepository
+	companyRepo  *repository.CompanyRepository
+}
+
+// NewPipelineService creates a new pipeline service
+func NewPipelineService(
+	pipelineRepo *repository.PipelineRepository,
+	companyRepo *repository.CompanyRepository,
+) *PipelineService {
+	return &PipelineService{
+		pipelineRepo: pipelineRepo,
+		companyRepo:  companyRepo,
+	}
+}
+
+// PipelineStageInput represents a stage in a pipeline update. ID is set for existing stages.
+type PipelineStageInput struct {
+	ID     *uuid.UUID
+	Name   string
+	Status domain.ApplicationStatus
+}
+
+// GetCompanyPipeline retrieves a company's pipeline, falling back to the default pipeline
+func (s *PipelineService) GetCompanyPipeline(companyID uuid.UUID) (*domain.Pipeline, error) {
+	stages, err := s.pipelineRepo.GetCompanyStages(companyID)
+	if err != nil {
+		return nil, err
+	}
+	if len(stages) == 0 {
+		return domain.DefaultPipeline(&companyID), nil
+	}
+	return &domain.Pipeline{CompanyID: &companyID, Stages: stages, IsCustom: true}, nil
+}
+
+// GetJobPipeline retrieves the pipeline used for a job's applications
+func (s *PipelineService) GetJobPipeline(job *domain.Job) (*domain.Pipeline, error) {
+	company, err := s.companyRepo.GetJobCompany(job)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// Jobs without a company (e.g. admin imports) use the default pipeline
+			return domain.DefaultPipeline(nil), nil
+		}
+		return nil, err
+	}
+	return s.GetCompanyPipeline(company.ID)
+}
+
+// UpdateCompanyPipeline replaces a company's pipeline stages
+func (s *PipelineService) UpdateCompanyPipeline(companyID uuid.UUID, inputs []PipelineStageInput) (*domain.Pipeline, error) {
+	current, err := s.pipelineRepo.GetCompanyStages(companyID)
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[uuid.UUID]bool, len(current))
+	for _, stage := range current {
+		existing[stage.ID] = true
+	}
+
+	stages, err := buildPipelineStages(inputs, existing)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.pipelineRepo.ReplaceStages(companyID, stages); err != nil {
+		return nil, err
+	}
+
+	return s.GetCompanyPipeline(companyID)
+}
+
+// ResetCompanyPipeline removes a company's custom stages and reverts it to the default pipeline
+func (s *PipelineService) ResetCompanyPipeline(companyID uuid.UUID) (*domain.Pipeline, error) {
+	if err := s.pipelineRepo.DeleteCompanyStages(companyID); err != nil {
+		return nil, err
+	}
+	return domain.DefaultPipeline(&companyID), nil
+}
+
+// buildPipelineStages validates stage inputs and converts them to ordered stages.
+// Stages must start with a SUBMITTED stage and their status categories may not go backwards.
+// Offers move applications to OFFERED and accepted offers to HIRED, so both stages are required.
+func buildPipelineStages(inputs []PipelineStageInput, existing map[uuid.UUID]bool) ([]domain.PipelineStage, error) {
+	if len(inputs) < MinPipelineStages || len(inputs) > MaxPipelineStages {
+		return nil, domain.ErrInvalidPipeline
+	}
+
+	names := make(map[string]bool, len(inputs))
+	ids := make(map[uuid.UUID]bool, len(inputs))
+	stages := make([]domain.PipelineStage, 0, len(inputs))
+	lastRank := -1
+
+	for i, in := range inputs {
+		name := strings.TrimSpace(in.Name)
+		key := strings.ToLower(name)
+		if name == "" || len(name) > 100 || names[key] {
+			return nil, domain.ErrInvalidPipeline
+		}
+		names[key] = true
+
+		rank := domain.PipelineStatusRank(in.Status)
+		if rank < 0 {
+			return nil, domain.ErrInvalidPipelineStatus
+		}
+		if (i == 0 && in.Status != domain.ApplicationStatusSubmitted) || rank < lastRank {
+			return nil, domain.ErrInvalidPipeline
+		}
+		lastRank = rank
+
+		stage := domain.PipelineStage{
+			Name:     name,
+			Status:   in.Status,
+			Position: i,
+		}
+		if in.ID != nil {
+			if !existing[*in.ID] || ids[*in.ID] {
+				return nil, domain.ErrPipelineStageNotFound
+			}
+			ids[*in.ID] = true
+			stage.ID = *in.ID
+		}
+		stages = append(stages, stage)
+	}
+
+	pipeline := domain.Pipeline{Stages: stages}
+	if pipeline.StageForStatus(domain.ApplicationStatusOffered) == nil || pipeline.StageForStatus(domain.ApplicationStatusHired) == nil {
+		return nil, domain.ErrPipelineMissingStages
+	}
+
+	return stages, nil
+}
@@ -0,0 +1,262 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"job-platform/internal/domain"
+	"job-platform/internal/repository"
+	"job-platform/internal/util/webpush"
+	"log"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	// MaxPushSubscriptionsPerUser is the number of browsers a user can receive push notifications on.
+	// Registering another browser replaces the least recently registered one.
+	MaxPushSubscriptionsPerUser = 10
+	// maxPushFailures is how many deliveries in a row may fail before a subscription is deleted
+	maxPushFailures = 5
+	// pushMessageTTL is how long push services keep a message for an offline browser
+	pushMessageTTL = 24 * time.Hour
+	// pushRequestTimeout limits a delivery to a push service
+	pushRequestTimeout = 30 * time.Second
+)
+
+// errPushAddressNotAllowed is returned when a push endpoint resolves to a private or loopback address
+var errPushAddressNotAllowed = errors.New("push endpoint resolves to a non-public address")
+
+// PushConfig holds configuration for web push notifications
+type PushConfig struct {
+	FrontendURL string
+	// AllowInsecureEndpoints accepts http:// push endpoints, e.g. a local push service stub in development
+	AllowInsecureEndpoints bool
+}
+
+// PushMessage is the payload shown by the service worker as a browser notification
+type PushMessage struct {
+	Type           string `json:"type"`
+	Title          string `json:"title"`
+	Body           string `json:"body"`
+	URL            string `json:"url,omitempty"`
+	Tag            string `json:"tag,omitempty"` // Browsers replace a shown notification with the same tag
+	NotificationID string `json:"notification_id,omitempty"`
+}
+
+// pushSubscriptionStore persists push subscriptions. It is implemented by
+// repository.PushSubscriptionRepository and lets delivery be tested without a database.
+type pushSubscriptionStore interface {
+	Upsert(sub *domain.PushSubscription) error
+	GetByEndpoint(endpoint string) (*domain.PushSubscription, error)
+	GetByID(id uuid.UUID) (*domain.PushSubscription, error)
+	GetByUserID(userID uuid.UUID) ([]domain.PushSubscription, error)
+	Delete(id uuid.UUID) error
+	DeleteOldestByUserID(userID uuid.UUID, keep int) error
+	RecordSuccess(id uuid.UUID, at time.Time) error
+	RecordFailure(id uuid.UUID) (int, error)
+}
+
+// PushService handles web push subscriptions and delivery
+type PushService struct {
+	subscriptionRepo pushSubscriptionStore
+	client           *webpush.Client
+	config           *PushConfig
+}
+
+// NewPushHTTPClient creates the client push messages are delivered with. Endpoints are chosen by
+// browsers, so unless insecure endpoints are allowed it only connects to public addresses and
+// doesn't follow redirects.
+func NewPushHTTPClient(config *PushConfig) *http.Client {
+	return newPublicHTTPClient(pushRequestTimeout, config.AllowInsecureEndpoints, errPushAddressNotAllowed)
+}
+
+// NewPushService creates a new push service. Without a client (no VAPID keys configured)
+// push notifications are disabled.
+func NewPushService(subscriptionRepo *repository.PushSubscriptionRepository, client *webpush.Client, config *PushConfig) *PushService {
+	return &PushService{
+		subscriptionRepo: subscriptionRepo,
+		client:           client,
+		config:           config,
+	}
+}
+
+// IsEnabled reports whether push notifications are configured
+func (s *PushService) IsEnabled() bool {
+	return s.client != nil
+}
+
+// PublicKey returns the VAPID public key browsers subscribe with
+func (s *PushService) PublicKey() (string, error) {
+	if !s.IsEnabled() {
+		return "", domain.ErrPushNotConfigured
+	}
+	return s.client.PublicKey(), nil
+}
+
+// SubscribeInput represents a browser's push subscription
+type SubscribeInput struct {
+	Endpoint  string
+	P256dh    string
+	Auth      string
+	UserAgent string
+}
+
+// Subscribe registers a browser of a user for push notifications
+func (s *PushService) Subscribe(userID uuid.UUID, input SubscribeInput) (*domain.PushSubscription, error) {
+	if !s.IsEnabled() {
+		return nil, domain.ErrPushNotConfigured
+	}
+
+	sub := webpush.Subscription{Endpoint: input.Endpoint, P256dh: input.P256dh, Auth: input.Auth}
+	if err := sub.Validate(); err != nil {
+		return nil, domain.ErrInvalidPushSubscription
+	}
+	// Messages are posted to the endpoint, only allow plain http and private addresses for local push service stubs
+	if !s.config.AllowInsecureEndpoints {
+		endpoint, _ := url.Parse(input.Endpoint)
+		if endpoint.Scheme != "https" || strings.EqualFold(endpoint.Hostname(), "localhost") {
+			return nil, domain.ErrInvalidPushSubscription
+		}
+		if addr, err := netip.ParseAddr(endpoint.Hostname()); err == nil && !isPublicAddr(addr) {
+			return nil, domain.ErrInvalidPushSubscription
+		}
+	}
+
+	userAgent := input.UserAgent
+	if utf8.RuneCountInString(userAgent) > 500 {
+		userAgent = string([]rune(userAgent)[:500])
+	}
+
+	now := time.Now()
+	subscription := &domain.PushSubscription{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Endpoint:  input.Endpoint,
+		P256dh:    input.P256dh,
+		Auth:      input.Auth,
+		UserAgent: userAgent,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.subscriptionRepo.Upsert(subscription); err != nil {
+		return nil, err
+	}
+
+	if err := s.subscriptionRepo.DeleteOldestByUserID(userID, MaxPushSubscriptionsPerUser); err != nil {
+		log.Printf("Error removing old push subscriptions of user %s: %v", userID, err)
+	}
+
+	return s.subscriptionRepo.GetByEndpoint(input.Endpoint)
+}
+
+// GetSubscriptions retrieves the browsers a user receives push notifications on
+func (s *PushService) GetSubscriptions(userID uuid.UUID) ([]domain.PushSubscription, error) {
+	return s.subscriptionRepo.GetByUserID(userID)
+}
+
+// Unsubscribe stops push notifications to a browser of the user
+func (s *PushService) Unsubscribe(id, userID uuid.UUID) error {
+	sub, err := s.subscriptionRepo.GetByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.ErrPushSubscriptionNotFound
+		}
+		return err
+	}
+	if sub.UserID != userID {
+		return domain.ErrPushSubscriptionNotFound
+	}
+
+	return s.subscriptionRepo.Delete(id)
+}
+
+// SendToUser delivers a message to every browser of a user. Subscriptions the push service
+// reports as expired, or that failed too often, are deleted. Returns the number of browsers reached.
+func (s *PushService) SendToUser(ctx context.Context, userID uuid.UUID, message PushMessage) (int, error) {
+	if !s.IsEnabled() {
+		return 0, nil
+	}
+
+	subs, err := s.subscriptionRepo.GetByUserID(userID)
+	if err != nil {
+		return 0, err
+	}
+	if len(subs) == 0 {
+		return 0, nil
+	}
+
+	if message.URL != "" {
+		message.URL = s.config.FrontendURL + message.URL
+	}
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return 0, err
+	}
+
+	opts := webpush.Options{TTL: pushMessageTTL, Urgency: webpush.UrgencyNormal}
+
+	sent := 0
+	for _, sub := range subs {
+		err := s.client.Send(ctx, webpush.Subscription{
+			Endpoint: sub.Endpoint,
+			P256dh:   sub.P256dh,
+			Auth:     sub.Auth,
+		}, payload, opts)
+
+		if err == nil {
+			sent++
+			if err := s.subscriptionRepo.RecordSuccess(sub.ID, time.Now()); err != nil {
+				log.Printf("Error updating push subscription %s: %v", sub.ID, err)
+			}
+			continue
+		}
+
+		s.handleFailure(&sub, err)
+	}
+
+	return sent, nil
+}
+
+// SendTest sends a test message to every browser of a user. Returns the number of browsers reached.
+func (s *PushService) SendTest(ctx context.Context, userID uuid.UUID) (int, error) {
+	if !s.IsEnabled() {
+		return 0, domain.ErrPushNotConfigured
+	}
+	return s.SendToUser(ctx, userID, PushMessage{
+		Type:  "TEST",
+		Title: "Push notifications are working",
+		Body:  "You will receive notifications on this device.",
+		Tag:   "push-test",
+	})
+}
+
+// handleFailure prunes subscriptions that are expired or keep failing
+func (s *PushService) handleFailure(sub *domain.PushSubscription, sendErr error) {
+	if errors.Is(sendErr, webpush.ErrSubscriptionExpired) || errors.Is(sendErr, webpush.ErrInvalidSubscription) {
+		if err := s.subscriptionRepo.Delete(sub.ID); err != nil {
+			log.Printf("Error deleting expired push subscription %s: %v", sub.ID, err)
+		}
+		return
+	}
+
+	log.Printf("Failed to send push notification to subscription %s: %v", sub.ID, sendErr)
+
+	failures, err := s.subscriptionRepo.RecordFailure(sub.ID)
+	if err != nil {
+		log.Printf("Error updating push subscription %s: %v", sub.ID, err)
+		return
+	}
+	if failures >= maxPushFailures {
+		if err := s.subscriptionRepo.Delete(sub.ID); err != nil {
+			log.Printf("Error deleting failing push subscription %s: %v", sub.ID, err)
+		}
+	}
+}
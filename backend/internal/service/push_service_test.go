@@ -0,0 +1,361 @@
+package service
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"job-platform/internal/domain"
+	"job-platform/internal/util/webpush"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// memoryPushStore is an in-memory pushSubscriptionStore
+type memoryPushStore struct {
+	mu   sync.Mutex
+	subs map[uuid.UUID]*domain.PushSubscription
+}
+
+func newMemoryPushStore() *memoryPushStore {
+	return &memoryPushStore{subs: make(map[uuid.UUID]*domain.PushSubscription)}
+}
+
+func (m *memoryPushStore) Upsert(sub *domain.PushSubscription) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for id, existing := range m.subs {
+		if existing.Endpoint == sub.Endpoint {
+			delete(m.subs, id)
+		}
+	}
+	copied := *sub
+	m.subs[sub.ID] = &copied
+	return nil
+}
+
+func (m *memoryPushStore) GetByEndpoint(endpoint string) (*domain.PushSubscription, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, sub := range m.subs {
+		if sub.Endpoint == endpoint {
+			copied := *sub
+			return &copied, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (m *memoryPushStore) GetByID(id uuid.UUID) (*domain.PushSubscription, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	sub, ok := m.subs[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	copied := *sub
+	return &copied, nil
+}
+
+func (m *memoryPushStore) GetByUserID(userID uuid.UUID) ([]domain.PushSubscription, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var subs []domain.PushSubscription
+	for _, sub := range m.subs {
+		if sub.UserID == userID {
+			subs = append(subs, *sub)
+		}
+	}
+	return subs, nil
+}
+
+func (m *memoryPushStore) Delete(id uuid.UUID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.subs, id)
+	return nil
+}
+
+func (m *memoryPushStore) DeleteOldestByUserID(userID uuid.UUID, keep int) error {
+	return nil
+}
+
+func (m *memoryPushStore) RecordSuccess(id uuid.UUID, at time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if sub, ok := m.subs[id]; ok {
+		sub.FailureCount = 0
+		sub.LastSuccessAt = &at
+	}
+	return nil
+}
+
+func (m *memoryPushStore) RecordFailure(id uuid.UUID) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	sub, ok := m.subs[id]
+	if !ok {
+		return 0, nil
+	}
+	sub.FailureCount++
+	return sub.FailureCount, nil
+}
+
+// stubBrowser holds the keys a browser creates when it subscribes
+type stubBrowser struct {
+	privateKey *ecdh.PrivateKey
+	authSecret []byte
+}
+
+func newStubBrowser(t *testing.T) *stubBrowser {
+	t.Helper()
+	key, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth := make([]byte, 16)
+	if _, err := rand.Read(auth); err != nil {
+		t.Fatal(err)
+	}
+	return &stubBrowser{privateKey: key, authSecret: auth}
+}
+
+func (b *stubBrowser) subscribe(userID uuid.UUID, endpoint string) *domain.PushSubscription {
+	return &domain.PushSubscription{
+		ID:       uuid.New(),
+		UserID:   userID,
+		Endpoint: endpoint,
+		P256dh:   base64.RawURLEncoding.EncodeToString(b.privateKey.PublicKey().Bytes()),
+		Auth:     base64.RawURLEncoding.EncodeToString(b.authSecret),
+	}
+}
+
+// decrypt decrypts an aes128gcm push message body as the browser would (RFC 8291)
+func (b *stubBrowser) decrypt(body []byte) ([]byte, error) {
+	if len(body) < 21 {
+		return nil, errors.New("body too short")
+	}
+	salt := body[:16]
+	keyIDLen := int(body[20])
+	if len(body) < 21+keyIDLen {
+		return nil, errors.New("body too short for key id")
+	}
+	if rs := binary.BigEndian.Uint32(body[16:20]); rs < 18 {
+		return nil, errors.New("invalid record size")
+	}
+	serverPublic, err := ecdh.P256().NewPublicKey(body[21 : 21+keyIDLen])
+	if err != nil {
+		return nil, err
+	}
+	ciphertext := body[21+keyIDLen:]
+
+	sharedSecret, err := b.privateKey.ECDH(serverPublic)
+	if err != nil {
+		return nil, err
+	}
+	prkKey, err := hkdf.Extract(sha256.New, sharedSecret, b.authSecret)
+	if err != nil {
+		return nil, err
+	}
+	keyInfo := "WebPush: info\x00" + string(b.privateKey.PublicKey().Bytes()) + string(serverPublic.Bytes())
+	ikm, err := hkdf.Expand(sha256.New, prkKey, keyInfo, 32)
+	if err != nil {
+		return nil, err
+	}
+	prk, err := hkdf.Extract(sha256.New, ikm, salt)
+	if err != nil {
+		return nil, err
+	}
+	cek, err := hkdf.Expand(sha256.New, prk, "Content-Encoding: aes128gcm\x00", 16)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := hkdf.Expand(sha256.New, prk, "Content-Encoding: nonce\x00", 12)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(plaintext) == 0 || plaintext[len(plaintext)-1] != 0x02 {
+		return nil, errors.New("missing last record delimiter")
+	}
+	return plaintext[:len(plaintext)-1], nil
+}
+
+// newTestPushService creates a push service that delivers to the local stub push service
+func newTestPushService(t *testing.T, store pushSubscriptionStore) *PushService {
+	t.Helper()
+	vapidKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The stub listens on loopback, which the delivery client only dials with insecure endpoints allowed
+	config := &PushConfig{FrontendURL: "https://jobs.example.com", AllowInsecureEndpoints: true}
+	client, err := webpush.NewClient(webpush.Config{
+		PrivateKey: base64.RawURLEncoding.EncodeToString(vapidKey.Bytes()),
+		Subject:    "mailto:push@example.com",
+	}, NewPushHTTPClient(config))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &PushService{subscriptionRepo: store, client: client, config: config}
+}
+
+func TestPushServiceSendToUserDeliversEncryptedMessages(t *testing.T) {
+	browser := newStubBrowser(t)
+
+	var (
+		mu       sync.Mutex
+		received []PushMessage
+	)
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if r.Header.Get("Content-Encoding") != "aes128gcm" || r.Header.Get("TTL") == "" ||
+			!strings.HasPrefix(r.Header.Get("Authorization"), "vapid t=") {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		plaintext, err := browser.decrypt(body)
+		if err != nil {
+			t.Errorf("decrypting push message: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var message PushMessage
+		if err := json.Unmarshal(plaintext, &message); err != nil {
+			t.Errorf("decoding push message: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		mu.Lock()
+		received = append(received, message)
+		mu.Unlock()
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer stub.Close()
+
+	store := newMemoryPushStore()
+	svc := newTestPushService(t, store)
+
+	userID := uuid.New()
+	sub := browser.subscribe(userID, stub.URL+"/push/device-1")
+	sub.FailureCount = 2
+	_ = store.Upsert(sub)
+
+	sent, err := svc.SendToUser(context.Background(), userID, PushMessage{
+		Type:  string(domain.NotificationApplicationStatus),
+		Title: "Application Status Updated",
+		Body:  "Your application has been updated",
+		URL:   "/dashboard/applications/1",
+	})
+	if err != nil {
+		t.Fatalf("SendToUser returned error: %v", err)
+	}
+	if sent != 1 {
+		t.Fatalf("sent = %d, want 1", sent)
+	}
+
+	if len(received) != 1 {
+		t.Fatalf("stub received %d messages, want 1", len(received))
+	}
+	if got := received[0]; got.Title != "Application Status Updated" || got.URL != "https://jobs.example.com/dashboard/applications/1" {
+		t.Errorf("stub received %+v", got)
+	}
+
+	stored, err := store.GetByID(sub.ID)
+	if err != nil {
+		t.Fatalf("delivered subscription was deleted: %v", err)
+	}
+	if stored.FailureCount != 0 || stored.LastSuccessAt == nil {
+		t.Errorf("delivery not recorded: failures = %d, last success = %v", stored.FailureCount, stored.LastSuccessAt)
+	}
+}
+
+func TestPushServiceSendToUserPrunesExpiredSubscriptions(t *testing.T) {
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/gone"):
+			w.WriteHeader(http.StatusGone)
+		case strings.HasSuffix(r.URL.Path, "/not-found"):
+			w.WriteHeader(http.StatusNotFound)
+		case strings.HasSuffix(r.URL.Path, "/unavailable"):
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.WriteHeader(http.StatusCreated)
+		}
+	}))
+	defer stub.Close()
+
+	store := newMemoryPushStore()
+	svc := newTestPushService(t, store)
+
+	userID := uuid.New()
+	delivered := newStubBrowser(t).subscribe(userID, stub.URL+"/push/ok")
+	gone := newStubBrowser(t).subscribe(userID, stub.URL+"/push/gone")
+	notFound := newStubBrowser(t).subscribe(userID, stub.URL+"/push/not-found")
+	unavailable := newStubBrowser(t).subscribe(userID, stub.URL+"/push/unavailable")
+	failing := newStubBrowser(t).subscribe(userID, stub.URL+"/push/failing/unavailable")
+	failing.FailureCount = maxPushFailures - 1
+	for _, sub := range []*domain.PushSubscription{delivered, gone, notFound, unavailable, failing} {
+		_ = store.Upsert(sub)
+	}
+
+	sent, err := svc.SendToUser(context.Background(), userID, PushMessage{Type: "TEST", Title: "Test"})
+	if err != nil {
+		t.Fatalf("SendToUser returned error: %v", err)
+	}
+	if sent != 1 {
+		t.Fatalf("sent = %d, want 1", sent)
+	}
+
+	for name, sub := range map[string]*domain.PushSubscription{"410": gone, "404": notFound, "repeatedly failing": failing} {
+		if _, err := store.GetByID(sub.ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+			t.Errorf("%s subscription was not pruned", name)
+		}
+	}
+
+	if _, err := store.GetByID(delivered.ID); err != nil {
+		t.Errorf("delivered subscription was deleted: %v", err)
+	}
+	stored, err := store.GetByID(unavailable.ID)
+	if err != nil {
+		t.Fatalf("temporarily failing subscription was deleted: %v", err)
+	}
+	if stored.FailureCount != 1 {
+		t.Errorf("failure count = %d, want 1", stored.FailureCount)
+	}
+}
@@ -11,9 +11,10 @@ import (
 
 // ReviewService handles company review business logic
 type ReviewService struct {
-	reviewRepo   *repository.ReviewRepository
-	companyRepo  *repository.CompanyRepository
-	teamRepo     *repository.TeamRepository
+	reviewRepo     *repository.ReviewRepository
+	companyRepo    *repository.CompanyRepository
+	teamRepo       *repository.TeamRepository
+	webhookService *WebhookService
 }
 
 // NewReviewService creates a new review service
@@ -29,6 +30,11 @@ func NewReviewService(
 	}
 }
 
+// SetWebhookService sets the service used to send review events to company webhooks
+func (s *ReviewService) SetWebhookService(webhookService *WebhookService) {
+	s.webhookService = webhookService
+}
+
 // CreateReview creates a new company review
 func (s *ReviewService) CreateReview(companyID, userID uuid.UUID, req *domain.CompanyReview) (*domain.CompanyReview, error) {
 	// Check if company exists
@@ -201,6 +207,10 @@ func (s *ReviewService) ApproveReview(id, moderatorID uuid.UUID) error {
 		return err
 	}
 
+	if s.webhookService != nil {
+		s.webhookService.ReviewPosted(review)
+	}
+
 	return nil
 }
 
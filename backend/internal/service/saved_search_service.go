@@ -0,0 +1,466 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"job-platform/internal/domain"
+	"job-platform/internal/repository"
+	"job-platform/internal/util/email"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	// MaxSavedSearchesPerUser is the maximum number of saved searches of a job seeker
+	MaxSavedSearchesPerUser = 20
+	// savedSearchBatchSize is how many saved searches are loaded per query while matching
+	savedSearchBatchSize = 200
+	// maxMatchesPerRun caps the new jobs recorded for one saved search in a single run
+	maxMatchesPerRun = 100
+	// jobAlertEmailJobs is the number of jobs listed in a job alert email
+	jobAlertEmailJobs = 10
+	// savedSearchMatchLookback re-checks jobs published shortly before the last run. Scheduled and
+	// approved jobs can go live with a publish time in the past; matches already recorded are skipped.
+	savedSearchMatchLookback = 2 * time.Hour
+)
+
+// SavedSearchConfig holds configuration for job alert emails
+type SavedSearchConfig struct {
+	CompanyName  string
+	SupportEmail string
+	FrontendURL  string
+}
+
+// SavedSearchService handles saved searches and the job alerts sent for them
+type SavedSearchService struct {
+	savedSearchRepo     *repository.SavedSearchRepository
+	jobRepo             *repository.JobRepository
+	emailService        email.EmailSender
+	notificationService *NotificationService
+	config              *SavedSearchConfig
+}
+
+// NewSavedSearchService creates a new saved search service
+func NewSavedSearchService(
+	savedSearchRepo *repository.SavedSearchRepository,
+	jobRepo *repository.JobRepository,
+	emailService email.EmailSender,
+	config *SavedSearchConfig,
+) *SavedSearchService {
+	config.FrontendURL = strings.TrimRight(config.FrontendURL, "/")
+	return &SavedSearchService{
+		savedSearchRepo: savedSearchRepo,
+		jobRepo:         jobRepo,
+		emailService:    emailService,
+		config:          config,
+	}
+}
+
+// SetNotificationService sets the notification service (to avoid circular dependency)
+func (s *SavedSearchService) SetNotificationService(ns *NotificationService) {
+	s.notificationService = ns
+}
+
+// CreateSavedSearchInput represents input for saving a search
+type CreateSavedSearchInput struct {
+	Name         string
+	Filters      domain.SavedSearchFilters
+	Frequency    domain.SavedSearchFrequency
+	EmailEnabled *bool
+}
+
+// UpdateSavedSearchInput represents input for updating a saved search, nil fields are left unchanged
+type UpdateSavedSearchInput struct {
+	Name         *string
+	Filters      *domain.SavedSearchFilters
+	Frequency    *domain.SavedSearchFrequency
+	EmailEnabled *bool
+	IsActive     *bool
+}
+
+// CreateSavedSearch saves a job search for a job seeker. Only jobs published from now on are matched.
+func (s *SavedSearchService) CreateSavedSearch(userID uuid.UUID, input CreateSavedSearchInput) (*domain.SavedSearch, error) {
+	filters := normalizeSavedSearchFilters(input.Filters)
+	if filters.IsEmpty() {
+		return nil, domain.ErrSavedSearchFiltersRequired
+	}
+
+	frequency := input.Frequency
+	if frequency == "" {
+		frequency = domain.SavedSearchFrequencyDaily
+	}
+	if !frequency.IsValid() {
+		return nil, domain.ErrInvalidSavedSearchFrequency
+	}
+
+	count, err := s.savedSearchRepo.CountByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= MaxSavedSearchesPerUser {
+		return nil, domain.ErrSavedSearchLimitReached
+	}
+
+	token, err := generateToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate unsubscribe token: %w", err)
+	}
+
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		name = describeSavedSearchFilters(filters)
+	}
+
+	now := time.Now()
+	search := &domain.SavedSearch{
+		ID:               uuid.New(),
+		UserID:           userID,
+		Name:             name,
+		Filters:          filters,
+		Frequency:        frequency,
+		EmailEnabled:     input.EmailEnabled == nil || *input.EmailEnabled,
+		IsActive:         true,
+		UnsubscribeToken: token,
+		LastMatchedAt:    &now,
+	}
+
+	if err := s.savedSearchRepo.Create(search); err != nil {
+		return nil, err
+	}
+
+	return search, nil
+}
+
+// GetSavedSearches retrieves the saved searches of a job seeker
+func (s *SavedSearchService) GetSavedSearches(userID uuid.UUID) ([]domain.SavedSearch, error) {
+	return s.savedSearchRepo.GetByUserID(userID)
+}
+
+// GetSavedSearch retrieves a saved search owned by the job seeker
+func (s *SavedSearchService) GetSavedSearch(id, userID uuid.UUID) (*domain.SavedSearch, error) {
+	search, err := s.savedSearchRepo.GetByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrSavedSearchNotFound
+		}
+		return nil, err
+	}
+
+	if search.UserID != userID {
+		return nil, domain.ErrSavedSearchNotFound
+	}
+
+	return search, nil
+}
+
+// UpdateSavedSearch updates a saved search owned by the job seeker
+func (s *SavedSearchService) UpdateSavedSearch(id, userID uuid.UUID, input UpdateSavedSearchInput) (*domain.SavedSearch, error) {
+	search, err := s.GetSavedSearch(id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Filters != nil {
+		filters := normalizeSavedSearchFilters(*input.Filters)
+		if filters.IsEmpty() {
+			return nil, domain.ErrSavedSearchFiltersRequired
+		}
+		search.Filters = filters
+	}
+	if input.Frequency != nil {
+		if !input.Frequency.IsValid() {
+			return nil, domain.ErrInvalidSavedSearchFrequency
+		}
+		search.Frequency = *input.Frequency
+	}
+	if input.Name != nil {
+		search.Name = strings.TrimSpace(*input.Name)
+		if search.Name == "" {
+			search.Name = describeSavedSearchFilters(search.Filters)
+		}
+	}
+	if input.EmailEnabled != nil {
+		search.EmailEnabled = *input.EmailEnabled
+	}
+	if input.IsActive != nil {
+		// A paused search starts matching from the moment it is resumed
+		if *input.IsActive && !search.IsActive {
+			now := time.Now()
+			search.LastMatchedAt = &now
+		}
+		search.IsActive = *input.IsActive
+	}
+
+	if err := s.savedSearchRepo.Update(search); err != nil {
+		return nil, err
+	}
+
+	return search, nil
+}
+
+// DeleteSavedSearch deletes a saved search owned by the job seeker
+func (s *SavedSearchService) DeleteSavedSearch(id, userID uuid.UUID) error {
+	if _, err := s.GetSavedSearch(id, userID); err != nil {
+		return err
+	}
+	return s.savedSearchRepo.Delete(id)
+}
+
+// GetSavedSearchMatches retrieves the jobs matched for a saved search owned by the job seeker
+func (s *SavedSearchService) GetSavedSearchMatches(id, userID uuid.UUID, page, limit int) ([]domain.SavedSearchMatch, int64, error) {
+	if _, err := s.GetSavedSearch(id, userID); err != nil {
+		return nil, 0, err
+	}
+	return s.savedSearchRepo.GetMatches(id, limit, (page-1)*limit)
+}
+
+// Unsubscribe turns off job alert emails of a saved search from the link in an alert email.
+// The search itself is kept. Unsubscribing twice is not an error.
+func (s *SavedSearchService) Unsubscribe(token string) (*domain.SavedSearch, error) {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return nil, domain.ErrInvalidUnsubscribeToken
+	}
+
+	search, err := s.savedSearchRepo.GetByToken(token)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrInvalidUnsubscribeToken
+		}
+		return nil, err
+	}
+
+	if !search.EmailEnabled {
+		return search, nil
+	}
+
+	search.EmailEnabled = false
+	if err := s.savedSearchRepo.Update(search); err != nil {
+		return nil, fmt.Errorf("failed to unsubscribe: %w", err)
+	}
+
+	return search, nil
+}
+
+// MatchNewJobs runs the jobs published since the last run against all active saved searches
+// and records the matches. Returns the number of new matches.
+func (s *SavedSearchService) MatchNewJobs(ctx context.Context) (int, error) {
+	now := time.Now()
+	matched := 0
+	afterID := uuid.Nil
+
+	for {
+		searches, err := s.savedSearchRepo.GetActive(afterID, savedSearchBatchSize)
+		if err != nil {
+			return matched, err
+		}
+
+		for i := range searches {
+			search := &searches[i]
+
+			since := search.CreatedAt
+			if search.LastMatchedAt != nil {
+				if lookback := search.LastMatchedAt.Add(-savedSearchMatchLookback); lookback.After(since) {
+					since = lookback
+				}
+			}
+
+			jobIDs, err := s.jobRepo.GetMatchingJobIDs(search.ID, toJobFilters(search.Filters), since, maxMatchesPerRun)
+			if err != nil {
+				log.Printf("Error matching jobs for saved search %s: %v", search.ID, err)
+				continue
+			}
+
+			added, err := s.savedSearchRepo.AddMatches(search.ID, jobIDs, now)
+			if err != nil {
+				log.Printf("Error recording matches for saved search %s: %v", search.ID, err)
+				continue
+			}
+			matched += added
+		}
+
+		if len(searches) < savedSearchBatchSize {
+			break
+		}
+		afterID = searches[len(searches)-1].ID
+	}
+
+	return matched, nil
+}
+
+// SendJobAlerts sends the pending matches of every saved search whose alert is due, as an
+// in-app notification and, unless turned off, an email. grace lets digests go out that much early,
+// pass the interval of the scheduler calling it. Each search is locked while its alert is sent, so
+// with several instances running every alert goes out once. Returns the number of alerts sent.
+func (s *SavedSearchService) SendJobAlerts(ctx context.Context, grace time.Duration) (int, error) {
+	now := time.Now()
+
+	searches, err := s.savedSearchRepo.GetWithPendingMatches()
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for i := range searches {
+		search := &searches[i]
+		if !search.IsDigestDue(now, grace) {
+			continue
+		}
+
+		_, err := s.savedSearchRepo.LockForAlert(search.ID, func(repo *repository.SavedSearchRepository, locked *domain.SavedSearch) error {
+			// Another instance may have sent the alert since the searches were loaded
+			if !locked.IsDigestDue(now, grace) {
+				return nil
+			}
+			locked.User = search.User
+
+			matches, total, err := repo.GetPendingMatches(locked.ID, jobAlertEmailJobs)
+			if err != nil {
+				return fmt.Errorf("failed to load matches: %w", err)
+			}
+
+			// Jobs closed before the alert went out are dropped silently
+			if total > 0 {
+				s.sendJobAlert(ctx, locked, matches, int(total))
+				sent++
+			}
+
+			if err := repo.MarkNotified(locked.ID, now); err != nil {
+				return fmt.Errorf("failed to mark as notified: %w", err)
+			}
+			return nil
+		})
+		if err != nil {
+			log.Printf("Error sending job alert for saved search %s: %v", search.ID, err)
+		}
+	}
+
+	return sent, nil
+}
+
+// sendJobAlert notifies a job seeker of new jobs matching a saved search
+func (s *SavedSearchService) sendJobAlert(ctx context.Context, search *domain.SavedSearch, matches []domain.SavedSearchMatch, total int) {
+	searchURL := s.SearchURL(search.Filters)
+	link := strings.TrimPrefix(searchURL, s.config.FrontendURL)
+
+	if s.notificationService != nil {
+		if err := s.notificationService.NotifyJobAlert(ctx, search.UserID, search.ID, search.Name, total, link); err != nil {
+			log.Printf("Error creating job alert notification for saved search %s: %v", search.ID, err)
+		}
+
+		prefs, err := s.notificationService.GetPreferences(ctx, search.UserID)
+		if err != nil || !s.notificationService.ShouldSendEmail(prefs, domain.NotificationJobAlert) {
+			return
+		}
+	}
+	if s.emailService == nil || !search.EmailEnabled || search.User == nil {
+		return
+	}
+
+	jobs := make([]email.JobAlertJob, 0, len(matches))
+	for _, match := range matches {
+		if match.Job == nil {
+			continue
+		}
+		jobs = append(jobs, email.JobAlertJob{
+			Title:       match.Job.Title,
+			CompanyName: match.Job.CompanyName,
+			Location:    match.Job.Location,
+			URL:         fmt.Sprintf("%s/jobs/%s", s.config.FrontendURL, match.Job.Slug),
+		})
+	}
+
+	if err := s.emailService.SendJobAlertEmail(email.JobAlertEmailData{
+		Name:           search.User.FirstName,
+		Email:          search.User.Email,
+		SearchName:     search.Name,
+		Frequency:      strings.ToLower(string(search.Frequency)),
+		Jobs:           jobs,
+		TotalJobs:      total,
+		SearchURL:      searchURL,
+		UnsubscribeURL: s.config.FrontendURL + "/job-alerts/unsubscribe?token=" + url.QueryEscape(search.UnsubscribeToken),
+		CompanyName:    s.config.CompanyName,
+		SupportEmail:   s.config.SupportEmail,
+		Year:           time.Now().Year(),
+	}); err != nil {
+		log.Printf("Error sending job alert email for saved search %s: %v", search.ID, err)
+	}
+}
+
+// SearchURL returns the frontend job list URL showing the results of saved search filters
+func (s *SavedSearchService) SearchURL(filters domain.SavedSearchFilters) string {
+	query := url.Values{}
+	if filters.Query != "" {
+		query.Set("q", filters.Query)
+	}
+	if filters.Location != "" {
+		query.Set("location", filters.Location)
+	}
+	if filters.CategorySlug != "" {
+		query.Set("category", filters.CategorySlug)
+	}
+	for _, jt := range filters.JobTypes {
+		query.Add("job_type", string(jt))
+	}
+	for _, el := range filters.ExperienceLevels {
+		query.Add("experience_level", string(el))
+	}
+	for _, wt := range filters.WorkplaceTypes {
+		query.Add("workplace_type", string(wt))
+	}
+	if filters.SalaryMin != nil {
+		query.Set("salary_min", strconv.Itoa(*filters.SalaryMin))
+	}
+	if filters.SalaryMax != nil {
+		query.Set("salary_max", strconv.Itoa(*filters.SalaryMax))
+	}
+
+	if len(query) == 0 {
+		return s.config.FrontendURL + "/jobs"
+	}
+	return s.config.FrontendURL + "/jobs?" + query.Encode()
+}
+
+// toJobFilters converts saved search filters to job list filters
+func toJobFilters(filters domain.SavedSearchFilters) repository.JobFilters {
+	return repository.JobFilters{
+		Query:            filters.Query,
+		JobTypes:         filters.JobTypes,
+		ExperienceLevels: filters.ExperienceLevels,
+		WorkplaceTypes:   filters.WorkplaceTypes,
+		Location:         filters.Location,
+		SalaryMin:        filters.SalaryMin,
+		SalaryMax:        filters.SalaryMax,
+		CategorySlug:     filters.CategorySlug,
+	}
+}
+
+// normalizeSavedSearchFilters trims text filters
+func normalizeSavedSearchFilters(filters domain.SavedSearchFilters) domain.SavedSearchFilters {
+	filters.Query = strings.TrimSpace(filters.Query)
+	filters.Location = strings.TrimSpace(filters.Location)
+	filters.CategorySlug = strings.TrimSpace(filters.CategorySlug)
+	return filters
+}
+
+// describeSavedSearchFilters names a saved search after its filters, e.g. "golang in Berlin"
+func describeSavedSearchFilters(filters domain.SavedSearchFilters) string {
+	name := filters.Query
+	if name == "" {
+		name = filters.CategorySlug
+	}
+	if name == "" {
+		name = "Jobs"
+	}
+	if filters.Location != "" {
+		name += " in " + filters.Location
+	}
+	return name
+}
@@ -0,0 +1,322 @@
+package service
+
+import (
+	"errors"
+	"job-platform/internal/domain"
+	"job-platform/internal/repository"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// MaxScorecardCriteria is the maximum number of scorecard criteria per job
+const MaxScorecardCriteria = 15
+
+// ScorecardService handles job scorecard criteria and interview feedback
+type ScorecardService struct {
+	scorecardRepo   *repository.ScorecardRepository
+	applicationRepo *repository.ApplicationRepository
+	jobRepo         *repository.JobRepository
+	teamRepo        *repository.TeamRepository
+	companyRepo     *repository.CompanyRepository
+}
+
+// NewScorecardService creates a new scorecard service
+func NewScorecardService(
+	scorecardRepo *repository.ScorecardRepository,
+	applicationRepo *repository.ApplicationRepository,
+	jobRepo *repository.JobRepository,
+	teamRepo *repository.TeamRepository,
+	companyRepo *repository.CompanyRepository,
+) *ScorecardService {
+	return &ScorecardService{
+		scorecardRepo:   scorecardRepo,
+		applicationRepo: applicationRepo,
+		jobRepo:         jobRepo,
+		teamRepo:        teamRepo,
+		companyRepo:     companyRepo,
+	}
+}
+
+// ScorecardCriterionInput represents a criterion in a criteria update. ID is set for existing criteria.
+type ScorecardCriterionInput struct {
+	ID          *uuid.UUID
+	Name        string
+	Description string
+}
+
+// ScorecardRatingInput represents the rating of one criterion
+type ScorecardRatingInput struct {
+	CriterionID uuid.UUID
+	Rating      int
+	Comment     string
+}
+
+// SaveScorecardInput represents input for saving a reviewer's scorecard
+type SaveScorecardInput struct {
+	Ratings        []ScorecardRatingInput
+	Recommendation domain.HireRecommendation
+	Summary        string
+	Submit         bool // Submitted scorecards can no longer be edited
+}
+
+// GetJobCriteria retrieves a job's scorecard criteria (employer only)
+func (s *ScorecardService) GetJobCriteria(jobID, employerID uuid.UUID) ([]domain.ScorecardCriterion, error) {
+	if _, err := s.getEmployerJob(jobID, employerID); err != nil {
+		return nil, err
+	}
+	return s.scorecardRepo.GetJobCriteria(jobID)
+}
+
+// UpdateJobCriteria replaces a job's scorecard criteria (employer only)
+func (s *ScorecardService) UpdateJobCriteria(jobID, employerID uuid.UUID, inputs []ScorecardCriterionInput) ([]domain.ScorecardCriterion, error) {
+	if _, err := s.getEmployerJob(jobID, employerID); err != nil {
+		return nil, err
+	}
+	if len(inputs) > MaxScorecardCriteria {
+		return nil, domain.ErrInvalidScorecardCriteria
+	}
+
+	current, err := s.scorecardRepo.GetJobCriteria(jobID)
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[uuid.UUID]bool, len(current))
+	for _, c := range current {
+		existing[c.ID] = true
+	}
+
+	names := make(map[string]bool, len(inputs))
+	ids := make(map[uuid.UUID]bool, len(inputs))
+	criteria := make([]domain.ScorecardCriterion, 0, len(inputs))
+	for i, in := range inputs {
+		name := strings.TrimSpace(in.Name)
+		key := strings.ToLower(name)
+		if name == "" || len(name) > 100 || names[key] {
+			return nil, domain.ErrInvalidScorecardCriteria
+		}
+		names[key] = true
+
+		criterion := domain.ScorecardCriterion{
+			Name:        name,
+			Description: strings.TrimSpace(in.Description),
+			Position:    i,
+		}
+		if in.ID != nil {
+			if !existing[*in.ID] || ids[*in.ID] {
+				return nil, domain.ErrInvalidScorecardCriteria
+			}
+			ids[*in.ID] = true
+			criterion.ID = *in.ID
+		}
+		criteria = append(criteria, criterion)
+	}
+
+	if err := s.scorecardRepo.ReplaceJobCriteria(jobID, criteria); err != nil {
+		return nil, err
+	}
+	return s.scorecardRepo.GetJobCriteria(jobID)
+}
+
+// GetApplicationFeedback retrieves the scorecards of an application visible to a reviewer.
+// The job owner sees all submitted feedback; other reviewers only see their colleagues'
+// scorecards after submitting their own, so their feedback is not influenced.
+func (s *ScorecardService) GetApplicationFeedback(applicationID, userID uuid.UUID) (*domain.ApplicationFeedback, error) {
+	application, err := s.applicationRepo.GetByID(applicationID)
+	if err != nil {
+		return nil, domain.ErrApplicationNotFound
+	}
+	isOwner, err := s.authorizeReviewer(application, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	criteria, err := s.scorecardRepo.GetJobCriteria(application.JobID)
+	if err != nil {
+		return nil, err
+	}
+	scorecards, err := s.scorecardRepo.GetApplicationScorecards(applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	feedback := &domain.ApplicationFeedback{
+		Criteria:   criteria,
+		Scorecards: []domain.Scorecard{},
+	}
+	for i := range scorecards {
+		orderRatings(&scorecards[i], criteria)
+		if scorecards[i].ReviewerID == userID {
+			feedback.MyScorecard = &scorecards[i]
+		}
+	}
+
+	canViewAll := isOwner || (feedback.MyScorecard != nil && feedback.MyScorecard.IsSubmitted())
+	for _, sc := range scorecards {
+		if !sc.IsSubmitted() {
+			continue
+		}
+		if canViewAll || sc.ReviewerID == userID {
+			feedback.Scorecards = append(feedback.Scorecards, sc)
+		} else {
+			feedback.HiddenCount++
+		}
+	}
+	if canViewAll {
+		feedback.Summary = domain.SummarizeScorecards(scorecards, criteria)
+	}
+
+	return feedback, nil
+}
+
+// GetFeedbackSummary retrieves aggregated feedback of an application.
+// Callers must have verified the user owns the application's job.
+func (s *ScorecardService) GetFeedbackSummary(application *domain.Application) (*domain.FeedbackSummary, error) {
+	criteria, err := s.scorecardRepo.GetJobCriteria(application.JobID)
+	if err != nil {
+		return nil, err
+	}
+	scorecards, err := s.scorecardRepo.GetApplicationScorecards(application.ID)
+	if err != nil {
+		return nil, err
+	}
+	return domain.SummarizeScorecards(scorecards, criteria), nil
+}
+
+// SaveMyScorecard creates or updates the reviewer's own scorecard for an application
+func (s *ScorecardService) SaveMyScorecard(applicationID, userID uuid.UUID, input SaveScorecardInput) (*domain.Scorecard, error) {
+	application, err := s.applicationRepo.GetByID(applicationID)
+	if err != nil {
+		return nil, domain.ErrApplicationNotFound
+	}
+	if _, err := s.authorizeReviewer(application, userID); err != nil {
+		return nil, err
+	}
+
+	scorecard, err := s.scorecardRepo.GetByApplicationAndReviewer(applicationID, userID)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		scorecard = &domain.Scorecard{
+			ApplicationID: applicationID,
+			ReviewerID:    userID,
+			Status:        domain.ScorecardStatusDraft,
+		}
+	}
+	if scorecard.IsSubmitted() {
+		return nil, domain.ErrScorecardAlreadySubmitted
+	}
+
+	if input.Recommendation != "" && !input.Recommendation.IsValid() {
+		return nil, domain.ErrInvalidHireRecommendation
+	}
+
+	criteria, err := s.scorecardRepo.GetJobCriteria(application.JobID)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]*domain.ScorecardCriterion, len(criteria))
+	for i := range criteria {
+		byID[criteria[i].ID] = &criteria[i]
+	}
+
+	ratings := make([]domain.ScorecardRating, 0, len(input.Ratings))
+	rated := make(map[uuid.UUID]bool, len(input.Ratings))
+	for _, in := range input.Ratings {
+		criterion, ok := byID[in.CriterionID]
+		if !ok || rated[in.CriterionID] || in.Rating < 1 || in.Rating > 5 {
+			return nil, domain.ErrInvalidScorecardRating
+		}
+		rated[in.CriterionID] = true
+
+		criterionID := criterion.ID
+		ratings = append(ratings, domain.ScorecardRating{
+			CriterionID:   &criterionID,
+			CriterionName: criterion.Name,
+			Rating:        in.Rating,
+			Comment:       strings.TrimSpace(in.Comment),
+		})
+	}
+
+	if input.Submit && (len(rated) != len(criteria) || input.Recommendation == "") {
+		return nil, domain.ErrScorecardIncomplete
+	}
+
+	scorecard.Ratings = ratings
+	scorecard.Recommendation = input.Recommendation
+	scorecard.Summary = strings.TrimSpace(input.Summary)
+	if input.Submit {
+		now := time.Now()
+		scorecard.Status = domain.ScorecardStatusSubmitted
+		scorecard.SubmittedAt = &now
+	}
+
+	if err := s.scorecardRepo.Save(scorecard); err != nil {
+		return nil, err
+	}
+	orderRatings(scorecard, criteria)
+
+	return scorecard, nil
+}
+
+// authorizeReviewer checks that a user may give feedback on an application and reports
+// whether the user is the job owner. Reviewers are the job owner, the application's
+// interviewers and active members of the job's company.
+func (s *ScorecardService) authorizeReviewer(application *domain.Application, userID uuid.UUID) (bool, error) {
+	if application.Job.EmployerID == userID {
+		return true, nil
+	}
+
+	isInterviewer, err := s.scorecardRepo.IsApplicationInterviewer(application.ID, userID)
+	if err != nil {
+		return false, err
+	}
+	if isInterviewer {
+		return false, nil
+	}
+
+	company, err := s.companyRepo.GetJobCompany(&application.Job)
+	if err != nil {
+		return false, domain.ErrNotApplicationReviewer
+	}
+	member, err := s.teamRepo.GetByCompanyAndUser(company.ID, userID)
+	if err != nil || member.Status != domain.TeamMemberStatusActive {
+		return false, domain.ErrNotApplicationReviewer
+	}
+	return false, nil
+}
+
+// getEmployerJob retrieves a job and verifies the employer owns it
+func (s *ScorecardService) getEmployerJob(jobID, employerID uuid.UUID) (*domain.Job, error) {
+	job, err := s.jobRepo.GetByID(jobID)
+	if err != nil {
+		return nil, domain.ErrJobNotFound
+	}
+	if job.EmployerID != employerID {
+		return nil, domain.ErrJobNotOwnedByEmployer
+	}
+	return job, nil
+}
+
+// orderRatings sorts a scorecard's ratings in the order of the job's criteria
+func orderRatings(scorecard *domain.Scorecard, criteria []domain.ScorecardCriterion) {
+	position := make(map[uuid.UUID]int, len(criteria))
+	for _, c := range criteria {
+		position[c.ID] = c.Position
+	}
+	rank := func(r domain.ScorecardRating) int {
+		if r.CriterionID != nil {
+			if p, ok := position[*r.CriterionID]; ok {
+				return p
+			}
+		}
+		return len(criteria)
+	}
+	sort.SliceStable(scorecard.Ratings, func(i, j int) bool {
+		return rank(scorecard.Ratings[i]) < rank(scorecard.Ratings[j])
+	})
+}
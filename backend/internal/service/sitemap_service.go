@@ -0,0 +1,281 @@
+package service
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"job-platform/internal/domain"
+	"job-platform/internal/repository"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SitemapSection is a group of sitemap files listed in the sitemap index
+type SitemapSection string
+
+const (
+	SitemapSectionPages     SitemapSection = "pages"     // Static pages and job categories
+	SitemapSectionJobs      SitemapSection = "jobs"      // Active jobs
+	SitemapSectionCompanies SitemapSection = "companies" // Active and verified companies
+	SitemapSectionBlogs     SitemapSection = "blogs"     // Published blog posts
+)
+
+// SitemapMaxURLs is the maximum number of URLs in one sitemap file allowed by the sitemap protocol
+const SitemapMaxURLs = 50000
+
+const sitemapNamespace = "http://www.sitemaps.org/schemas/sitemap/0.9"
+
+// IsValid checks if the sitemap section exists
+func (s SitemapSection) IsValid() bool {
+	switch s {
+	case SitemapSectionPages, SitemapSectionJobs, SitemapSectionCompanies, SitemapSectionBlogs:
+		return true
+	}
+	return false
+}
+
+// SitemapFileName returns the file name of a sitemap file, e.g. sitemap-jobs-2.xml.
+// The pages section fits in a single file and has no page number.
+func SitemapFileName(section SitemapSection, page int) string {
+	if section == SitemapSectionPages {
+		return "sitemap-pages.xml"
+	}
+	return fmt.Sprintf("sitemap-%s-%d.xml", section, page)
+}
+
+// ParseSitemapFileName parses a sitemap file name created by SitemapFileName
+func ParseSitemapFileName(name string) (SitemapSection, int, error) {
+	if name == SitemapFileName(SitemapSectionPages, 1) {
+		return SitemapSectionPages, 1, nil
+	}
+
+	base := strings.TrimSuffix(strings.TrimPrefix(name, "sitemap-"), ".xml")
+	sep := strings.LastIndex(base, "-")
+	if sep < 0 {
+		return "", 0, domain.ErrSitemapNotFound
+	}
+
+	section := SitemapSection(base[:sep])
+	page, err := strconv.Atoi(base[sep+1:])
+	// Round-trip the name so only canonical file names are served
+	if err != nil || page < 1 || !section.IsValid() || SitemapFileName(section, page) != name {
+		return "", 0, domain.ErrSitemapNotFound
+	}
+	return section, page, nil
+}
+
+// sitemapStaticPage is a frontend page that isn't backed by a record
+type sitemapStaticPage struct {
+	path       string
+	changeFreq string
+	priority   string
+}
+
+// sitemapStaticPages are the frontend pages listed in the pages sitemap
+var sitemapStaticPages = []sitemapStaticPage{
+	{"", "daily", "1.0"},
+	{"/jobs", "hourly", "0.9"},
+	{"/companies", "daily", "0.8"},
+	{"/blogs", "daily", "0.7"},
+	{"/about", "monthly", "0.5"},
+	{"/contact", "yearly", "0.4"},
+	{"/privacy-policy", "yearly", "0.3"},
+	{"/terms-of-service", "yearly", "0.3"},
+}
+
+// sitemapIndexEntry is a <sitemap> element of the sitemap index
+type sitemapIndexEntry struct {
+	XMLName xml.Name `xml:"sitemap"`
+	Loc     string   `xml:"loc"`
+	LastMod string   `xml:"lastmod,omitempty"`
+}
+
+// sitemapURL is a <url> element of a sitemap file
+type sitemapURL struct {
+	XMLName    xml.Name `xml:"url"`
+	Loc        string   `xml:"loc"`
+	LastMod    string   `xml:"lastmod,omitempty"`
+	ChangeFreq string   `xml:"changefreq,omitempty"`
+	Priority   string   `xml:"priority,omitempty"`
+}
+
+// SitemapService renders XML sitemaps of the public frontend pages
+type SitemapService struct {
+	sitemapRepo *repository.SitemapRepository
+	frontendURL string
+}
+
+// NewSitemapService creates a new sitemap service
+func NewSitemapService(sitemapRepo *repository.SitemapRepository, frontendURL string) *SitemapService {
+	return &SitemapService{
+		sitemapRepo: sitemapRepo,
+		frontendURL: strings.TrimRight(frontendURL, "/"),
+	}
+}
+
+// WriteIndex writes the sitemap index to w. baseURL is the public URL the sitemap files are served from.
+// Each file's lastmod is the most recent update of the records it lists.
+func (s *SitemapService) WriteIndex(w io.Writer, baseURL string) error {
+	baseURL = strings.TrimRight(baseURL, "/")
+
+	entries := []sitemapIndexEntry{{Loc: baseURL + "/" + SitemapFileName(SitemapSectionPages, 1)}}
+	for _, section := range []SitemapSection{SitemapSectionJobs, SitemapSectionCompanies, SitemapSectionBlogs} {
+		pages, err := s.getPages(section)
+		if err != nil {
+			return err
+		}
+		for _, page := range pages {
+			entries = append(entries, sitemapIndexEntry{
+				Loc:     baseURL + "/" + SitemapFileName(section, page.Page),
+				LastMod: formatLastMod(page.LastMod),
+			})
+		}
+	}
+
+	return writeSitemapDocument(w, "sitemapindex", len(entries), func(enc *xml.Encoder, i int) error {
+		return enc.Encode(entries[i])
+	})
+}
+
+// WriteSitemap writes one sitemap file of a section to w (pages start at 1)
+func (s *SitemapService) WriteSitemap(w io.Writer, section SitemapSection, page int) error {
+	if !section.IsValid() || page < 1 {
+		return domain.ErrSitemapNotFound
+	}
+
+	var urls []sitemapURL
+	if section == SitemapSectionPages {
+		if page != 1 {
+			return domain.ErrSitemapNotFound
+		}
+		var err error
+		if urls, err = s.pageURLs(); err != nil {
+			return err
+		}
+	} else {
+		entries, err := s.getEntries(section, page)
+		if err != nil {
+			return err
+		}
+		// The first file of a section always exists so the index can be crawled before any content is published
+		if len(entries) == 0 && page > 1 {
+			return domain.ErrSitemapNotFound
+		}
+
+		changeFreq, priority := sectionChangeFreq(section)
+		urls = make([]sitemapURL, len(entries))
+		for i, entry := range entries {
+			urls[i] = sitemapURL{
+				Loc:        fmt.Sprintf("%s/%s/%s", s.frontendURL, section, url.PathEscape(entry.Slug)),
+				LastMod:    formatLastMod(entry.UpdatedAt),
+				ChangeFreq: changeFreq,
+				Priority:   priority,
+			}
+		}
+	}
+
+	return writeSitemapDocument(w, "urlset", len(urls), func(enc *xml.Encoder, i int) error {
+		return enc.Encode(urls[i])
+	})
+}
+
+// pageURLs lists the static pages and the job category pages
+func (s *SitemapService) pageURLs() ([]sitemapURL, error) {
+	categories, err := s.sitemapRepo.GetCategoryEntries()
+	if err != nil {
+		return nil, err
+	}
+
+	urls := make([]sitemapURL, 0, len(sitemapStaticPages)+len(categories))
+	for _, page := range sitemapStaticPages {
+		urls = append(urls, sitemapURL{
+			Loc:        s.frontendURL + page.path,
+			ChangeFreq: page.changeFreq,
+			Priority:   page.priority,
+		})
+	}
+	for _, category := range categories {
+		urls = append(urls, sitemapURL{
+			Loc:        s.frontendURL + "/jobs?category=" + url.QueryEscape(category.Slug),
+			LastMod:    formatLastMod(category.UpdatedAt),
+			ChangeFreq: "daily",
+			Priority:   "0.7",
+		})
+	}
+	return urls, nil
+}
+
+// getPages returns the sitemap files of a record section
+func (s *SitemapService) getPages(section SitemapSection) ([]repository.SitemapPage, error) {
+	switch section {
+	case SitemapSectionJobs:
+		return s.sitemapRepo.GetJobPages(SitemapMaxURLs)
+	case SitemapSectionCompanies:
+		return s.sitemapRepo.GetCompanyPages(SitemapMaxURLs)
+	case SitemapSectionBlogs:
+		return s.sitemapRepo.GetBlogPages(SitemapMaxURLs)
+	}
+	return nil, domain.ErrSitemapNotFound
+}
+
+// getEntries returns the records of one sitemap file of a record section
+func (s *SitemapService) getEntries(section SitemapSection, page int) ([]repository.SitemapEntry, error) {
+	switch section {
+	case SitemapSectionJobs:
+		return s.sitemapRepo.GetJobEntries(page, SitemapMaxURLs)
+	case SitemapSectionCompanies:
+		return s.sitemapRepo.GetCompanyEntries(page, SitemapMaxURLs)
+	case SitemapSectionBlogs:
+		return s.sitemapRepo.GetBlogEntries(page, SitemapMaxURLs)
+	}
+	return nil, domain.ErrSitemapNotFound
+}
+
+// sectionChangeFreq returns the changefreq and priority hints of a record section
+func sectionChangeFreq(section SitemapSection) (string, string) {
+	switch section {
+	case SitemapSectionJobs:
+		return "weekly", "0.8"
+	case SitemapSectionCompanies:
+		return "weekly", "0.7"
+	default:
+		return "weekly", "0.6"
+	}
+}
+
+// formatLastMod formats a lastmod value in W3C datetime format
+func formatLastMod(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// writeSitemapDocument writes an XML sitemap document with the given root element,
+// encoding its n children with encode
+func writeSitemapDocument(w io.Writer, root string, n int, encode func(enc *xml.Encoder, i int) error) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+
+	start := xml.StartElement{
+		Name: xml.Name{Local: root},
+		Attr: []xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: sitemapNamespace}},
+	}
+	if err := enc.EncodeToken(start); err != nil {
+		return err
+	}
+	for i := 0; i < n; i++ {
+		if err := encode(enc, i); err != nil {
+			return err
+		}
+	}
+	if err := enc.EncodeToken(start.End()); err != nil {
+		return err
+	}
+	return enc.Flush()
+}
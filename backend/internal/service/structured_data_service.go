@@ -0,0 +1,366 @@
+package service
+
+import (
+	"job-platform/internal/domain"
+	"job-platform/internal/dto"
+	"job-platform/internal/repository"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// StructuredDataSeverityError marks a missing required field, Google won't show the job
+	StructuredDataSeverityError = "error"
+	// StructuredDataSeverityWarning marks a missing recommended field
+	StructuredDataSeverityWarning = "warning"
+	// structuredDataAuditBatchSize is how many active jobs are validated per query during an audit
+	structuredDataAuditBatchSize = 200
+)
+
+// schemaEmploymentTypes maps job types to schema.org employment types
+var schemaEmploymentTypes = map[domain.JobType]string{
+	domain.JobTypeFullTime:   "FULL_TIME",
+	domain.JobTypePartTime:   "PART_TIME",
+	domain.JobTypeContract:   "CONTRACTOR",
+	domain.JobTypeFreelance:  "CONTRACTOR",
+	domain.JobTypeInternship: "INTERN",
+}
+
+// schemaSalaryUnits maps salary periods to schema.org unit texts
+var schemaSalaryUnits = map[string]string{
+	"HOURLY":  "HOUR",
+	"DAILY":   "DAY",
+	"WEEKLY":  "WEEK",
+	"MONTHLY": "MONTH",
+	"YEARLY":  "YEAR",
+}
+
+// schemaExperienceMonths maps experience levels to the minimum months of experience
+var schemaExperienceMonths = map[domain.ExperienceLevel]int{
+	domain.ExperienceLevelEntry:     1, // monthsOfExperience must be positive
+	domain.ExperienceLevelMid:       24,
+	domain.ExperienceLevelSenior:    60,
+	domain.ExperienceLevelLead:      84,
+	domain.ExperienceLevelExecutive: 120,
+}
+
+// StructuredDataService generates schema.org JobPosting JSON-LD for jobs and validates it
+type StructuredDataService struct {
+	jobRepo     *repository.JobRepository
+	companyRepo *repository.CompanyRepository
+	frontendURL string
+}
+
+// NewStructuredDataService creates a new structured data service
+func NewStructuredDataService(
+	jobRepo *repository.JobRepository,
+	companyRepo *repository.CompanyRepository,
+	frontendURL string,
+) *StructuredDataService {
+	return &StructuredDataService{
+		jobRepo:     jobRepo,
+		companyRepo: companyRepo,
+		frontendURL: strings.TrimRight(frontendURL, "/"),
+	}
+}
+
+// GetJobStructuredData generates the JSON-LD of a published job by slug
+func (s *StructuredDataService) GetJobStructuredData(slug string) (*dto.JobStructuredDataResponse, error) {
+	job, err := s.jobRepo.GetBySlug(slug)
+	if err != nil {
+		return nil, domain.ErrJobNotFound
+	}
+	if job.IsUnpublished() {
+		return nil, domain.ErrJobNotFound
+	}
+
+	return s.buildResponse(job, s.getCompany(job)), nil
+}
+
+// AdminGetJobStructuredData generates the JSON-LD of any job by ID
+func (s *StructuredDataService) AdminGetJobStructuredData(jobID uuid.UUID) (*dto.JobStructuredDataResponse, error) {
+	job, err := s.jobRepo.GetByID(jobID)
+	if err != nil {
+		return nil, domain.ErrJobNotFound
+	}
+
+	return s.buildResponse(job, s.getCompany(job)), nil
+}
+
+// AuditActiveJobs validates the structured data of all active jobs and returns the jobs with issues.
+// With errorsOnly, jobs that only miss recommended fields are left out.
+func (s *StructuredDataService) AuditActiveJobs(errorsOnly bool) ([]dto.StructuredDataAuditItem, error) {
+	items := []dto.StructuredDataAuditItem{}
+	companies := make(map[uuid.UUID]*domain.Company)
+
+	for offset := 0; ; offset += structuredDataAuditBatchSize {
+		jobs, _, err := s.jobRepo.GetActiveJobs(structuredDataAuditBatchSize, offset)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range jobs {
+			job := &jobs[i]
+
+			var company *domain.Company
+			if job.CompanyID != nil {
+				cached, ok := companies[*job.CompanyID]
+				if !ok {
+					cached = s.getCompany(job)
+					companies[*job.CompanyID] = cached
+				}
+				company = cached
+			}
+
+			issues := ValidateJobPosting(job, BuildJobPosting(job, company, s.frontendURL))
+			valid := !hasStructuredDataErrors(issues)
+			if len(issues) == 0 || (errorsOnly && valid) {
+				continue
+			}
+
+			items = append(items, dto.StructuredDataAuditItem{
+				JobID:       job.ID.String(),
+				Title:       job.Title,
+				Slug:        job.Slug,
+				CompanyName: job.CompanyName,
+				Valid:       valid,
+				Issues:      issues,
+			})
+		}
+
+		if len(jobs) < structuredDataAuditBatchSize {
+			return items, nil
+		}
+	}
+}
+
+// buildResponse builds and validates the JSON-LD of a job
+func (s *StructuredDataService) buildResponse(job *domain.Job, company *domain.Company) *dto.JobStructuredDataResponse {
+	posting := BuildJobPosting(job, company, s.frontendURL)
+	issues := ValidateJobPosting(job, posting)
+
+	return &dto.JobStructuredDataResponse{
+		JobID:  job.ID.String(),
+		Slug:   job.Slug,
+		JSONLD: posting,
+		Valid:  !hasStructuredDataErrors(issues),
+		Issues: issues,
+	}
+}
+
+// getCompany loads the company profile of a job, or nil if the job has none
+func (s *StructuredDataService) getCompany(job *domain.Job) *domain.Company {
+	if job.CompanyID == nil || s.companyRepo == nil {
+		return nil
+	}
+	company, err := s.companyRepo.GetByID(*job.CompanyID)
+	if err != nil {
+		return nil
+	}
+	return company
+}
+
+// BuildJobPosting converts a job to a schema.org JobPosting.
+// The company profile is optional and only used for the hiring organisation's logo and link.
+func BuildJobPosting(job *domain.Job, company *domain.Company, frontendURL string) *dto.JobPostingSchema {
+	posting := &dto.JobPostingSchema{
+		Context:     "https://schema.org/",
+		Type:        "JobPosting",
+		Title:       job.Title,
+		Description: job.Description,
+		Identifier: &dto.SchemaPropertyValue{
+			Type:  "PropertyValue",
+			Name:  job.CompanyName,
+			Value: job.ID.String(),
+		},
+		DatePosted:     job.CreatedAt.Format(time.RFC3339),
+		EmploymentType: schemaEmploymentTypes[job.JobType],
+		HiringOrganization: dto.SchemaOrganization{
+			Type: "Organization",
+			Name: job.CompanyName,
+			Logo: job.CompanyLogoURL,
+		},
+		Skills:                strings.Join(job.Skills, ", "),
+		EducationRequirements: job.Education,
+		JobBenefits:           strings.Join(job.Benefits, ", "),
+		// Jobs without an external application link are applied to on our site
+		DirectApply: job.ApplicationURL == "",
+	}
+
+	if frontendURL != "" {
+		posting.URL = frontendURL + "/jobs/" + job.Slug
+	}
+	if job.PublishedAt != nil {
+		posting.DatePosted = job.PublishedAt.Format(time.RFC3339)
+	}
+	if job.ExpiresAt != nil {
+		posting.ValidThrough = job.ExpiresAt.Format(time.RFC3339)
+	}
+
+	// Hiring organisation
+	if company != nil {
+		if posting.HiringOrganization.Name == "" {
+			posting.HiringOrganization.Name = company.Name
+		}
+		if company.LogoURL != nil && *company.LogoURL != "" {
+			posting.HiringOrganization.Logo = *company.LogoURL
+		}
+		if company.Website != nil && *company.Website != "" {
+			posting.HiringOrganization.SameAs = *company.Website
+		} else if frontendURL != "" {
+			posting.HiringOrganization.SameAs = frontendURL + "/companies/" + company.Slug
+		}
+	}
+
+	// Location. Remote jobs are TELECOMMUTE and limited to the job's country, if any.
+	if job.WorkplaceType == domain.WorkplaceTypeRemote {
+		posting.JobLocationType = "TELECOMMUTE"
+		if job.Country != "" {
+			posting.ApplicantLocationRequirements = &dto.SchemaCountry{Type: "Country", Name: job.Country}
+		}
+	} else if job.City != "" || job.Location != "" || job.Country != "" {
+		locality := job.City
+		if locality == "" {
+			locality = strings.TrimSpace(strings.SplitN(job.Location, ",", 2)[0])
+		}
+		posting.JobLocation = &dto.SchemaPlace{
+			Type: "Place",
+			Address: dto.SchemaPostalAddress{
+				Type:            "PostalAddress",
+				StreetAddress:   job.Location,
+				AddressLocality: locality,
+				AddressRegion:   job.State,
+				AddressCountry:  job.Country,
+			},
+		}
+		if job.Latitude != nil && job.Longitude != nil {
+			posting.JobLocation.Geo = &dto.SchemaGeo{
+				Type:      "GeoCoordinates",
+				Latitude:  *job.Latitude,
+				Longitude: *job.Longitude,
+			}
+		}
+	}
+
+	// Salary, unless the employer hides it
+	if !job.HideSalary && (job.SalaryMin != nil || job.SalaryMax != nil) {
+		value := dto.SchemaQuantitativeValue{
+			Type:     "QuantitativeValue",
+			UnitText: schemaSalaryUnits[strings.ToUpper(job.SalaryPeriod)],
+		}
+		if value.UnitText == "" {
+			value.UnitText = "YEAR"
+		}
+		switch {
+		case job.SalaryMin != nil && job.SalaryMax != nil && *job.SalaryMin != *job.SalaryMax:
+			value.MinValue = job.SalaryMin
+			value.MaxValue = job.SalaryMax
+		case job.SalaryMin != nil:
+			value.Value = job.SalaryMin
+		default:
+			value.Value = job.SalaryMax
+		}
+
+		currency := job.SalaryCurrency
+		if currency == "" {
+			currency = "USD"
+		}
+		posting.BaseSalary = &dto.SchemaMonetaryAmount{
+			Type:     "MonetaryAmount",
+			Currency: currency,
+			Value:    value,
+		}
+	}
+
+	if months, ok := schemaExperienceMonths[job.ExperienceLevel]; ok {
+		if job.YearsExperienceMin > 0 {
+			months = job.YearsExperienceMin * 12
+		}
+		posting.ExperienceRequirements = &dto.SchemaExperienceRequirements{
+			Type:               "OccupationalExperienceRequirements",
+			MonthsOfExperience: months,
+		}
+	}
+
+	return posting
+}
+
+// ValidateJobPosting checks a JobPosting against Google's required and recommended fields
+func ValidateJobPosting(job *domain.Job, posting *dto.JobPostingSchema) []dto.StructuredDataIssue {
+	issues := []dto.StructuredDataIssue{}
+	addError := func(field, message string) {
+		issues = append(issues, dto.StructuredDataIssue{Field: field, Severity: StructuredDataSeverityError, Message: message})
+	}
+	addWarning := func(field, message string) {
+		issues = append(issues, dto.StructuredDataIssue{Field: field, Severity: StructuredDataSeverityWarning, Message: message})
+	}
+
+	// Required
+	if strings.TrimSpace(posting.Title) == "" {
+		addError("title", "Job title is missing")
+	}
+	if strings.TrimSpace(posting.Description) == "" {
+		addError("description", "Job description is missing")
+	}
+	if posting.DatePosted == "" {
+		addError("datePosted", "Posting date is missing")
+	}
+	if strings.TrimSpace(posting.HiringOrganization.Name) == "" {
+		addError("hiringOrganization.name", "Company name is missing")
+	}
+	if posting.JobLocationType == "TELECOMMUTE" {
+		if posting.ApplicantLocationRequirements == nil {
+			addError("applicantLocationRequirements", "Remote jobs need the country candidates must live in")
+		}
+	} else if posting.JobLocation == nil {
+		addError("jobLocation", "Job location is missing")
+	} else {
+		if posting.JobLocation.Address.AddressLocality == "" && posting.JobLocation.Address.StreetAddress == "" {
+			addError("jobLocation.address.addressLocality", "Job city is missing")
+		}
+		if posting.JobLocation.Address.AddressCountry == "" {
+			addError("jobLocation.address.addressCountry", "Job country is missing")
+		}
+	}
+	if job.ExpiresAt != nil && job.ExpiresAt.Before(time.Now()) {
+		addError("validThrough", "Job has expired")
+	}
+	if job.Status != domain.JobStatusActive {
+		addError("status", "Only active jobs are shown by Google")
+	}
+
+	// Recommended
+	if posting.ValidThrough == "" {
+		addWarning("validThrough", "Expiry date is missing")
+	}
+	if posting.EmploymentType == "" {
+		addWarning("employmentType", "Job type has no schema.org employment type")
+	}
+	if posting.BaseSalary == nil {
+		if job.HideSalary {
+			addWarning("baseSalary", "Salary is hidden by the employer")
+		} else {
+			addWarning("baseSalary", "Salary is missing")
+		}
+	}
+	if posting.HiringOrganization.Logo == "" {
+		addWarning("hiringOrganization.logo", "Company logo is missing")
+	}
+	if posting.HiringOrganization.SameAs == "" {
+		addWarning("hiringOrganization.sameAs", "Job is not linked to a company profile")
+	}
+
+	return issues
+}
+
+// hasStructuredDataErrors checks if any issue stops Google from showing the job
+func hasStructuredDataErrors(issues []dto.StructuredDataIssue) bool {
+	for _, issue := range issues {
+		if issue.Severity == StructuredDataSeverityError {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,696 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"job-platform/internal/domain"
+	"job-platform/internal/repository"
+	"log"
+	mathrand "math/rand/v2"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+const (
+	// MaxWebhooksPerCompany is the number of webhook endpoints a company can register
+	MaxWebhooksPerCompany = 10
+	// MaxWebhookAttempts is how often a delivery is attempted before it is marked as failed.
+	// With webhookRetryBaseDelay doubling after each failure the last retry is about 8.5 hours after the event.
+	MaxWebhookAttempts = 10
+	// WebhookDeliveryRetention is how long finished deliveries are kept in the delivery log
+	WebhookDeliveryRetention = 30 * 24 * time.Hour
+
+	webhookRetryBaseDelay   = time.Minute
+	webhookRequestTimeout   = 10 * time.Second
+	webhookClaimLease       = 2 * time.Minute // Longer than an attempt, a crashed attempt is retried after it
+	webhookResponseBodySize = 1024
+	webhookDueBatchSize     = 100
+	webhookUserAgent        = "JobPlatform-Webhooks/1.0"
+
+	// Signature headers, see signWebhookPayload
+	WebhookSignatureHeader = "X-Webhook-Signature"
+	WebhookTimestampHeader = "X-Webhook-Timestamp"
+)
+
+// errWebhookAddressNotAllowed is returned when a webhook URL resolves to a private or loopback address
+var errWebhookAddressNotAllowed = errors.New("webhook URL resolves to a non-public address")
+
+// WebhookConfig holds webhook configuration
+type WebhookConfig struct {
+	FrontendURL string
+	// AllowInsecureURLs accepts http:// URLs and private network addresses, e.g. a local receiver in development
+	AllowInsecureURLs bool
+}
+
+// WebhookService manages company webhooks and delivers signed events to them
+type WebhookService struct {
+	webhookRepo *repository.WebhookRepository
+	httpClient  *http.Client
+	config      *WebhookConfig
+}
+
+// NewWebhookService creates a new webhook service
+func NewWebhookService(webhookRepo *repository.WebhookRepository, config *WebhookConfig) *WebhookService {
+	config.FrontendURL = strings.TrimRight(config.FrontendURL, "/")
+
+	return &WebhookService{
+		webhookRepo: webhookRepo,
+		httpClient:  newPublicHTTPClient(webhookRequestTimeout, config.AllowInsecureURLs, errWebhookAddressNotAllowed),
+		config:      config,
+	}
+}
+
+// newPublicHTTPClient creates a client for requests to user-provided URLs. Unless private addresses
+// are allowed it only connects to public addresses, checked on the resolved address so hostnames
+// can't point requests into our network, and fails with errNotAllowed otherwise.
+func newPublicHTTPClient(timeout time.Duration, allowPrivateAddrs bool, errNotAllowed error) *http.Client {
+	dialer := &net.Dialer{Timeout: 5 * time.Second}
+	if !allowPrivateAddrs {
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil || !isPublicAddr(addrPort.Addr()) {
+				return errNotAllowed
+			}
+			return nil
+		}
+	}
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: &http.Transport{DialContext: dialer.DialContext},
+		// Redirects are reported as failures instead of being followed to an unchecked URL
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// isPublicAddr reports whether an address is routable on the public internet
+func isPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsValid() && addr.IsGlobalUnicast() && !addr.IsPrivate() &&
+		!addr.IsLoopback() && !addr.IsLinkLocalUnicast()
+}
+
+// WebhookInput represents input for creating or updating a webhook.
+// Nil fields are left unchanged on update.
+type WebhookInput struct {
+	URL         *string
+	Description *string
+	Events      []string
+	IsActive    *bool
+}
+
+// CreateWebhook registers a webhook for a company. The returned webhook holds the signing secret.
+func (s *WebhookService) CreateWebhook(companyID, userID uuid.UUID, input WebhookInput) (*domain.Webhook, error) {
+	count, err := s.webhookRepo.CountByCompanyID(companyID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= MaxWebhooksPerCompany {
+		return nil, domain.ErrWebhookLimitReached
+	}
+
+	webhook := &domain.Webhook{
+		ID:        uuid.New(),
+		CompanyID: companyID,
+		CreatedBy: &userID,
+		IsActive:  true,
+	}
+	if input.URL == nil {
+		return nil, domain.ErrInvalidWebhookURL
+	}
+	if input.Events == nil {
+		return nil, domain.ErrInvalidWebhookEvents
+	}
+	if err := s.applyInput(webhook, input); err != nil {
+		return nil, err
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+	webhook.Secret = secret
+
+	if err := s.webhookRepo.Create(webhook); err != nil {
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+// GetWebhooks retrieves the webhooks of a company
+func (s *WebhookService) GetWebhooks(companyID uuid.UUID) ([]domain.Webhook, error) {
+	return s.webhookRepo.GetByCompanyID(companyID)
+}
+
+// GetWebhook retrieves a webhook of a company
+func (s *WebhookService) GetWebhook(id, companyID uuid.UUID) (*domain.Webhook, error) {
+	webhook, err := s.webhookRepo.GetByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrWebhookNotFound
+		}
+		return nil, err
+	}
+	if webhook.CompanyID != companyID {
+		return nil, domain.ErrWebhookNotFound
+	}
+	return webhook, nil
+}
+
+// UpdateWebhook updates the URL, description, events or active state of a webhook
+func (s *WebhookService) UpdateWebhook(id, companyID uuid.UUID, input WebhookInput) (*domain.Webhook, error) {
+	webhook, err := s.GetWebhook(id, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.applyInput(webhook, input); err != nil {
+		return nil, err
+	}
+	if input.IsActive != nil {
+		webhook.IsActive = *input.IsActive
+	}
+
+	if err := s.webhookRepo.Update(webhook); err != nil {
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+// DeleteWebhook deletes a webhook and its delivery log
+func (s *WebhookService) DeleteWebhook(id, companyID uuid.UUID) error {
+	if _, err := s.GetWebhook(id, companyID); err != nil {
+		return err
+	}
+	return s.webhookRepo.Delete(id)
+}
+
+// RotateSecret replaces the signing secret of a webhook. The returned webhook holds the new secret.
+func (s *WebhookService) RotateSecret(id, companyID uuid.UUID) (*domain.Webhook, error) {
+	webhook, err := s.GetWebhook(id, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+	webhook.Secret = secret
+
+	if err := s.webhookRepo.Update(webhook); err != nil {
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+// applyInput validates and sets the URL, description and events of a webhook
+func (s *WebhookService) applyInput(webhook *domain.Webhook, input WebhookInput) error {
+	if input.URL != nil {
+		webhookURL := strings.TrimSpace(*input.URL)
+		if err := s.validateURL(webhookURL); err != nil {
+			return err
+		}
+		webhook.URL = webhookURL
+	}
+
+	if input.Description != nil {
+		webhook.Description = strings.TrimSpace(*input.Description)
+	}
+
+	if input.Events != nil {
+		if len(input.Events) == 0 {
+			return domain.ErrInvalidWebhookEvents
+		}
+		events := make([]string, 0, len(input.Events))
+		seen := make(map[string]bool, len(input.Events))
+		for _, event := range input.Events {
+			if !domain.WebhookEvent(event).IsValid() {
+				return domain.ErrInvalidWebhookEvents
+			}
+			if !seen[event] {
+				seen[event] = true
+				events = append(events, event)
+			}
+		}
+		webhook.Events = events
+	}
+
+	return nil
+}
+
+// validateURL checks that a webhook URL is an absolute https URL that doesn't point at our network.
+// Hostnames are checked again on every delivery, when they are resolved.
+func (s *WebhookService) validateURL(webhookURL string) error {
+	u, err := url.Parse(webhookURL)
+	if err != nil || u.Host == "" || u.Hostname() == "" || len(webhookURL) > 2000 {
+		return domain.ErrInvalidWebhookURL
+	}
+
+	if s.config.AllowInsecureURLs {
+		if u.Scheme != "https" && u.Scheme != "http" {
+			return domain.ErrInvalidWebhookURL
+		}
+		return nil
+	}
+
+	if u.Scheme != "https" || strings.EqualFold(u.Hostname(), "localhost") {
+		return domain.ErrInvalidWebhookURL
+	}
+	if addr, err := netip.ParseAddr(u.Hostname()); err == nil && !isPublicAddr(addr) {
+		return domain.ErrInvalidWebhookURL
+	}
+	return nil
+}
+
+// generateWebhookSecret creates a random signing secret
+func generateWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+// GetDeliveries retrieves the delivery log of a webhook, optionally filtered by status
+func (s *WebhookService) GetDeliveries(webhookID, companyID uuid.UUID, status *domain.WebhookDeliveryStatus, limit, offset int) ([]domain.WebhookDelivery, int64, error) {
+	if _, err := s.GetWebhook(webhookID, companyID); err != nil {
+		return nil, 0, err
+	}
+	return s.webhookRepo.GetDeliveries(webhookID, status, limit, offset)
+}
+
+// GetDelivery retrieves a delivery of a webhook
+func (s *WebhookService) GetDelivery(webhookID, deliveryID, companyID uuid.UUID) (*domain.WebhookDelivery, error) {
+	if _, err := s.GetWebhook(webhookID, companyID); err != nil {
+		return nil, err
+	}
+
+	delivery, err := s.webhookRepo.GetDeliveryByID(deliveryID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrWebhookDeliveryNotFound
+		}
+		return nil, err
+	}
+	if delivery.WebhookID != webhookID {
+		return nil, domain.ErrWebhookDeliveryNotFound
+	}
+	return delivery, nil
+}
+
+// ReplayDelivery sends the event of a delivery again as a new delivery, e.g. after the receiver
+// fixed an outage. The event keeps its ID so receivers can recognize events they already processed.
+func (s *WebhookService) ReplayDelivery(webhookID, deliveryID, companyID uuid.UUID) (*domain.WebhookDelivery, error) {
+	original, err := s.GetDelivery(webhookID, deliveryID, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	replay := &domain.WebhookDelivery{
+		ID:            uuid.New(),
+		WebhookID:     webhookID,
+		EventID:       original.EventID,
+		Event:         original.Event,
+		Payload:       original.Payload,
+		Status:        domain.WebhookDeliveryPending,
+		NextAttemptAt: &now,
+		ReplayOf:      &original.ID,
+	}
+	if err := s.webhookRepo.CreateDelivery(replay); err != nil {
+		return nil, err
+	}
+
+	go s.attempt(context.Background(), replay.ID)
+
+	return replay, nil
+}
+
+// webhookEnvelope is the JSON body posted to webhook endpoints
+type webhookEnvelope struct {
+	ID        uuid.UUID           `json:"id"`
+	Type      domain.WebhookEvent `json:"type"`
+	CreatedAt time.Time           `json:"created_at"`
+	Data      interface{}         `json:"data"`
+}
+
+// Dispatch records a delivery of an event for every active webhook of the company subscribed to it
+// and attempts them in the background. Failures are logged and never fail the caller.
+func (s *WebhookService) Dispatch(companyID uuid.UUID, event domain.WebhookEvent, data interface{}) {
+	webhooks, err := s.webhookRepo.GetActiveByEvent(companyID, event)
+	if err != nil {
+		log.Printf("Error loading webhooks of company %s: %v", companyID, err)
+		return
+	}
+	if len(webhooks) == 0 {
+		return
+	}
+
+	now := time.Now()
+	eventID := uuid.New()
+	payload, err := json.Marshal(webhookEnvelope{
+		ID:        eventID,
+		Type:      event,
+		CreatedAt: now.UTC(),
+		Data:      data,
+	})
+	if err != nil {
+		log.Printf("Error encoding %s webhook payload: %v", event, err)
+		return
+	}
+
+	for _, webhook := range webhooks {
+		delivery := &domain.WebhookDelivery{
+			ID:            uuid.New(),
+			WebhookID:     webhook.ID,
+			EventID:       eventID,
+			Event:         event,
+			Payload:       datatypes.JSON(payload),
+			Status:        domain.WebhookDeliveryPending,
+			NextAttemptAt: &now,
+		}
+		if err := s.webhookRepo.CreateDelivery(delivery); err != nil {
+			log.Printf("Error recording %s delivery for webhook %s: %v", event, webhook.ID, err)
+			continue
+		}
+
+		go s.attempt(context.Background(), delivery.ID)
+	}
+}
+
+// ProcessDueDeliveries attempts the pending deliveries whose retry is due. Returns the number attempted.
+func (s *WebhookService) ProcessDueDeliveries(ctx context.Context) (int, error) {
+	ids, err := s.webhookRepo.GetDueDeliveryIDs(time.Now(), webhookDueBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	attempted := 0
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			break
+		}
+		if s.attempt(ctx, id) {
+			attempted++
+		}
+	}
+
+	return attempted, nil
+}
+
+// CleanupDeliveries removes finished deliveries older than WebhookDeliveryRetention
+func (s *WebhookService) CleanupDeliveries() (int64, error) {
+	return s.webhookRepo.DeleteDeliveriesBefore(time.Now().Add(-WebhookDeliveryRetention))
+}
+
+// attempt claims a due delivery, posts it to its webhook and records the outcome.
+// Returns false when the delivery wasn't due or was claimed by another instance.
+func (s *WebhookService) attempt(ctx context.Context, deliveryID uuid.UUID) bool {
+	now := time.Now()
+	claimed, err := s.webhookRepo.ClaimDelivery(deliveryID, now, now.Add(webhookClaimLease))
+	if err != nil {
+		log.Printf("Error claiming webhook delivery %s: %v", deliveryID, err)
+		return false
+	}
+	if !claimed {
+		return false
+	}
+
+	delivery, err := s.webhookRepo.GetDeliveryByID(deliveryID)
+	if err != nil {
+		log.Printf("Error loading webhook delivery %s: %v", deliveryID, err)
+		return false
+	}
+
+	webhook, err := s.webhookRepo.GetByID(delivery.WebhookID)
+	if err != nil {
+		log.Printf("Error loading webhook %s: %v", delivery.WebhookID, err)
+		return false
+	}
+
+	attemptedAt := time.Now()
+	delivery.Attempts++
+	delivery.LastAttemptAt = &attemptedAt
+
+	if !webhook.IsActive {
+		delivery.Status = domain.WebhookDeliveryFailed
+		delivery.NextAttemptAt = nil
+		delivery.Error = "webhook is disabled"
+	} else {
+		s.send(ctx, webhook, delivery)
+	}
+
+	if err := s.webhookRepo.UpdateDelivery(delivery); err != nil {
+		log.Printf("Error recording webhook delivery %s: %v", delivery.ID, err)
+	}
+	return true
+}
+
+// send posts a delivery to its webhook and sets the outcome and next retry on the delivery
+func (s *WebhookService) send(ctx context.Context, webhook *domain.Webhook, delivery *domain.WebhookDelivery) {
+	delivery.ResponseStatus = nil
+	delivery.ResponseBody = ""
+	delivery.Error = ""
+
+	start := time.Now()
+	status, body, err := s.post(ctx, webhook, delivery)
+	duration := int(time.Since(start).Milliseconds())
+	delivery.DurationMs = &duration
+
+	if status != 0 {
+		delivery.ResponseStatus = &status
+		delivery.ResponseBody = body
+	}
+
+	switch {
+	case err != nil:
+		delivery.Error = err.Error()
+	case status < 200 || status > 299:
+		delivery.Error = fmt.Sprintf("endpoint returned status %d", status)
+	default:
+		delivered := time.Now()
+		delivery.Status = domain.WebhookDeliverySucceeded
+		delivery.DeliveredAt = &delivered
+		delivery.NextAttemptAt = nil
+		return
+	}
+
+	if delivery.Attempts >= MaxWebhookAttempts {
+		delivery.Status = domain.WebhookDeliveryFailed
+		delivery.NextAttemptAt = nil
+		return
+	}
+
+	next := time.Now().Add(webhookRetryDelay(delivery.Attempts))
+	delivery.NextAttemptAt = &next
+}
+
+// post sends the signed payload of a delivery. Returns the response status and truncated body.
+func (s *WebhookService) post(ctx context.Context, webhook *domain.Webhook, delivery *domain.WebhookDelivery) (int, string, error) {
+	timestamp := time.Now().Unix()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", webhookUserAgent)
+	req.Header.Set("X-Webhook-Id", delivery.EventID.String())
+	req.Header.Set("X-Webhook-Delivery", delivery.ID.String())
+	req.Header.Set("X-Webhook-Event", string(delivery.Event))
+	req.Header.Set(WebhookTimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(WebhookSignatureHeader, signWebhookPayload(webhook.Secret, timestamp, delivery.Payload))
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, webhookResponseBodySize))
+	return resp.StatusCode, strings.ToValidUTF8(string(body), ""), nil
+}
+
+// signWebhookPayload signs a payload as "sha256=" followed by the hex HMAC-SHA256 of
+// "<timestamp>.<body>" with the webhook secret. Receivers recompute it with the timestamp header
+// and should reject old timestamps to prevent replayed requests.
+func signWebhookPayload(secret string, timestamp int64, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// webhookRetryDelay is the delay after a failed attempt: the base delay doubled for every
+// earlier failure, with up to 10% jitter so an endpoint recovering from an outage isn't flooded
+func webhookRetryDelay(attempts int) time.Duration {
+	delay := webhookRetryBaseDelay << (attempts - 1)
+	return delay + time.Duration(mathrand.Int64N(int64(delay)/10+1))
+}
+
+// Event data
+
+// WebhookApplicant is the applicant of an application event
+type WebhookApplicant struct {
+	ID        uuid.UUID `json:"id"`
+	FirstName string    `json:"first_name"`
+	LastName  string    `json:"last_name"`
+	Email     string    `json:"email"`
+}
+
+// WebhookApplicationData is the data of application.created and application.status_changed events
+type WebhookApplicationData struct {
+	ApplicationID  uuid.UUID        `json:"application_id"`
+	JobID          uuid.UUID        `json:"job_id"`
+	JobTitle       string           `json:"job_title"`
+	RequisitionID  string           `json:"requisition_id,omitempty"`
+	Applicant      WebhookApplicant `json:"applicant"`
+	Status         string           `json:"status"`
+	Stage          string           `json:"stage"`
+	PreviousStatus string           `json:"previous_status,omitempty"`
+	PreviousStage  string           `json:"previous_stage,omitempty"`
+	AppliedAt      time.Time        `json:"applied_at"`
+}
+
+// WebhookJobData is the data of job.published, job.expired and job.closed events
+type WebhookJobData struct {
+	JobID         uuid.UUID  `json:"job_id"`
+	Title         string     `json:"title"`
+	Slug          string     `json:"slug"`
+	URL           string     `json:"url"`
+	Status        string     `json:"status"`
+	RequisitionID string     `json:"requisition_id,omitempty"`
+	JobType       string     `json:"job_type"`
+	WorkplaceType string     `json:"workplace_type"`
+	Location      string     `json:"location"`
+	PublishedAt   *time.Time `json:"published_at,omitempty"`
+	ExpiresAt     *time.Time `json:"expires_at,omitempty"`
+}
+
+// WebhookReviewData is the data of review.posted events. Reviewers stay anonymous.
+type WebhookReviewData struct {
+	ReviewID          uuid.UUID `json:"review_id"`
+	Title             string    `json:"title"`
+	OverallRating     int       `json:"overall_rating"`
+	Pros              string    `json:"pros"`
+	Cons              string    `json:"cons"`
+	JobTitle          *string   `json:"job_title,omitempty"`
+	IsCurrentEmployee bool      `json:"is_current_employee"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+// ApplicationCreated dispatches application.created for an application loaded with its job and
+// applicant, in the given pipeline stage
+func (s *WebhookService) ApplicationCreated(application *domain.Application, stage string) {
+	if application.Job.CompanyID == nil {
+		return
+	}
+	data := applicationWebhookData(application, stage)
+	s.Dispatch(*application.Job.CompanyID, domain.WebhookEventApplicationCreated, data)
+}
+
+// ApplicationStatusChanged dispatches application.status_changed for an application loaded with its
+// job and applicant, after it moved from fromStatus and fromStage
+func (s *WebhookService) ApplicationStatusChanged(application *domain.Application, fromStatus domain.ApplicationStatus, fromStage, toStage string) {
+	if application.Job.CompanyID == nil {
+		return
+	}
+	data := applicationWebhookData(application, toStage)
+	data.PreviousStatus = string(fromStatus)
+	data.PreviousStage = fromStage
+	s.Dispatch(*application.Job.CompanyID, domain.WebhookEventApplicationStatusChanged, data)
+}
+
+// JobPublished dispatches job.published
+func (s *WebhookService) JobPublished(job *domain.Job) {
+	s.dispatchJob(job, domain.WebhookEventJobPublished)
+}
+
+// JobExpired dispatches job.expired
+func (s *WebhookService) JobExpired(job *domain.Job) {
+	s.dispatchJob(job, domain.WebhookEventJobExpired)
+}
+
+// JobClosed dispatches job.closed for a job that was closed without an employer request,
+// because its openings were filled or its posting disappeared from its source
+func (s *WebhookService) JobClosed(job *domain.Job) {
+	s.dispatchJob(job, domain.WebhookEventJobClosed)
+}
+
+// ReviewPosted dispatches review.posted for a review that was approved and is now public
+func (s *WebhookService) ReviewPosted(review *domain.CompanyReview) {
+	s.Dispatch(review.CompanyID, domain.WebhookEventReviewPosted, WebhookReviewData{
+		ReviewID:          review.ID,
+		Title:             review.Title,
+		OverallRating:     review.OverallRating,
+		Pros:              review.Pros,
+		Cons:              review.Cons,
+		JobTitle:          review.JobTitle,
+		IsCurrentEmployee: review.IsCurrentEmployee,
+		CreatedAt:         review.CreatedAt,
+	})
+}
+
+// dispatchJob dispatches a job event for jobs that belong to a company
+func (s *WebhookService) dispatchJob(job *domain.Job, event domain.WebhookEvent) {
+	if job.CompanyID == nil {
+		return
+	}
+	s.Dispatch(*job.CompanyID, event, WebhookJobData{
+		JobID:         job.ID,
+		Title:         job.Title,
+		Slug:          job.Slug,
+		URL:           s.config.FrontendURL + "/jobs/" + job.Slug,
+		Status:        string(job.Status),
+		RequisitionID: job.RequisitionID,
+		JobType:       string(job.JobType),
+		WorkplaceType: string(job.WorkplaceType),
+		Location:      job.Location,
+		PublishedAt:   job.PublishedAt,
+		ExpiresAt:     job.ExpiresAt,
+	})
+}
+
+// applicationWebhookData builds the event data of an application in a pipeline stage
+func applicationWebhookData(application *domain.Application, stage string) WebhookApplicationData {
+	return WebhookApplicationData{
+		ApplicationID: application.ID,
+		JobID:         application.JobID,
+		JobTitle:      application.Job.Title,
+		RequisitionID: application.Job.RequisitionID,
+		Applicant: WebhookApplicant{
+			ID:        application.ApplicantID,
+			FirstName: application.Applicant.FirstName,
+			LastName:  application.Applicant.LastName,
+			Email:     application.Applicant.Email,
+		},
+		Status:    string(application.Status),
+		Stage:     stage,
+		AppliedAt: application.AppliedAt,
+	}
+}
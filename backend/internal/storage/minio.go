@@ -26,6 +26,8 @@ type MinioConfig struct {
 	BucketCerts     string
 	BucketPortfolio string
 	BucketCompanies string
+	BucketMessages  string
+	BucketOffers    string
 }
 
 // MinioClient wraps minio.Client with custom methods
@@ -77,6 +79,8 @@ func (m *MinioClient) InitBuckets() error {
 		m.config.BucketCerts,
 		m.config.BucketPortfolio,
 		m.config.BucketCompanies,
+		m.config.BucketMessages,
+		m.config.BucketOffers,
 	}
 
 	for _, bucket := range buckets {
@@ -0,0 +1,130 @@
+package calendar
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Method is the iTIP method of a calendar object
+type Method string
+
+const (
+	MethodRequest Method = "REQUEST"
+	MethodCancel  Method = "CANCEL"
+)
+
+// Attendee represents an invited participant of an event
+type Attendee struct {
+	Name  string
+	Email string
+}
+
+// Event holds the data for a single iCalendar event
+type Event struct {
+	UID         string // Stable across updates so calendar clients replace the original event
+	Sequence    int    // Incremented on every reschedule or cancellation
+	Method      Method
+	Summary     string
+	Description string
+	Location    string
+	URL         string
+	Start       time.Time
+	End         time.Time
+	Organizer   Attendee
+	Attendees   []Attendee
+}
+
+// ContentType returns the MIME type for the event's .ics attachment
+func (e *Event) ContentType() string {
+	return fmt.Sprintf("text/calendar; charset=utf-8; method=%s", e.method())
+}
+
+func (e *Event) method() Method {
+	if e.Method == "" {
+		return MethodRequest
+	}
+	return e.Method
+}
+
+// Build renders the event as an RFC 5545 iCalendar document
+func (e *Event) Build() []byte {
+	method := e.method()
+	status := "CONFIRMED"
+	if method == MethodCancel {
+		status = "CANCELLED"
+	}
+
+	var b strings.Builder
+	writeLine(&b, "BEGIN:VCALENDAR")
+	writeLine(&b, "VERSION:2.0")
+	writeLine(&b, "PRODID:-//Job Platform//Interviews//EN")
+	writeLine(&b, "CALSCALE:GREGORIAN")
+	writeLine(&b, "METHOD:"+string(method))
+	writeLine(&b, "BEGIN:VEVENT")
+	writeLine(&b, "UID:"+e.UID)
+	writeLine(&b, fmt.Sprintf("SEQUENCE:%d", e.Sequence))
+	writeLine(&b, "DTSTAMP:"+formatTime(time.Now()))
+	writeLine(&b, "DTSTART:"+formatTime(e.Start))
+	writeLine(&b, "DTEND:"+formatTime(e.End))
+	writeLine(&b, "SUMMARY:"+escapeText(e.Summary))
+	if e.Description != "" {
+		writeLine(&b, "DESCRIPTION:"+escapeText(e.Description))
+	}
+	if e.Location != "" {
+		writeLine(&b, "LOCATION:"+escapeText(e.Location))
+	}
+	if e.URL != "" {
+		writeLine(&b, "URL:"+e.URL)
+	}
+	if e.Organizer.Email != "" {
+		writeLine(&b, fmt.Sprintf("ORGANIZER;CN=%s:mailto:%s", escapeParam(e.Organizer.Name), e.Organizer.Email))
+	}
+	for _, a := range e.Attendees {
+		writeLine(&b, fmt.Sprintf("ATTENDEE;CN=%s;ROLE=REQ-PARTICIPANT;PARTSTAT=NEEDS-ACTION;RSVP=TRUE:mailto:%s",
+			escapeParam(a.Name), a.Email))
+	}
+	writeLine(&b, "STATUS:"+status)
+	writeLine(&b, "END:VEVENT")
+	writeLine(&b, "END:VCALENDAR")
+
+	return []byte(b.String())
+}
+
+// formatTime formats a time as a UTC iCalendar date-time
+func formatTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// escapeText escapes a TEXT property value
+func escapeText(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, ";", `\;`)
+	s = strings.ReplaceAll(s, ",", `\,`)
+	s = strings.ReplaceAll(s, "\r\n", `\n`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return s
+}
+
+// escapeParam quotes a parameter value such as a common name
+func escapeParam(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, "'") + `"`
+}
+
+// writeLine writes a content line, folding it at 75 octets as required by RFC 5545
+func writeLine(b *strings.Builder, line string) {
+	limit := 75
+	for len(line) > limit {
+		cut := limit
+		// Do not split multi-byte UTF-8 sequences
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		limit = 74 // Continuation lines start with a space
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
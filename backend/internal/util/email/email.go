@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"html/template"
+	"io"
 
 	"gopkg.in/gomail.v2"
 )
@@ -30,6 +31,11 @@ func NewEmailService(config *EmailConfig) *EmailService {
 
 // SendEmail sends an email
 func (s *EmailService) SendEmail(to, subject, htmlBody, textBody string) error {
+	return s.SendEmailWithAttachments(to, subject, htmlBody, textBody, nil)
+}
+
+// SendEmailWithAttachments sends an email with file attachments
+func (s *EmailService) SendEmailWithAttachments(to, subject, htmlBody, textBody string, attachments []Attachment) error {
 	m := gomail.NewMessage()
 	m.SetHeader("From", fmt.Sprintf("%s <%s>", s.config.FromName, s.config.FromEmail))
 	m.SetHeader("To", to)
@@ -37,6 +43,17 @@ func (s *EmailService) SendEmail(to, subject, htmlBody, textBody string) error {
 	m.SetBody("text/plain", textBody)
 	m.AddAlternative("text/html", htmlBody)
 
+	for _, a := range attachments {
+		content := a.Content
+		m.Attach(a.Filename,
+			gomail.SetCopyFunc(func(w io.Writer) error {
+				_, err := w.Write(content)
+				return err
+			}),
+			gomail.SetHeader(map[string][]string{"Content-Type": {a.ContentType}}),
+		)
+	}
+
 	d := gomail.NewDialer(s.config.SMTPHost, s.config.SMTPPort, s.config.SMTPUser, s.config.SMTPPassword)
 
 	return d.DialAndSend(m)
@@ -57,6 +74,13 @@ func renderTemplate(templateStr string, data interface{}) (string, error) {
 	return buf.String(), nil
 }
 
+// Attachment represents a file attached to an email
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+}
+
 // EmailData holds data for email templates
 type EmailData struct {
 	Name              string
@@ -9,4 +9,10 @@ type EmailSender interface {
 	SendPasswordChangedEmail(data EmailData) error
 	SendAccountLockedEmail(data EmailData) error
 	SendAdminLoginAlert(data EmailData) error
+	SendInterviewInvitation(data InterviewEmailData) error
+	SendInterviewCancellation(data InterviewEmailData) error
+	SendNewMessageEmail(data MessageEmailData) error
+	SendJobAlertEmail(data JobAlertEmailData) error
+	SendNotificationEmail(data NotificationEmailData) error
+	SendNotificationDigestEmail(data NotificationDigestEmailData) error
 }
@@ -0,0 +1,149 @@
+package email
+
+import (
+	"fmt"
+	"strings"
+)
+
+// InterviewEmailData holds data for interview invitation and cancellation emails
+type InterviewEmailData struct {
+	Name          string
+	Email         string
+	Heading       string // e.g. "Interview Scheduled" or "Interview Rescheduled"
+	Message       string
+	JobTitle      string
+	EmployerName  string
+	InterviewTime string // Formatted in the interview's timezone
+	Duration      int    // Minutes
+	Location      string
+	MeetingURL    string
+	DetailsURL    string
+	Reason        string // Cancellation reason
+	CompanyName   string
+	SupportEmail  string
+	Year          int
+
+	// Invite is the .ics calendar attachment
+	Invite *Attachment
+}
+
+// Interview email template
+const interviewEmailTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>{{.Heading}}</title>
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .header { background-color: #4F46E5; color: white; padding: 20px; text-align: center; }
+        .content { background-color: #f9f9f9; padding: 30px; }
+        .details { background-color: #EEF2FF; padding: 15px; border-left: 4px solid #4F46E5; margin: 20px 0; }
+        .button { display: inline-block; padding: 12px 30px; background-color: #4F46E5; color: white; text-decoration: none; border-radius: 5px; margin: 20px 0; }
+        .footer { text-align: center; padding: 20px; font-size: 12px; color: #666; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <h1>{{.Heading}}</h1>
+        </div>
+        <div class="content">
+            <h2>Hello {{.Name}},</h2>
+            <p>{{.Message}}</p>
+            <div class="details">
+                <p><strong>Position:</strong> {{.JobTitle}}{{if .EmployerName}} at {{.EmployerName}}{{end}}</p>
+                {{if .InterviewTime}}<p><strong>When:</strong> {{.InterviewTime}}{{if .Duration}} ({{.Duration}} minutes){{end}}</p>{{end}}
+                {{if .Location}}<p><strong>Where:</strong> {{.Location}}</p>{{end}}
+                {{if .MeetingURL}}<p><strong>Meeting link:</strong> <a href="{{.MeetingURL}}">{{.MeetingURL}}</a></p>{{end}}
+                {{if .Reason}}<p><strong>Reason:</strong> {{.Reason}}</p>{{end}}
+            </div>
+            {{if .Invite}}<p>A calendar invite is attached to this email.</p>{{end}}
+            {{if .DetailsURL}}
+            <p style="text-align: center;">
+                <a href="{{.DetailsURL}}" class="button">View Interview</a>
+            </p>
+            {{end}}
+        </div>
+        <div class="footer">
+            <p>&copy; {{.Year}} {{.CompanyName}}. All rights reserved.</p>
+            <p>If you have any questions, contact us at {{.SupportEmail}}</p>
+        </div>
+    </div>
+</body>
+</html>
+`
+
+// buildInterviewEmail renders the interview email bodies and attachments
+func buildInterviewEmail(data InterviewEmailData) (string, string, []Attachment, error) {
+	html, err := renderTemplate(interviewEmailTemplate, data)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	var text strings.Builder
+	fmt.Fprintf(&text, "Hello %s,\n\n%s\n\nPosition: %s", data.Name, data.Message, data.JobTitle)
+	if data.EmployerName != "" {
+		fmt.Fprintf(&text, " at %s", data.EmployerName)
+	}
+	if data.InterviewTime != "" {
+		fmt.Fprintf(&text, "\nWhen: %s", data.InterviewTime)
+	}
+	if data.Location != "" {
+		fmt.Fprintf(&text, "\nWhere: %s", data.Location)
+	}
+	if data.MeetingURL != "" {
+		fmt.Fprintf(&text, "\nMeeting link: %s", data.MeetingURL)
+	}
+	if data.Reason != "" {
+		fmt.Fprintf(&text, "\nReason: %s", data.Reason)
+	}
+	if data.DetailsURL != "" {
+		fmt.Fprintf(&text, "\n\nView the interview: %s", data.DetailsURL)
+	}
+
+	var attachments []Attachment
+	if data.Invite != nil {
+		attachments = append(attachments, *data.Invite)
+	}
+
+	return html, text.String(), attachments, nil
+}
+
+// SendInterviewInvitation sends an interview invitation or update with a calendar invite
+func (s *EmailService) SendInterviewInvitation(data InterviewEmailData) error {
+	html, text, attachments, err := buildInterviewEmail(data)
+	if err != nil {
+		return err
+	}
+	return s.SendEmailWithAttachments(data.Email, data.Heading+": "+data.JobTitle, html, text, attachments)
+}
+
+// SendInterviewCancellation sends an interview cancellation with a calendar cancellation
+func (s *EmailService) SendInterviewCancellation(data InterviewEmailData) error {
+	html, text, attachments, err := buildInterviewEmail(data)
+	if err != nil {
+		return err
+	}
+	return s.SendEmailWithAttachments(data.Email, "Interview Cancelled: "+data.JobTitle, html, text, attachments)
+}
+
+// SendInterviewInvitation sends an interview invitation or update with a calendar invite
+func (s *ResendService) SendInterviewInvitation(data InterviewEmailData) error {
+	html, text, attachments, err := buildInterviewEmail(data)
+	if err != nil {
+		return err
+	}
+	return s.SendEmailWithAttachments(data.Email, data.Heading+": "+data.JobTitle, html, text, attachments)
+}
+
+// SendInterviewCancellation sends an interview cancellation with a calendar cancellation
+func (s *ResendService) SendInterviewCancellation(data InterviewEmailData) error {
+	html, text, attachments, err := buildInterviewEmail(data)
+	if err != nil {
+		return err
+	}
+	return s.SendEmailWithAttachments(data.Email, "Interview Cancelled: "+data.JobTitle, html, text, attachments)
+}
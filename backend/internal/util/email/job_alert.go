@@ -0,0 +1,128 @@
+package email
+
+import (
+	"fmt"
+	"strings"
+)
+
+// JobAlertJob is a job listed in a job alert email
+type JobAlertJob struct {
+	Title       string
+	CompanyName string
+	Location    string
+	URL         string
+}
+
+// JobAlertEmailData holds data for saved search job alert emails
+type JobAlertEmailData struct {
+	Name           string
+	Email          string
+	SearchName     string
+	Frequency      string // instant, daily or weekly
+	Jobs           []JobAlertJob
+	TotalJobs      int // Can be more than len(Jobs), the email only lists the newest
+	SearchURL      string
+	UnsubscribeURL string
+	CompanyName    string
+	SupportEmail   string
+	Year           int
+}
+
+// MoreJobs returns the number of matching jobs not listed in the email
+func (d JobAlertEmailData) MoreJobs() int {
+	return d.TotalJobs - len(d.Jobs)
+}
+
+// Job alert email template
+const jobAlertEmailTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>Job Alert</title>
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .header { background-color: #4F46E5; color: white; padding: 20px; text-align: center; }
+        .content { background-color: #f9f9f9; padding: 30px; }
+        .job { background-color: #ffffff; padding: 15px; border-left: 4px solid #4F46E5; margin: 15px 0; }
+        .job a { color: #4F46E5; font-weight: bold; text-decoration: none; }
+        .job p { margin: 5px 0 0; color: #666; font-size: 14px; }
+        .button { display: inline-block; padding: 12px 30px; background-color: #4F46E5; color: white; text-decoration: none; border-radius: 5px; margin: 20px 0; }
+        .footer { text-align: center; padding: 20px; font-size: 12px; color: #666; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <h1>New Jobs For You</h1>
+        </div>
+        <div class="content">
+            <h2>Hello {{.Name}},</h2>
+            <p>We found {{.TotalJobs}} new job(s) matching your saved search <strong>{{.SearchName}}</strong>.</p>
+            {{range .Jobs}}
+            <div class="job">
+                <a href="{{.URL}}">{{.Title}}</a>
+                <p>{{.CompanyName}}{{if .Location}} &middot; {{.Location}}{{end}}</p>
+            </div>
+            {{end}}
+            {{if gt .MoreJobs 0}}<p>And {{.MoreJobs}} more.</p>{{end}}
+            <p style="text-align: center;">
+                <a href="{{.SearchURL}}" class="button">View All Jobs</a>
+            </p>
+            <p>You receive this {{.Frequency}} alert because you saved this search.</p>
+        </div>
+        <div class="footer">
+            <p><a href="{{.UnsubscribeURL}}">Unsubscribe from this alert</a></p>
+            <p>&copy; {{.Year}} {{.CompanyName}}. All rights reserved.</p>
+            <p>If you have any questions, contact us at {{.SupportEmail}}</p>
+        </div>
+    </div>
+</body>
+</html>
+`
+
+// buildJobAlertEmail renders the job alert email bodies
+func buildJobAlertEmail(data JobAlertEmailData) (string, string, string, error) {
+	html, err := renderTemplate(jobAlertEmailTemplate, data)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	subject := fmt.Sprintf("%d new jobs for \"%s\"", data.TotalJobs, data.SearchName)
+	if data.TotalJobs == 1 {
+		subject = fmt.Sprintf("1 new job for \"%s\"", data.SearchName)
+	}
+
+	var text strings.Builder
+	fmt.Fprintf(&text, "Hello %s,\n\nWe found %d new job(s) matching your saved search \"%s\":\n\n",
+		data.Name, data.TotalJobs, data.SearchName)
+	for _, job := range data.Jobs {
+		fmt.Fprintf(&text, "- %s, %s\n  %s\n", job.Title, job.CompanyName, job.URL)
+	}
+	if data.MoreJobs() > 0 {
+		fmt.Fprintf(&text, "\nAnd %d more.\n", data.MoreJobs())
+	}
+	fmt.Fprintf(&text, "\nView all jobs: %s\n\nUnsubscribe from this alert: %s", data.SearchURL, data.UnsubscribeURL)
+
+	return subject, html, text.String(), nil
+}
+
+// SendJobAlertEmail sends the new jobs matching a saved search
+func (s *EmailService) SendJobAlertEmail(data JobAlertEmailData) error {
+	subject, html, text, err := buildJobAlertEmail(data)
+	if err != nil {
+		return err
+	}
+	return s.SendEmail(data.Email, subject, html, text)
+}
+
+// SendJobAlertEmail sends the new jobs matching a saved search
+func (s *ResendService) SendJobAlertEmail(data JobAlertEmailData) error {
+	subject, html, text, err := buildJobAlertEmail(data)
+	if err != nil {
+		return err
+	}
+	return s.SendEmail(data.Email, subject, html, text)
+}
@@ -0,0 +1,91 @@
+package email
+
+import "fmt"
+
+// MessageEmailData holds data for new message notification emails
+type MessageEmailData struct {
+	Name            string
+	Email           string
+	SenderName      string
+	JobTitle        string
+	Excerpt         string
+	AttachmentCount int
+	ConversationURL string
+	CompanyName     string
+	SupportEmail    string
+	Year            int
+}
+
+// New message email template
+const newMessageEmailTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>New Message</title>
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .header { background-color: #4F46E5; color: white; padding: 20px; text-align: center; }
+        .content { background-color: #f9f9f9; padding: 30px; }
+        .message { background-color: #EEF2FF; padding: 15px; border-left: 4px solid #4F46E5; margin: 20px 0; white-space: pre-line; }
+        .button { display: inline-block; padding: 12px 30px; background-color: #4F46E5; color: white; text-decoration: none; border-radius: 5px; margin: 20px 0; }
+        .footer { text-align: center; padding: 20px; font-size: 12px; color: #666; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <h1>New Message</h1>
+        </div>
+        <div class="content">
+            <h2>Hello {{.Name}},</h2>
+            <p>{{.SenderName}} sent you a message about the <strong>{{.JobTitle}}</strong> application.</p>
+            <div class="message">{{.Excerpt}}</div>
+            {{if .AttachmentCount}}<p>This message has {{.AttachmentCount}} attachment(s).</p>{{end}}
+            <p style="text-align: center;">
+                <a href="{{.ConversationURL}}" class="button">Reply</a>
+            </p>
+            <p>You can turn off message emails in your notification preferences.</p>
+        </div>
+        <div class="footer">
+            <p>&copy; {{.Year}} {{.CompanyName}}. All rights reserved.</p>
+            <p>If you have any questions, contact us at {{.SupportEmail}}</p>
+        </div>
+    </div>
+</body>
+</html>
+`
+
+// buildNewMessageEmail renders the new message email bodies
+func buildNewMessageEmail(data MessageEmailData) (string, string, string, error) {
+	html, err := renderTemplate(newMessageEmailTemplate, data)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	subject := fmt.Sprintf("New message from %s: %s", data.SenderName, data.JobTitle)
+	text := fmt.Sprintf("Hello %s,\n\n%s sent you a message about the %s application:\n\n%s\n\nReply: %s",
+		data.Name, data.SenderName, data.JobTitle, data.Excerpt, data.ConversationURL)
+
+	return subject, html, text, nil
+}
+
+// SendNewMessageEmail notifies a user of a new message they have not read yet
+func (s *EmailService) SendNewMessageEmail(data MessageEmailData) error {
+	subject, html, text, err := buildNewMessageEmail(data)
+	if err != nil {
+		return err
+	}
+	return s.SendEmail(data.Email, subject, html, text)
+}
+
+// SendNewMessageEmail notifies a user of a new message they have not read yet
+func (s *ResendService) SendNewMessageEmail(data MessageEmailData) error {
+	subject, html, text, err := buildNewMessageEmail(data)
+	if err != nil {
+		return err
+	}
+	return s.SendEmail(data.Email, subject, html, text)
+}
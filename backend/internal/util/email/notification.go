@@ -0,0 +1,292 @@
+package email
+
+import (
+	"bytes"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+)
+
+// NotificationEmailData holds data for emails sent for in-app notifications
+type NotificationEmailData struct {
+	Name           string
+	Email          string
+	Type           string // Notification type, selects the template
+	Title          string
+	Message        string
+	Details        map[string]string // Type-specific values used by the type's template, e.g. job_title
+	ActionURL      string
+	PreferencesURL string
+	CompanyName    string
+	SupportEmail   string
+	Year           int
+}
+
+// Heading returns the email heading for the notification type
+func (d NotificationEmailData) Heading() string {
+	return notificationTemplateFor(d.Type).heading
+}
+
+// ActionText returns the button text for the notification type
+func (d NotificationEmailData) ActionText() string {
+	return notificationTemplateFor(d.Type).actionText
+}
+
+// Notification email layout, the body of each notification type is rendered into "content"
+const notificationEmailLayout = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>{{.Title}}</title>
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .header { background-color: #4F46E5; color: white; padding: 20px; text-align: center; }
+        .content { background-color: #f9f9f9; padding: 30px; }
+        .message { background-color: #EEF2FF; padding: 15px; border-left: 4px solid #4F46E5; margin: 20px 0; white-space: pre-line; }
+        .button { display: inline-block; padding: 12px 30px; background-color: #4F46E5; color: white; text-decoration: none; border-radius: 5px; margin: 20px 0; }
+        .footer { text-align: center; padding: 20px; font-size: 12px; color: #666; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <h1>{{.Heading}}</h1>
+        </div>
+        <div class="content">
+            <h2>Hello {{.Name}},</h2>
+            {{template "content" .}}
+            {{if .ActionURL}}
+            <p style="text-align: center;">
+                <a href="{{.ActionURL}}" class="button">{{.ActionText}}</a>
+            </p>
+            {{end}}
+            <p>You can choose which emails you receive in your <a href="{{.PreferencesURL}}">notification preferences</a>.</p>
+        </div>
+        <div class="footer">
+            <p>&copy; {{.Year}} {{.CompanyName}}. All rights reserved.</p>
+            <p>If you have any questions, contact us at {{.SupportEmail}}</p>
+        </div>
+    </div>
+</body>
+</html>
+`
+
+// Plain-text notification email layout
+const notificationEmailTextLayout = `Hello {{.Name}},
+
+{{template "content" .}}{{if .ActionURL}}
+{{.ActionText}}: {{.ActionURL}}
+{{end}}
+Manage your email preferences: {{.PreferencesURL}}`
+
+// notificationTemplate is the parsed email of one notification type
+type notificationTemplate struct {
+	heading    string
+	actionText string
+	subject    *texttemplate.Template
+	html       *htmltemplate.Template
+	text       *texttemplate.Template
+}
+
+// parsedNotificationTemplates holds the parsed template of every notification type with an email
+var parsedNotificationTemplates = parseNotificationTemplates()
+
+// defaultNotificationTemplate is used for notification types without their own template
+var defaultNotificationTemplate = mustParseNotificationTemplate("default", defaultNotificationEmail)
+
+// parseNotificationTemplates parses the templates of all notification types. Templates are
+// static, so a parse error is a programming error and panics at startup.
+func parseNotificationTemplates() map[string]*notificationTemplate {
+	parsed := make(map[string]*notificationTemplate, len(notificationEmails))
+	for notifType, email := range notificationEmails {
+		parsed[notifType] = mustParseNotificationTemplate(notifType, email)
+	}
+	return parsed
+}
+
+// mustParseNotificationTemplate parses the subject and both bodies of a notification email.
+// Missing details render empty instead of "<no value>".
+func mustParseNotificationTemplate(name string, email notificationEmail) *notificationTemplate {
+	html := htmltemplate.Must(htmltemplate.New(name).Option("missingkey=zero").Parse(notificationEmailLayout))
+	htmltemplate.Must(html.New("content").Parse(email.HTML))
+
+	text := texttemplate.Must(texttemplate.New(name).Option("missingkey=zero").Parse(notificationEmailTextLayout))
+	texttemplate.Must(text.New("content").Parse(email.Text))
+
+	return &notificationTemplate{
+		heading:    email.Heading,
+		actionText: email.ActionText,
+		subject:    texttemplate.Must(texttemplate.New(name + "_subject").Option("missingkey=zero").Parse(email.Subject)),
+		html:       html,
+		text:       text,
+	}
+}
+
+// notificationTemplateFor returns the template of a notification type
+func notificationTemplateFor(notifType string) *notificationTemplate {
+	if tmpl, ok := parsedNotificationTemplates[notifType]; ok {
+		return tmpl
+	}
+	return defaultNotificationTemplate
+}
+
+// buildNotificationEmail renders the subject and bodies of a notification email from the template of its type
+func buildNotificationEmail(data NotificationEmailData) (string, string, string, error) {
+	tmpl := notificationTemplateFor(data.Type)
+
+	var subject, html, text bytes.Buffer
+	if err := tmpl.subject.Execute(&subject, data); err != nil {
+		return "", "", "", err
+	}
+	if err := tmpl.html.Execute(&html, data); err != nil {
+		return "", "", "", err
+	}
+	if err := tmpl.text.Execute(&text, data); err != nil {
+		return "", "", "", err
+	}
+
+	return strings.TrimSpace(subject.String()), html.String(), text.String(), nil
+}
+
+// SendNotificationEmail sends an in-app notification by email
+func (s *EmailService) SendNotificationEmail(data NotificationEmailData) error {
+	subject, html, text, err := buildNotificationEmail(data)
+	if err != nil {
+		return err
+	}
+	return s.SendEmail(data.Email, subject, html, text)
+}
+
+// SendNotificationEmail sends an in-app notification by email
+func (s *ResendService) SendNotificationEmail(data NotificationEmailData) error {
+	subject, html, text, err := buildNotificationEmail(data)
+	if err != nil {
+		return err
+	}
+	return s.SendEmail(data.Email, subject, html, text)
+}
+
+// NotificationDigestItem is a notification listed in a digest email
+type NotificationDigestItem struct {
+	Title   string
+	Message string
+	URL     string
+}
+
+// NotificationDigestEmailData holds data for daily notification digest emails
+type NotificationDigestEmailData struct {
+	Name             string
+	Email            string
+	Items            []NotificationDigestItem
+	TotalItems       int // Can be more than len(Items), the email only lists the newest
+	NotificationsURL string
+	PreferencesURL   string
+	CompanyName      string
+	SupportEmail     string
+	Year             int
+}
+
+// MoreItems returns the number of notifications not listed in the email
+func (d NotificationDigestEmailData) MoreItems() int {
+	return d.TotalItems - len(d.Items)
+}
+
+// Notification digest email template
+const notificationDigestEmailTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>Your Daily Summary</title>
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .header { background-color: #4F46E5; color: white; padding: 20px; text-align: center; }
+        .content { background-color: #f9f9f9; padding: 30px; }
+        .item { background-color: #ffffff; padding: 15px; border-left: 4px solid #4F46E5; margin: 15px 0; }
+        .item a { color: #4F46E5; font-weight: bold; text-decoration: none; }
+        .item p { margin: 5px 0 0; color: #666; font-size: 14px; }
+        .button { display: inline-block; padding: 12px 30px; background-color: #4F46E5; color: white; text-decoration: none; border-radius: 5px; margin: 20px 0; }
+        .footer { text-align: center; padding: 20px; font-size: 12px; color: #666; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <h1>Your Daily Summary</h1>
+        </div>
+        <div class="content">
+            <h2>Hello {{.Name}},</h2>
+            <p>Here is what happened since your last summary: {{.TotalItems}} new notification(s).</p>
+            {{range .Items}}
+            <div class="item">
+                {{if .URL}}<a href="{{.URL}}">{{.Title}}</a>{{else}}<strong>{{.Title}}</strong>{{end}}
+                <p>{{.Message}}</p>
+            </div>
+            {{end}}
+            {{if gt .MoreItems 0}}<p>And {{.MoreItems}} more.</p>{{end}}
+            <p style="text-align: center;">
+                <a href="{{.NotificationsURL}}" class="button">View All Notifications</a>
+            </p>
+            <p>You receive this summary once a day instead of an email for each notification. You can change this in your <a href="{{.PreferencesURL}}">notification preferences</a>.</p>
+        </div>
+        <div class="footer">
+            <p>&copy; {{.Year}} {{.CompanyName}}. All rights reserved.</p>
+            <p>If you have any questions, contact us at {{.SupportEmail}}</p>
+        </div>
+    </div>
+</body>
+</html>
+`
+
+// buildNotificationDigestEmail renders the notification digest email bodies
+func buildNotificationDigestEmail(data NotificationDigestEmailData) (string, string, string, error) {
+	html, err := renderTemplate(notificationDigestEmailTemplate, data)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	subject := fmt.Sprintf("Your daily summary: %d new notifications", data.TotalItems)
+	if data.TotalItems == 1 {
+		subject = "Your daily summary: 1 new notification"
+	}
+
+	var text strings.Builder
+	fmt.Fprintf(&text, "Hello %s,\n\nHere is what happened since your last summary: %d new notification(s).\n\n",
+		data.Name, data.TotalItems)
+	for _, item := range data.Items {
+		fmt.Fprintf(&text, "- %s\n  %s\n", item.Title, item.Message)
+		if item.URL != "" {
+			fmt.Fprintf(&text, "  %s\n", item.URL)
+		}
+	}
+	if data.MoreItems() > 0 {
+		fmt.Fprintf(&text, "\nAnd %d more.\n", data.MoreItems())
+	}
+	fmt.Fprintf(&text, "\nView all notifications: %s\n\nManage your email preferences: %s", data.NotificationsURL, data.PreferencesURL)
+
+	return subject, html, text.String(), nil
+}
+
+// SendNotificationDigestEmail sends the notifications batched into a daily digest
+func (s *EmailService) SendNotificationDigestEmail(data NotificationDigestEmailData) error {
+	subject, html, text, err := buildNotificationDigestEmail(data)
+	if err != nil {
+		return err
+	}
+	return s.SendEmail(data.Email, subject, html, text)
+}
+
+// SendNotificationDigestEmail sends the notifications batched into a daily digest
+func (s *ResendService) SendNotificationDigestEmail(data NotificationDigestEmailData) error {
+	subject, html, text, err := buildNotificationDigestEmail(data)
+	if err != nil {
+		return err
+	}
+	return s.SendEmail(data.Email, subject, html, text)
+}
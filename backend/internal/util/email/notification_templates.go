@@ -0,0 +1,223 @@
+package email
+
+// notificationEmail is the email template of a notification type. Subject and Text are plain-text
+// templates, HTML is rendered inside the notification layout. All three get NotificationEmailData,
+// with the type-specific values in .Details.
+type notificationEmail struct {
+	Heading    string
+	ActionText string
+	Subject    string
+	HTML       string
+	Text       string
+}
+
+// notificationEmails maps notification types to their email templates. New messages, scheduled
+// and cancelled interviews and job alerts have dedicated emails (message.go, interview.go,
+// job_alert.go) and digests are rendered by buildNotificationDigestEmail.
+var notificationEmails = map[string]notificationEmail{
+	"APPLICATION_STATUS_CHANGE": {
+		Heading:    "Application Update",
+		ActionText: "View Application",
+		Subject:    `Update on your application for {{.Details.job_title}}`,
+		HTML: `
+            {{if eq .Details.status "HIRED"}}<p>Congratulations! You have been hired for <strong>{{.Details.job_title}}</strong>.</p>
+            {{else if eq .Details.status "REJECTED"}}<p>Thank you for your interest in <strong>{{.Details.job_title}}</strong>. Unfortunately the employer has decided not to move forward with your application.</p>
+            {{else if eq .Details.status "INTERVIEW"}}<p>Good news: the employer would like to interview you for <strong>{{.Details.job_title}}</strong>. You will receive the interview details separately.</p>
+            {{else if eq .Details.status "OFFERED"}}<p>Good news: you are receiving an offer for <strong>{{.Details.job_title}}</strong>.</p>
+            {{else}}<p>Your application for <strong>{{.Details.job_title}}</strong> has moved forward.</p>
+            {{end}}
+            <div class="message">Current status: {{.Details.status}}</div>`,
+		Text: `{{if eq .Details.status "HIRED"}}Congratulations! You have been hired for {{.Details.job_title}}.
+{{- else if eq .Details.status "REJECTED"}}Thank you for your interest in {{.Details.job_title}}. Unfortunately the employer has decided not to move forward with your application.
+{{- else if eq .Details.status "INTERVIEW"}}Good news: the employer would like to interview you for {{.Details.job_title}}. You will receive the interview details separately.
+{{- else if eq .Details.status "OFFERED"}}Good news: you are receiving an offer for {{.Details.job_title}}.
+{{- else}}Your application for {{.Details.job_title}} has moved forward.
+{{- end}}
+
+Current status: {{.Details.status}}
+`,
+	},
+	"NEW_APPLICATION": {
+		Heading:    "New Application",
+		ActionText: "Review Application",
+		Subject:    `New application from {{.Details.applicant_name}} for {{.Details.job_title}}`,
+		HTML: `
+            <p><strong>{{.Details.applicant_name}}</strong> applied to <strong>{{.Details.job_title}}</strong>.</p>
+            <p>Review the application to move the candidate through your pipeline.</p>`,
+		Text: `{{.Details.applicant_name}} applied to {{.Details.job_title}}.
+
+Review the application to move the candidate through your pipeline.
+`,
+	},
+	"NEW_JOB_FROM_FOLLOWED_COMPANY": {
+		Heading:    "New Job Posted",
+		ActionText: "View Job",
+		Subject:    `{{.Details.company_name}} is hiring: {{.Details.job_title}}`,
+		HTML: `
+            <p><strong>{{.Details.company_name}}</strong>, a company you follow, posted a new job:</p>
+            <div class="message">{{.Details.job_title}}</div>`,
+		Text: `{{.Details.company_name}}, a company you follow, posted a new job:
+
+{{.Details.job_title}}
+`,
+	},
+	"JOB_EXPIRING_SOON": {
+		Heading:    "Job Expiring Soon",
+		ActionText: "Manage Job",
+		Subject:    `Your job posting "{{.Details.job_title}}" expires in {{.Details.days_until_expiry}} days`,
+		HTML: `
+            <p>Your job posting <strong>{{.Details.job_title}}</strong> will expire in {{.Details.days_until_expiry}} days.</p>
+            <p>Renew it to keep receiving applications, or let it expire if the position is filled.</p>`,
+		Text: `Your job posting "{{.Details.job_title}}" will expire in {{.Details.days_until_expiry}} days.
+
+Renew it to keep receiving applications, or let it expire if the position is filled.
+`,
+	},
+	"PROFILE_VIEWED": {
+		Heading:    "Profile Viewed",
+		ActionText: "See Who Viewed",
+		Subject:    `{{.Details.viewer_name}} viewed your profile`,
+		HTML: `
+            <p><strong>{{.Details.viewer_name}}</strong>{{with .Details.viewer_company}} from <strong>{{.}}</strong>{{end}} viewed your profile.</p>
+            <p>Keep your profile up to date so employers see your latest experience.</p>`,
+		Text: `{{.Details.viewer_name}}{{with .Details.viewer_company}} from {{.}}{{end}} viewed your profile.
+
+Keep your profile up to date so employers see your latest experience.
+`,
+	},
+	"COMPANY_REVIEW_POSTED": {
+		Heading:    "New Company Review",
+		ActionText: "Read Review",
+		Subject:    `New {{.Details.rating}}-star review of your company`,
+		HTML: `
+            <p><strong>{{.Details.reviewer_name}}</strong> left a {{.Details.rating}}-star review on your company.</p>
+            <p>You can read the review and respond to it from your company page.</p>`,
+		Text: `{{.Details.reviewer_name}} left a {{.Details.rating}}-star review on your company.
+
+You can read the review and respond to it from your company page.
+`,
+	},
+	"TEAM_INVITATION": {
+		Heading:    "Team Invitation",
+		ActionText: "View Invitation",
+		Subject:    `{{.Details.inviter_name}} invited you to join {{.Details.company_name}}`,
+		HTML: `
+            <p><strong>{{.Details.inviter_name}}</strong> invited you to join the <strong>{{.Details.company_name}}</strong> team as {{.Details.role}}.</p>
+            <p>Accept the invitation to start managing jobs and applications with your team.</p>`,
+		Text: `{{.Details.inviter_name}} invited you to join the {{.Details.company_name}} team as {{.Details.role}}.
+
+Accept the invitation to start managing jobs and applications with your team.
+`,
+	},
+	"JOB_APPROVED": {
+		Heading:    "Job Approved",
+		ActionText: "View Job",
+		Subject:    `Your job posting "{{.Details.job_title}}" is live`,
+		HTML: `
+            <p>Your job posting <strong>{{.Details.job_title}}</strong> has been approved and is now live.</p>`,
+		Text: `Your job posting "{{.Details.job_title}}" has been approved and is now live.
+`,
+	},
+	"JOB_REJECTED": {
+		Heading:    "Job Not Approved",
+		ActionText: "Review Job",
+		Subject:    `Your job posting "{{.Details.job_title}}" was not approved`,
+		HTML: `
+            <p>Your job posting <strong>{{.Details.job_title}}</strong> was not approved.</p>
+            {{with .Details.reason}}<div class="message">Reason: {{.}}</div>{{end}}
+            <p>Update the posting and submit it again.</p>`,
+		Text: `Your job posting "{{.Details.job_title}}" was not approved.
+{{with .Details.reason}}
+Reason: {{.}}
+{{end}}
+Update the posting and submit it again.
+`,
+	},
+	"COMPANY_VERIFIED": {
+		Heading:    "Company Verified",
+		ActionText: "View Company",
+		Subject:    `{{.Details.company_name}} has been verified`,
+		HTML: `
+            <p>Your company <strong>{{.Details.company_name}}</strong> has been verified. Its profile and jobs now show the verified badge.</p>`,
+		Text: `Your company {{.Details.company_name}} has been verified. Its profile and jobs now show the verified badge.
+`,
+	},
+	"COMPANY_REJECTED": {
+		Heading:    "Company Verification Declined",
+		ActionText: "Review Company",
+		Subject:    `Verification of {{.Details.company_name}} was declined`,
+		HTML: `
+            <p>The verification of your company <strong>{{.Details.company_name}}</strong> was declined.</p>
+            {{with .Details.reason}}<div class="message">Reason: {{.}}</div>{{end}}
+            <p>Update your company details and request verification again.</p>`,
+		Text: `The verification of your company {{.Details.company_name}} was declined.
+{{with .Details.reason}}
+Reason: {{.}}
+{{end}}
+Update your company details and request verification again.
+`,
+	},
+	"INTERVIEW_PROPOSED": {
+		Heading:    "Interview Invitation",
+		ActionText: "Choose a Time",
+		Subject:    `Interview invitation: {{.Details.job_title}}`,
+		HTML: `
+            <p>You have been invited to interview for <strong>{{.Details.job_title}}</strong>.</p>
+            <div class="message">The employer proposed {{.Details.slot_count}} time(s). Pick the one that suits you best and you will receive a calendar invite.</div>`,
+		Text: `You have been invited to interview for {{.Details.job_title}}.
+
+The employer proposed {{.Details.slot_count}} time(s). Pick the one that suits you best and you will receive a calendar invite.
+`,
+	},
+	"NOTE_MENTION": {
+		Heading:    "You Were Mentioned",
+		ActionText: "View Note",
+		Subject:    `{{.Details.author_name}} mentioned you in a note on {{.Details.subject_name}}`,
+		HTML: `
+            <p><strong>{{.Details.author_name}}</strong> mentioned you in a note on <strong>{{.Details.subject_name}}</strong>:</p>
+            <div class="message">{{.Details.excerpt}}</div>`,
+		Text: `{{.Details.author_name}} mentioned you in a note on {{.Details.subject_name}}:
+
+{{.Details.excerpt}}
+`,
+	},
+	"OFFER_RECEIVED": {
+		Heading:    "Job Offer Received",
+		ActionText: "View Offer",
+		Subject:    `Offer from {{.Details.company_name}}: {{.Details.job_title}}`,
+		HTML: `
+            <p>Congratulations! <strong>{{.Details.company_name}}</strong> sent you an offer for <strong>{{.Details.job_title}}</strong>.</p>
+            <p>Review the offer letter and accept or decline it from your application.</p>`,
+		Text: `Congratulations! {{.Details.company_name}} sent you an offer for {{.Details.job_title}}.
+
+Review the offer letter and accept or decline it from your application.
+`,
+	},
+	"OFFER_RESPONDED": {
+		Heading:    "Offer Response",
+		ActionText: "View Application",
+		Subject:    `{{.Details.candidate_name}} {{if eq .Details.accepted "true"}}accepted{{else}}declined{{end}} your offer for {{.Details.job_title}}`,
+		HTML: `
+            {{if eq .Details.accepted "true"}}<p><strong>{{.Details.candidate_name}}</strong> accepted your offer for <strong>{{.Details.job_title}}</strong> and has been marked as hired.</p>
+            {{else}}<p><strong>{{.Details.candidate_name}}</strong> declined your offer for <strong>{{.Details.job_title}}</strong>.</p>
+            {{end}}`,
+		Text: `{{if eq .Details.accepted "true"}}{{.Details.candidate_name}} accepted your offer for {{.Details.job_title}} and has been marked as hired.
+{{- else}}{{.Details.candidate_name}} declined your offer for {{.Details.job_title}}.
+{{- end}}
+`,
+	},
+}
+
+// defaultNotificationEmail is used for notification types without their own template
+var defaultNotificationEmail = notificationEmail{
+	Heading:    "New Notification",
+	ActionText: "View Details",
+	Subject:    `{{.Title}}`,
+	HTML: `
+            <p><strong>{{.Title}}</strong></p>
+            <div class="message">{{.Message}}</div>`,
+	Text: `{{.Title}}
+
+{{.Message}}
+`,
+}
@@ -2,6 +2,7 @@ package email
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -36,6 +37,14 @@ type ResendEmailRequest struct {
 	Subject string `json:"subject"`
 	HTML    string `json:"html"`
 	Text    string `json:"text,omitempty"`
+	Attachments []ResendAttachment `json:"attachments,omitempty"`
+}
+
+// ResendAttachment represents a file attached to a Resend email
+type ResendAttachment struct {
+	Filename    string `json:"filename"`
+	Content     string `json:"content"` // Base64 encoded
+	ContentType string `json:"content_type,omitempty"`
 }
 
 // ResendEmailResponse represents Resend API response
@@ -46,6 +55,11 @@ type ResendEmailResponse struct {
 
 // SendEmail sends an email via Resend API
 func (s *ResendService) SendEmail(to, subject, htmlBody, textBody string) error {
+	return s.SendEmailWithAttachments(to, subject, htmlBody, textBody, nil)
+}
+
+// SendEmailWithAttachments sends an email with file attachments via Resend API
+func (s *ResendService) SendEmailWithAttachments(to, subject, htmlBody, textBody string, attachments []Attachment) error {
 	reqBody := ResendEmailRequest{
 		From:    fmt.Sprintf("%s <%s>", s.config.FromName, s.config.FromEmail),
 		To:      []string{to},
@@ -53,6 +67,13 @@ func (s *ResendService) SendEmail(to, subject, htmlBody, textBody string) error
 		HTML:    htmlBody,
 		Text:    textBody,
 	}
+	for _, a := range attachments {
+		reqBody.Attachments = append(reqBody.Attachments, ResendAttachment{
+			Filename:    a.Filename,
+			Content:     base64.StdEncoding.EncodeToString(a.Content),
+			ContentType: a.ContentType,
+		})
+	}
 
 	jsonData, err := json.Marshal(reqBody)
 	if err != nil {
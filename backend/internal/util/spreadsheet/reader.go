@@ -0,0 +1,372 @@
+// Package spreadsheet reads and writes the CSV and XLSX files used for bulk uploads and exports.
+// XLSX support covers plain cell values of the first worksheet; formulas are read as their cached
+// result and formatting is ignored, except to recognize dates.
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Format is a supported spreadsheet file format
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatXLSX Format = "xlsx"
+)
+
+// maxPartSize caps the uncompressed size of a single part of an XLSX file
+const maxPartSize = 50 << 20
+
+var (
+	// ErrUnsupportedFormat is returned for files that are not CSV or XLSX
+	ErrUnsupportedFormat = errors.New("unsupported spreadsheet format, use CSV or XLSX")
+	// ErrInvalidFile is returned when a file can't be parsed
+	ErrInvalidFile = errors.New("invalid spreadsheet file")
+)
+
+// FormatFromFilename determines the format of a file from its extension
+func FormatFromFilename(filename string) (Format, error) {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".csv":
+		return FormatCSV, nil
+	case ".xlsx":
+		return FormatXLSX, nil
+	default:
+		return "", ErrUnsupportedFormat
+	}
+}
+
+// Read parses a CSV or XLSX file into rows of cell values. Rows keep their position in the
+// sheet, so empty rows between data rows are returned as empty slices.
+func Read(format Format, data []byte) ([][]string, error) {
+	switch format {
+	case FormatCSV:
+		return ReadCSV(bytes.NewReader(data))
+	case FormatXLSX:
+		return ReadXLSX(data)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+// ReadCSV parses a CSV file. A UTF-8 byte order mark, as written by Excel, is skipped.
+func ReadCSV(r io.Reader) ([][]string, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFile, err)
+	}
+	if len(rows) > 0 && len(rows[0]) > 0 {
+		rows[0][0] = strings.TrimPrefix(rows[0][0], "\ufeff")
+	}
+	return rows, nil
+}
+
+// ReadXLSX parses the first worksheet of an XLSX file. Cells formatted as dates are returned
+// in RFC 3339 format, other numbers as they are stored.
+func ReadXLSX(data []byte) ([][]string, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFile, err)
+	}
+
+	files := make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+
+	sheetPath, date1904, err := firstSheetPath(files)
+	if err != nil {
+		return nil, err
+	}
+
+	var sharedStrings []string
+	if f, ok := files["xl/sharedStrings.xml"]; ok {
+		var sst xlsxSharedStrings
+		if err := decodePart(f, &sst); err != nil {
+			return nil, err
+		}
+		sharedStrings = make([]string, len(sst.Items))
+		for i, item := range sst.Items {
+			sharedStrings[i] = item.text()
+		}
+	}
+
+	var dateStyles map[int]bool
+	if f, ok := files["xl/styles.xml"]; ok {
+		var styles xlsxStyles
+		if err := decodePart(f, &styles); err != nil {
+			return nil, err
+		}
+		dateStyles = styles.dateStyles()
+	}
+
+	f, ok := files[sheetPath]
+	if !ok {
+		return nil, fmt.Errorf("%w: worksheet %s is missing", ErrInvalidFile, sheetPath)
+	}
+	var sheet xlsxWorksheet
+	if err := decodePart(f, &sheet); err != nil {
+		return nil, err
+	}
+
+	var rows [][]string
+	for _, row := range sheet.Rows {
+		index := len(rows)
+		if row.R > 0 {
+			index = row.R - 1
+		}
+		for len(rows) <= index {
+			rows = append(rows, nil)
+		}
+
+		var values []string
+		for _, cell := range row.Cells {
+			col := len(values)
+			if cell.R != "" {
+				if c, ok := columnIndex(cell.R); ok {
+					col = c
+				}
+			}
+			for len(values) <= col {
+				values = append(values, "")
+			}
+			values[col] = cell.value(sharedStrings, dateStyles, date1904)
+		}
+		rows[index] = values
+	}
+	return rows, nil
+}
+
+// firstSheetPath finds the part of the workbook's first worksheet and whether the workbook
+// counts dates from 1904
+func firstSheetPath(files map[string]*zip.File) (string, bool, error) {
+	const fallback = "xl/worksheets/sheet1.xml"
+
+	f, ok := files["xl/workbook.xml"]
+	if !ok {
+		return "", false, fmt.Errorf("%w: workbook is missing", ErrInvalidFile)
+	}
+	var workbook xlsxWorkbook
+	if err := decodePart(f, &workbook); err != nil {
+		return "", false, err
+	}
+	date1904 := workbook.Properties.Date1904 == "1" || workbook.Properties.Date1904 == "true"
+
+	rels, ok := files["xl/_rels/workbook.xml.rels"]
+	if !ok || len(workbook.Sheets) == 0 {
+		return fallback, date1904, nil
+	}
+	var relationships xlsxRelationships
+	if err := decodePart(rels, &relationships); err != nil {
+		return "", false, err
+	}
+	for _, rel := range relationships.Items {
+		if rel.ID != workbook.Sheets[0].RelID {
+			continue
+		}
+		if strings.HasPrefix(rel.Target, "/") {
+			return strings.TrimPrefix(rel.Target, "/"), date1904, nil
+		}
+		return path.Join("xl", rel.Target), date1904, nil
+	}
+	return fallback, date1904, nil
+}
+
+// decodePart unmarshals an XML part of an XLSX file
+func decodePart(f *zip.File, v interface{}) error {
+	rc, err := f.Open()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidFile, err)
+	}
+	defer rc.Close()
+
+	if err := xml.NewDecoder(io.LimitReader(rc, maxPartSize)).Decode(v); err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrInvalidFile, f.Name, err)
+	}
+	return nil
+}
+
+// columnIndex converts the column letters of a cell reference like "AB12" to a zero-based index
+func columnIndex(ref string) (int, bool) {
+	col := 0
+	n := 0
+	for _, r := range ref {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+		col = col*26 + int(r-'A'+1)
+		n++
+	}
+	if n == 0 || n > 3 {
+		return 0, false
+	}
+	return col - 1, true
+}
+
+// xlsxWorkbook is the part of xl/workbook.xml needed to find the first worksheet
+type xlsxWorkbook struct {
+	Properties struct {
+		Date1904 string `xml:"date1904,attr"`
+	} `xml:"workbookPr"`
+	Sheets []struct {
+		RelID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+// xlsxRelationships is xl/_rels/workbook.xml.rels
+type xlsxRelationships struct {
+	Items []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+// xlsxSharedStrings is xl/sharedStrings.xml
+type xlsxSharedStrings struct {
+	Items []xlsxRichText `xml:"si"`
+}
+
+// xlsxRichText is a plain or rich text string
+type xlsxRichText struct {
+	T    string `xml:"t"`
+	Runs []struct {
+		T string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (t xlsxRichText) text() string {
+	if len(t.Runs) == 0 {
+		return t.T
+	}
+	var b strings.Builder
+	b.WriteString(t.T)
+	for _, run := range t.Runs {
+		b.WriteString(run.T)
+	}
+	return b.String()
+}
+
+// xlsxStyles is the part of xl/styles.xml needed to recognize date cells
+type xlsxStyles struct {
+	NumFmts []struct {
+		ID   int    `xml:"numFmtId,attr"`
+		Code string `xml:"formatCode,attr"`
+	} `xml:"numFmts>numFmt"`
+	CellXfs []struct {
+		NumFmtID int `xml:"numFmtId,attr"`
+	} `xml:"cellXfs>xf"`
+}
+
+// dateStyles returns the indexes of the cell styles that format numbers as dates
+func (s xlsxStyles) dateStyles() map[int]bool {
+	customDates := make(map[int]bool)
+	for _, f := range s.NumFmts {
+		customDates[f.ID] = isDateFormatCode(f.Code)
+	}
+
+	styles := make(map[int]bool)
+	for i, xf := range s.CellXfs {
+		id := xf.NumFmtID
+		if (id >= 14 && id <= 22) || (id >= 45 && id <= 47) || customDates[id] {
+			styles[i] = true
+		}
+	}
+	return styles
+}
+
+// isDateFormatCode checks if a custom number format shows a date or time
+func isDateFormatCode(code string) bool {
+	inQuotes := false
+	inBrackets := false
+	for _, r := range strings.ToLower(code) {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+		case inQuotes:
+		case r == '[':
+			inBrackets = true
+		case r == ']':
+			inBrackets = false
+		case inBrackets:
+		case r == 'y' || r == 'm' || r == 'd' || r == 'h' || r == 's':
+			return true
+		}
+	}
+	return false
+}
+
+// xlsxWorksheet is a worksheet part
+type xlsxWorksheet struct {
+	Rows []struct {
+		R     int        `xml:"r,attr"`
+		Cells []xlsxCell `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+// xlsxCell is a cell of a worksheet
+type xlsxCell struct {
+	R      string        `xml:"r,attr"`
+	T      string        `xml:"t,attr"`
+	S      int           `xml:"s,attr"`
+	V      string        `xml:"v"`
+	Inline *xlsxRichText `xml:"is"`
+}
+
+// value returns the text of a cell
+func (c xlsxCell) value(sharedStrings []string, dateStyles map[int]bool, date1904 bool) string {
+	switch c.T {
+	case "s":
+		i, err := strconv.Atoi(strings.TrimSpace(c.V))
+		if err != nil || i < 0 || i >= len(sharedStrings) {
+			return ""
+		}
+		return sharedStrings[i]
+	case "inlineStr":
+		if c.Inline == nil {
+			return ""
+		}
+		return c.Inline.text()
+	case "b":
+		if strings.TrimSpace(c.V) == "1" {
+			return "TRUE"
+		}
+		return "FALSE"
+	case "str", "e":
+		return c.V
+	}
+
+	if dateStyles[c.S] {
+		if serial, err := strconv.ParseFloat(strings.TrimSpace(c.V), 64); err == nil {
+			return serialToTime(serial, date1904).Format(time.RFC3339)
+		}
+	}
+	return c.V
+}
+
+// serialToTime converts a spreadsheet date serial number to a time in UTC
+func serialToTime(serial float64, date1904 bool) time.Time {
+	epoch := time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)
+	if date1904 {
+		epoch = time.Date(1904, time.January, 1, 0, 0, 0, 0, time.UTC)
+	}
+	days := math.Floor(serial)
+	seconds := math.Round((serial - days) * 86400)
+	return epoch.AddDate(0, 0, int(days)).Add(time.Duration(seconds) * time.Second)
+}
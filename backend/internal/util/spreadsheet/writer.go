@@ -0,0 +1,187 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// maxCellLength is the most characters a spreadsheet application shows in a cell
+const maxCellLength = 32767
+
+// Writer streams rows to a spreadsheet file. The first row is written as the header.
+type Writer interface {
+	WriteRow(values []string) error
+	// Close finishes the file. It does not close the underlying writer.
+	Close() error
+}
+
+// NewWriter creates a writer for a format
+func NewWriter(format Format, w io.Writer) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(w)
+	case FormatXLSX:
+		return newXLSXWriter(w)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+// ContentType returns the MIME type of a format
+func ContentType(format Format) string {
+	if format == FormatXLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// csvWriter writes CSV with a byte order mark so spreadsheet applications detect UTF-8
+type csvWriter struct {
+	w    *csv.Writer
+	rows int
+}
+
+func newCSVWriter(w io.Writer) (*csvWriter, error) {
+	if _, err := io.WriteString(w, "\ufeff"); err != nil {
+		return nil, err
+	}
+	return &csvWriter{w: csv.NewWriter(w)}, nil
+}
+
+// WriteRow writes a row. Values that a spreadsheet application would run as a formula are
+// prefixed with a quote, so exported user input can't execute.
+func (cw *csvWriter) WriteRow(values []string) error {
+	record := make([]string, len(values))
+	for i, v := range values {
+		if v != "" && strings.ContainsRune("=+-@\t\r", rune(v[0])) {
+			v = "'" + v
+		}
+		record[i] = v
+	}
+	if err := cw.w.Write(record); err != nil {
+		return err
+	}
+
+	// Flush regularly so large exports stream instead of building up in memory
+	cw.rows++
+	if cw.rows%100 == 0 {
+		cw.w.Flush()
+		return cw.w.Error()
+	}
+	return nil
+}
+
+func (cw *csvWriter) Close() error {
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+// xlsxWriter streams a workbook with a single worksheet. Cells are written as inline strings,
+// so no shared string table has to be kept in memory.
+type xlsxWriter struct {
+	zw    *zip.Writer
+	sheet *bufio.Writer
+	rows  int
+}
+
+const xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types"><Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/><Default Extension="xml" ContentType="application/xml"/><Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/><Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/><Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/></Types>`
+
+const xlsxRootRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/></Relationships>`
+
+const xlsxWorkbookXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/></sheets></workbook>`
+
+const xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/><Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/></Relationships>`
+
+// xlsxStylesXML defines the default style and a bold style for the header row
+const xlsxStylesXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts><fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills><borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders><cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs><cellXfs count="2"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/><xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/></cellXfs></styleSheet>`
+
+const xlsxSheetStart = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetViews><sheetView workbookViewId="0"><pane ySplit="1" topLeftCell="A2" activePane="bottomLeft" state="frozen"/></sheetView></sheetViews><sheetData>`
+
+const xlsxSheetEnd = `</sheetData></worksheet>`
+
+func newXLSXWriter(w io.Writer) (*xlsxWriter, error) {
+	zw := zip.NewWriter(w)
+	parts := []struct{ name, body string }{
+		{"[Content_Types].xml", xlsxContentTypes},
+		{"_rels/.rels", xlsxRootRels},
+		{"xl/workbook.xml", xlsxWorkbookXML},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels},
+		{"xl/styles.xml", xlsxStylesXML},
+	}
+	for _, part := range parts {
+		f, err := zw.Create(part.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.WriteString(f, part.body); err != nil {
+			return nil, err
+		}
+	}
+
+	// The worksheet is the last part, so it can be streamed row by row
+	f, err := zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, err
+	}
+	sheet := bufio.NewWriter(f)
+	if _, err := sheet.WriteString(xlsxSheetStart); err != nil {
+		return nil, err
+	}
+	return &xlsxWriter{zw: zw, sheet: sheet}, nil
+}
+
+func (xw *xlsxWriter) WriteRow(values []string) error {
+	xw.rows++
+	style := ""
+	if xw.rows == 1 {
+		style = ` s="1"`
+	}
+
+	fmt.Fprintf(xw.sheet, `<row r="%d">`, xw.rows)
+	for i, v := range values {
+		if v == "" {
+			continue
+		}
+		if runes := []rune(v); len(runes) > maxCellLength {
+			v = string(runes[:maxCellLength])
+		}
+		fmt.Fprintf(xw.sheet, `<c r="%s%d" t="inlineStr"%s><is><t xml:space="preserve">`, columnName(i), xw.rows, style)
+		if err := xml.EscapeText(xw.sheet, []byte(v)); err != nil {
+			return err
+		}
+		xw.sheet.WriteString(`</t></is></c>`)
+	}
+	_, err := xw.sheet.WriteString(`</row>`)
+	return err
+}
+
+func (xw *xlsxWriter) Close() error {
+	if _, err := xw.sheet.WriteString(xlsxSheetEnd); err != nil {
+		return err
+	}
+	if err := xw.sheet.Flush(); err != nil {
+		return err
+	}
+	return xw.zw.Close()
+}
+
+// columnName converts a zero-based column index to its letters, e.g. 27 to "AB"
+func columnName(index int) string {
+	name := ""
+	for index >= 0 {
+		name = string(rune('A'+index%26)) + name
+		index = index/26 - 1
+	}
+	return name
+}
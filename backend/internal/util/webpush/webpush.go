@@ -0,0 +1,332 @@
+// Package webpush sends Web Push messages (RFC 8030) with payloads encrypted for the browser
+// (RFC 8291, aes128gcm) and the application server identified by VAPID (RFC 8292).
+package webpush
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// recordSize is the aes128gcm record size. Payloads are sent as a single record.
+	recordSize = 4096
+	// MaxPayloadSize is the largest payload that fits the single record push services must accept
+	MaxPayloadSize = recordSize - 16 - 1 - 86
+	// vapidTokenExpiry is the lifetime of VAPID tokens, push services reject more than 24 hours
+	vapidTokenExpiry = 12 * time.Hour
+)
+
+var (
+	// ErrSubscriptionExpired is returned when the push service no longer knows the subscription.
+	// The subscription should be deleted.
+	ErrSubscriptionExpired = errors.New("push subscription expired or unsubscribed")
+	// ErrPayloadTooLarge is returned for payloads larger than MaxPayloadSize
+	ErrPayloadTooLarge = errors.New("push payload too large")
+	// ErrInvalidSubscription is returned for subscriptions with malformed keys
+	ErrInvalidSubscription = errors.New("invalid push subscription keys")
+)
+
+// Subscription is a browser's push subscription as returned by PushManager.subscribe()
+type Subscription struct {
+	Endpoint string
+	P256dh   string // Base64url encoded public key of the browser
+	Auth     string // Base64url encoded authentication secret
+}
+
+// Urgency tells the push service how soon a message should reach a device on battery
+type Urgency string
+
+const (
+	UrgencyVeryLow Urgency = "very-low"
+	UrgencyLow     Urgency = "low"
+	UrgencyNormal  Urgency = "normal"
+	UrgencyHigh    Urgency = "high"
+)
+
+// Options configures the delivery of a message
+type Options struct {
+	TTL     time.Duration // How long the push service keeps the message for an offline device
+	Urgency Urgency
+	Topic   string // Replaces an undelivered message with the same topic
+}
+
+// Config holds the VAPID key pair identifying the application server
+type Config struct {
+	PublicKey  string // Base64url encoded uncompressed P-256 public key
+	PrivateKey string // Base64url encoded P-256 private key
+	Subject    string // mailto: or https: contact of the application server
+}
+
+// Client sends push messages
+type Client struct {
+	publicKey  string
+	privateKey *ecdsa.PrivateKey
+	subject    string
+	httpClient *http.Client
+}
+
+// NewClient creates a new push client from a VAPID key pair
+func NewClient(config Config, httpClient *http.Client) (*Client, error) {
+	privateKey, err := parsePrivateKey(config.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid VAPID private key: %w", err)
+	}
+
+	publicKey := encodeKey(publicKeyBytes(privateKey))
+	if config.PublicKey != "" && trimPadding(config.PublicKey) != publicKey {
+		return nil, errors.New("VAPID public key does not match the private key")
+	}
+
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+
+	return &Client{
+		publicKey:  publicKey,
+		privateKey: privateKey,
+		subject:    config.Subject,
+		httpClient: httpClient,
+	}, nil
+}
+
+// PublicKey returns the VAPID public key browsers pass as applicationServerKey when subscribing
+func (c *Client) PublicKey() string {
+	return c.publicKey
+}
+
+// Send encrypts a payload for a subscription and delivers it to the subscription's push service
+func (c *Client) Send(ctx context.Context, sub Subscription, payload []byte, opts Options) error {
+	if len(payload) > MaxPayloadSize {
+		return ErrPayloadTooLarge
+	}
+
+	body, err := encrypt(sub, payload)
+	if err != nil {
+		return err
+	}
+
+	endpoint, err := url.Parse(sub.Endpoint)
+	if err != nil || endpoint.Host == "" {
+		return ErrInvalidSubscription
+	}
+	token, err := c.vapidToken(endpoint.Scheme + "://" + endpoint.Host)
+	if err != nil {
+		return fmt.Errorf("failed to sign VAPID token: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("TTL", strconv.Itoa(int(opts.TTL.Seconds())))
+	req.Header.Set("Authorization", fmt.Sprintf("vapid t=%s, k=%s", token, c.publicKey))
+	if opts.Urgency != "" {
+		req.Header.Set("Urgency", string(opts.Urgency))
+	}
+	if opts.Topic != "" {
+		req.Header.Set("Topic", opts.Topic)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		return ErrSubscriptionExpired
+	case resp.StatusCode == http.StatusRequestEntityTooLarge:
+		return ErrPayloadTooLarge
+	case resp.StatusCode < 200 || resp.StatusCode > 299:
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("push service returned %d: %s", resp.StatusCode, bytes.TrimSpace(msg))
+	}
+
+	return nil
+}
+
+// vapidToken signs the JWT identifying the application server to the push service at audience
+func (c *Client) vapidToken(audience string) (string, error) {
+	claims := jwt.MapClaims{
+		"aud": audience,
+		"exp": time.Now().Add(vapidTokenExpiry).Unix(),
+	}
+	if c.subject != "" {
+		claims["sub"] = c.subject
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodES256, claims).SignedString(c.privateKey)
+}
+
+// Validate checks that the subscription has an endpoint URL and well-formed keys
+func (s Subscription) Validate() error {
+	endpoint, err := url.Parse(s.Endpoint)
+	if err != nil || endpoint.Host == "" || (endpoint.Scheme != "https" && endpoint.Scheme != "http") {
+		return ErrInvalidSubscription
+	}
+	_, _, err = s.keys()
+	return err
+}
+
+// keys decodes the public key and authentication secret of the browser
+func (s Subscription) keys() (*ecdh.PublicKey, []byte, error) {
+	publicKeyBytes, err := decodeKey(s.P256dh)
+	if err != nil {
+		return nil, nil, ErrInvalidSubscription
+	}
+	publicKey, err := ecdh.P256().NewPublicKey(publicKeyBytes)
+	if err != nil {
+		return nil, nil, ErrInvalidSubscription
+	}
+	authSecret, err := decodeKey(s.Auth)
+	if err != nil || len(authSecret) != 16 {
+		return nil, nil, ErrInvalidSubscription
+	}
+	return publicKey, authSecret, nil
+}
+
+// encrypt encrypts a payload for a subscription as a single aes128gcm record (RFC 8291)
+func encrypt(sub Subscription, payload []byte) ([]byte, error) {
+	uaPublic, authSecret, err := sub.keys()
+	if err != nil {
+		return nil, err
+	}
+	uaPublicBytes := uaPublic.Bytes()
+
+	// Ephemeral key pair of the application server, used for this message only
+	asPrivate, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	asPublicBytes := asPrivate.PublicKey().Bytes()
+
+	sharedSecret, err := asPrivate.ECDH(uaPublic)
+	if err != nil {
+		return nil, err
+	}
+
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	// Combine the shared secret with the browser's authentication secret
+	prkKey, err := hkdf.Extract(sha256.New, sharedSecret, authSecret)
+	if err != nil {
+		return nil, err
+	}
+	keyInfo := "WebPush: info\x00" + string(uaPublicBytes) + string(asPublicBytes)
+	ikm, err := hkdf.Expand(sha256.New, prkKey, keyInfo, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	// Derive the content encryption key and nonce
+	prk, err := hkdf.Extract(sha256.New, ikm, salt)
+	if err != nil {
+		return nil, err
+	}
+	cek, err := hkdf.Expand(sha256.New, prk, "Content-Encoding: aes128gcm\x00", 16)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := hkdf.Expand(sha256.New, prk, "Content-Encoding: nonce\x00", 12)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	// The 0x02 delimiter marks the last (and only) record
+	plaintext := make([]byte, 0, len(payload)+1)
+	plaintext = append(plaintext, payload...)
+	plaintext = append(plaintext, 0x02)
+
+	// Header: salt, record size, key id length, key id (the ephemeral public key)
+	body := make([]byte, 0, 16+4+1+len(asPublicBytes)+len(plaintext)+gcm.Overhead())
+	body = append(body, salt...)
+	body = binary.BigEndian.AppendUint32(body, recordSize)
+	body = append(body, byte(len(asPublicBytes)))
+	body = append(body, asPublicBytes...)
+
+	return gcm.Seal(body, nonce, plaintext, nil), nil
+}
+
+// parsePrivateKey parses a base64url encoded P-256 private key for ES256 signing
+func parsePrivateKey(encoded string) (*ecdsa.PrivateKey, error) {
+	raw, err := decodeKey(encoded)
+	if err != nil {
+		return nil, err
+	}
+	key, err := ecdh.P256().NewPrivateKey(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	// Uncompressed point: 0x04 || X || Y
+	point := key.PublicKey().Bytes()
+	return &ecdsa.PrivateKey{
+		PublicKey: ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(point[1:33]),
+			Y:     new(big.Int).SetBytes(point[33:]),
+		},
+		D: new(big.Int).SetBytes(raw),
+	}, nil
+}
+
+// publicKeyBytes returns the uncompressed public key of a P-256 private key
+func publicKeyBytes(key *ecdsa.PrivateKey) []byte {
+	point := make([]byte, 65)
+	point[0] = 0x04
+	key.X.FillBytes(point[1:33])
+	key.Y.FillBytes(point[33:])
+	return point
+}
+
+// decodeKey decodes a base64url key, with or without padding as browsers and key generators differ
+func decodeKey(encoded string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(trimPadding(encoded))
+}
+
+// encodeKey encodes a key as unpadded base64url
+func encodeKey(key []byte) string {
+	return base64.RawURLEncoding.EncodeToString(key)
+}
+
+// trimPadding removes base64 padding
+func trimPadding(s string) string {
+	for len(s) > 0 && s[len(s)-1] == '=' {
+		s = s[:len(s)-1]
+	}
+	return s
+}
@@ -0,0 +1,81 @@
+-- Migration: Persist bulk import queues, per-URL import jobs and link extraction tasks
+-- Previously held in memory by ImportQueueService and lost on restart
+
+CREATE TABLE IF NOT EXISTS import_queues (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    admin_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    source_url TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    total_jobs INTEGER NOT NULL DEFAULT 0,
+    completed INTEGER NOT NULL DEFAULT 0,
+    failed INTEGER NOT NULL DEFAULT 0,
+    cancelled INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_import_queues_status ON import_queues(status);
+CREATE INDEX IF NOT EXISTS idx_import_queues_admin_id ON import_queues(admin_id);
+CREATE INDEX IF NOT EXISTS idx_import_queues_created_at ON import_queues(created_at DESC);
+
+CREATE TABLE IF NOT EXISTS import_jobs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    queue_id UUID NOT NULL REFERENCES import_queues(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL DEFAULT 0,
+    url TEXT NOT NULL,
+    title VARCHAR(500),
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    error TEXT,
+    job_id UUID REFERENCES jobs(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_import_jobs_queue_id ON import_jobs(queue_id, position);
+CREATE INDEX IF NOT EXISTS idx_import_jobs_status ON import_jobs(status);
+CREATE INDEX IF NOT EXISTS idx_import_jobs_job_id ON import_jobs(job_id);
+
+CREATE TABLE IF NOT EXISTS link_extraction_tasks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    source_url TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    links JSONB NOT NULL DEFAULT '[]',
+    total INTEGER NOT NULL DEFAULT 0,
+    error TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_link_extraction_tasks_status ON link_extraction_tasks(status);
+CREATE INDEX IF NOT EXISTS idx_link_extraction_tasks_created_at ON link_extraction_tasks(created_at DESC);
+
+-- Add triggers for updated_at
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_trigger WHERE tgname = 'update_import_queues_updated_at'
+    ) THEN
+        CREATE TRIGGER update_import_queues_updated_at
+        BEFORE UPDATE ON import_queues
+        FOR EACH ROW
+        EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_trigger WHERE tgname = 'update_import_jobs_updated_at'
+    ) THEN
+        CREATE TRIGGER update_import_jobs_updated_at
+        BEFORE UPDATE ON import_jobs
+        FOR EACH ROW
+        EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_trigger WHERE tgname = 'update_link_extraction_tasks_updated_at'
+    ) THEN
+        CREATE TRIGGER update_link_extraction_tasks_updated_at
+        BEFORE UPDATE ON link_extraction_tasks
+        FOR EACH ROW
+        EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+END $$;
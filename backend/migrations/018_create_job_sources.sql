@@ -0,0 +1,47 @@
+-- Migration: Recurring career-page job sources
+-- A job source is a company careers page that is re-crawled on a schedule;
+-- new postings are imported through the import queue and vanished ones are closed
+
+CREATE TABLE IF NOT EXISTS job_sources (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    company_id UUID REFERENCES companies(id) ON DELETE SET NULL,
+    created_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    url TEXT NOT NULL,
+    extraction_strategy VARCHAR(20) NOT NULL DEFAULT 'AUTO',
+    crawl_interval_hours INTEGER NOT NULL DEFAULT 24,
+    close_missing_jobs BOOLEAN NOT NULL DEFAULT TRUE,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    last_crawled_at TIMESTAMP,
+    next_crawl_at TIMESTAMP,
+    last_status VARCHAR(20),
+    last_error TEXT,
+    last_links_found INTEGER NOT NULL DEFAULT 0,
+    last_new_jobs INTEGER NOT NULL DEFAULT 0,
+    last_closed_jobs INTEGER NOT NULL DEFAULT 0,
+    last_queue_id UUID REFERENCES import_queues(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_job_sources_company_id ON job_sources(company_id);
+CREATE INDEX IF NOT EXISTS idx_job_sources_next_crawl_at ON job_sources(next_crawl_at) WHERE is_active = TRUE;
+
+-- Link import queues (and through them imported jobs) to the source that produced them
+ALTER TABLE import_queues ADD COLUMN IF NOT EXISTS job_source_id UUID REFERENCES job_sources(id) ON DELETE SET NULL;
+ALTER TABLE import_queues ADD COLUMN IF NOT EXISTS company_id UUID REFERENCES companies(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_import_queues_job_source_id ON import_queues(job_source_id);
+
+-- Add trigger for updated_at
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_trigger WHERE tgname = 'update_job_sources_updated_at'
+    ) THEN
+        CREATE TRIGGER update_job_sources_updated_at
+        BEFORE UPDATE ON job_sources
+        FOR EACH ROW
+        EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+END $$;
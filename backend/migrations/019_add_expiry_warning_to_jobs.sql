@@ -0,0 +1,8 @@
+-- Migration: Track expiry warnings sent to employers
+-- Prevents the job cron scheduler from sending the same warning on every run
+
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS expiry_warning_sent_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_jobs_expires_at ON jobs(expires_at) WHERE status = 'ACTIVE' AND deleted_at IS NULL;
+
+COMMENT ON COLUMN jobs.expiry_warning_sent_at IS 'When the employer was warned that the job is about to expire';
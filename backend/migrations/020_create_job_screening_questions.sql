@@ -0,0 +1,40 @@
+-- Migration: Screening questions on jobs
+-- Employers attach questions to a job; validated answers are stored on applications.answers
+-- and "knockout" answers either reject the application or flag it for review
+
+CREATE TABLE IF NOT EXISTS job_screening_questions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    job_id UUID NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL DEFAULT 0,
+    question TEXT NOT NULL,
+    question_type VARCHAR(20) NOT NULL,
+    options TEXT[],
+    min_value NUMERIC,
+    max_value NUMERIC,
+    is_required BOOLEAN NOT NULL DEFAULT TRUE,
+    knockout_action VARCHAR(10) NOT NULL DEFAULT 'NONE',
+    knockout_answers TEXT[],
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_job_screening_questions_job_id ON job_screening_questions(job_id, position);
+
+-- Applications that gave a FLAG knockout answer
+ALTER TABLE applications ADD COLUMN IF NOT EXISTS is_flagged BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE applications ADD COLUMN IF NOT EXISTS flag_reason TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_applications_is_flagged ON applications(job_id) WHERE is_flagged = TRUE;
+
+-- Add trigger for updated_at
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_trigger WHERE tgname = 'update_job_screening_questions_updated_at'
+    ) THEN
+        CREATE TRIGGER update_job_screening_questions_updated_at
+        BEFORE UPDATE ON job_screening_questions
+        FOR EACH ROW
+        EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+END $$;
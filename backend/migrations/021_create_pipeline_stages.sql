@@ -0,0 +1,38 @@
+-- Migration: Configurable hiring pipelines
+-- Companies define their own ordered pipeline stages. Every stage maps to an
+-- application_status category so existing status-based analytics keep working.
+
+CREATE TABLE IF NOT EXISTS pipeline_stages (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    company_id UUID NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    position INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_pipeline_stages_company_id ON pipeline_stages(company_id, position);
+
+-- Current custom stage of an application (NULL when the company uses the default pipeline)
+ALTER TABLE applications ADD COLUMN IF NOT EXISTS stage_id UUID REFERENCES pipeline_stages(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_applications_stage_id ON applications(stage_id);
+
+-- Stage names are copied so history stays readable after a stage is renamed or removed
+ALTER TABLE application_status_history ADD COLUMN IF NOT EXISTS from_stage VARCHAR(100);
+ALTER TABLE application_status_history ADD COLUMN IF NOT EXISTS to_stage VARCHAR(100);
+ALTER TABLE application_status_history ADD COLUMN IF NOT EXISTS to_stage_id UUID REFERENCES pipeline_stages(id) ON DELETE SET NULL;
+
+-- Add trigger for updated_at
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_trigger WHERE tgname = 'update_pipeline_stages_updated_at'
+    ) THEN
+        CREATE TRIGGER update_pipeline_stages_updated_at
+        BEFORE UPDATE ON pipeline_stages
+        FOR EACH ROW
+        EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+END $$;
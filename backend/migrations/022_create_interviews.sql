@@ -0,0 +1,62 @@
+-- Migration: Interview scheduling
+-- Employers propose time slots for an application's interview, the candidate picks one
+-- and everyone receives an iCalendar invite. Interviewers are company team members.
+
+CREATE TABLE IF NOT EXISTS interviews (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    application_id UUID NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+    created_by UUID NOT NULL REFERENCES users(id),
+    title VARCHAR(255) NOT NULL,
+    interview_type VARCHAR(20) NOT NULL,
+    duration_minutes INTEGER NOT NULL,
+    location TEXT,
+    meeting_url TEXT,
+    notes TEXT,
+    timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    status VARCHAR(20) NOT NULL DEFAULT 'PROPOSED',
+    selected_slot_id UUID,
+    scheduled_start TIMESTAMP,
+    scheduled_end TIMESTAMP,
+    sequence INTEGER NOT NULL DEFAULT 0,
+    cancel_reason TEXT,
+    cancelled_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_interviews_application_id ON interviews(application_id);
+CREATE INDEX IF NOT EXISTS idx_interviews_status ON interviews(status);
+
+CREATE TABLE IF NOT EXISTS interview_slots (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    interview_id UUID NOT NULL REFERENCES interviews(id) ON DELETE CASCADE,
+    start_time TIMESTAMP NOT NULL,
+    end_time TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_interview_slots_interview_id ON interview_slots(interview_id, start_time);
+
+CREATE TABLE IF NOT EXISTS interview_interviewers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    interview_id UUID NOT NULL REFERENCES interviews(id) ON DELETE CASCADE,
+    team_member_id UUID NOT NULL REFERENCES company_team_members(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (interview_id, team_member_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_interview_interviewers_user_id ON interview_interviewers(user_id);
+
+-- Add trigger for updated_at
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_trigger WHERE tgname = 'update_interviews_updated_at'
+    ) THEN
+        CREATE TRIGGER update_interviews_updated_at
+        BEFORE UPDATE ON interviews
+        FOR EACH ROW
+        EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+END $$;
@@ -0,0 +1,65 @@
+-- Migration: Structured interview scorecards
+-- Employers configure rating criteria per job; each reviewer (job owner, interviewer or
+-- company team member) fills in one scorecard per application with a hire recommendation.
+
+CREATE TABLE IF NOT EXISTS job_scorecard_criteria (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    job_id UUID NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL DEFAULT 0,
+    name VARCHAR(100) NOT NULL,
+    description TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_job_scorecard_criteria_job_id ON job_scorecard_criteria(job_id, position);
+
+CREATE TABLE IF NOT EXISTS application_scorecards (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    application_id UUID NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+    reviewer_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    recommendation VARCHAR(20),
+    summary TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'DRAFT',
+    submitted_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (application_id, reviewer_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_application_scorecards_application_id ON application_scorecards(application_id);
+
+-- Criterion names are copied so submitted scorecards stay readable after criteria change
+CREATE TABLE IF NOT EXISTS scorecard_ratings (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    scorecard_id UUID NOT NULL REFERENCES application_scorecards(id) ON DELETE CASCADE,
+    criterion_id UUID REFERENCES job_scorecard_criteria(id) ON DELETE SET NULL,
+    criterion_name VARCHAR(100) NOT NULL,
+    rating INTEGER NOT NULL CHECK (rating >= 1 AND rating <= 5),
+    comment TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_scorecard_ratings_scorecard_id ON scorecard_ratings(scorecard_id);
+
+-- Add triggers for updated_at
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_trigger WHERE tgname = 'update_job_scorecard_criteria_updated_at'
+    ) THEN
+        CREATE TRIGGER update_job_scorecard_criteria_updated_at
+        BEFORE UPDATE ON job_scorecard_criteria
+        FOR EACH ROW
+        EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_trigger WHERE tgname = 'update_application_scorecards_updated_at'
+    ) THEN
+        CREATE TRIGGER update_application_scorecards_updated_at
+        BEFORE UPDATE ON application_scorecards
+        FOR EACH ROW
+        EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+END $$;
@@ -0,0 +1,61 @@
+-- Migration: Threaded employer notes
+-- Notes are attached to an application or a candidate profile and shared with the
+-- company team. Replies reference their parent note; mentioned team members are notified.
+
+CREATE TABLE IF NOT EXISTS notes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    company_id UUID NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+    subject_type VARCHAR(20) NOT NULL,
+    subject_id UUID NOT NULL,
+    parent_id UUID REFERENCES notes(id) ON DELETE CASCADE,
+    author_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    content TEXT NOT NULL,
+    edited_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_notes_subject ON notes(company_id, subject_type, subject_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_notes_parent_id ON notes(parent_id);
+
+CREATE TABLE IF NOT EXISTS note_mentions (
+    note_id UUID NOT NULL REFERENCES notes(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (note_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_note_mentions_user_id ON note_mentions(user_id);
+
+-- Carry over existing single-text notes, attributed to the job owner / saving employer
+INSERT INTO notes (company_id, subject_type, subject_id, author_id, content, created_at, updated_at)
+SELECT COALESCE(j.company_id, c.id), 'APPLICATION', a.id, j.employer_id, a.employer_notes, a.updated_at, a.updated_at
+FROM applications a
+JOIN jobs j ON j.id = a.job_id
+LEFT JOIN companies c ON c.created_by = j.employer_id AND c.deleted_at IS NULL
+WHERE a.employer_notes IS NOT NULL AND TRIM(a.employer_notes) <> ''
+  AND COALESCE(j.company_id, c.id) IS NOT NULL
+  AND NOT EXISTS (SELECT 1 FROM notes n WHERE n.subject_type = 'APPLICATION' AND n.subject_id = a.id);
+
+INSERT INTO notes (company_id, subject_type, subject_id, author_id, content, created_at, updated_at)
+SELECT c.id, 'CANDIDATE', sc.candidate_id, sc.employer_id, sc.notes, sc.updated_at, sc.updated_at
+FROM saved_candidates sc
+JOIN companies c ON c.created_by = sc.employer_id AND c.deleted_at IS NULL
+WHERE sc.notes IS NOT NULL AND TRIM(sc.notes) <> ''
+  AND NOT EXISTS (
+      SELECT 1 FROM notes n
+      WHERE n.subject_type = 'CANDIDATE' AND n.subject_id = sc.candidate_id AND n.author_id = sc.employer_id
+  );
+
+-- Add trigger for updated_at
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_trigger WHERE tgname = 'update_notes_updated_at'
+    ) THEN
+        CREATE TRIGGER update_notes_updated_at
+        BEFORE UPDATE ON notes
+        FOR EACH ROW
+        EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+END $$;
@@ -0,0 +1,46 @@
+-- Migration: Offer letters
+-- Employers draft an offer for an application and send it to the candidate, who accepts
+-- or declines it. Accepting hires the candidate; the job closes once all openings are filled.
+
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS openings INTEGER NOT NULL DEFAULT 1;
+
+CREATE TABLE IF NOT EXISTS offers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    application_id UUID NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+    created_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'DRAFT',
+    salary INTEGER NOT NULL,
+    salary_currency VARCHAR(3) NOT NULL DEFAULT 'USD',
+    salary_period VARCHAR(20) NOT NULL DEFAULT 'YEARLY',
+    start_date DATE NOT NULL,
+    expires_at TIMESTAMP,
+    message TEXT,
+    document_name VARCHAR(255),
+    document_path VARCHAR(500),
+    previous_status VARCHAR(20),
+    sent_at TIMESTAMP,
+    responded_at TIMESTAMP,
+    decline_reason TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_offers_application_id ON offers(application_id);
+CREATE INDEX IF NOT EXISTS idx_offers_status ON offers(status);
+
+-- Only one offer per application can be in progress at a time
+CREATE UNIQUE INDEX IF NOT EXISTS idx_offers_application_open
+    ON offers(application_id) WHERE status IN ('DRAFT', 'SENT');
+
+-- Add trigger for updated_at
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_trigger WHERE tgname = 'update_offers_updated_at'
+    ) THEN
+        CREATE TRIGGER update_offers_updated_at
+        BEFORE UPDATE ON offers
+        FOR EACH ROW
+        EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+END $$;
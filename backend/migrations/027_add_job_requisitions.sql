@@ -0,0 +1,20 @@
+-- Migration: Job openings and requisitions
+-- Openings (added with offers) is the job's headcount. A job also gets an internal
+-- requisition reference, a hiring manager from the company team, and the time it was filled.
+
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS openings INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS requisition_id VARCHAR(100);
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS hiring_manager_id UUID REFERENCES company_team_members(id) ON DELETE SET NULL;
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS filled_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_jobs_requisition_id ON jobs(employer_id, requisition_id) WHERE requisition_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_jobs_hiring_manager_id ON jobs(hiring_manager_id);
+
+-- Jobs that already reached their headcount count as filled when they were last updated
+UPDATE jobs SET filled_at = updated_at
+WHERE filled_at IS NULL
+  AND status = 'CLOSED'
+  AND openings <= (
+      SELECT COUNT(*) FROM applications
+      WHERE applications.job_id = jobs.id AND applications.status = 'HIRED'
+  );
@@ -0,0 +1,20 @@
+-- Migration: Job drafts, scheduled publishing and company approval
+-- A SCHEDULED job goes live when its published_at is reached. A PENDING_REVIEW job
+-- waits for a company ADMIN or OWNER when the company requires approval of recruiter postings.
+
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM pg_type WHERE typname = 'job_status') THEN
+        ALTER TYPE job_status ADD VALUE IF NOT EXISTS 'SCHEDULED';
+        ALTER TYPE job_status ADD VALUE IF NOT EXISTS 'PENDING_REVIEW';
+    END IF;
+END $$;
+
+ALTER TABLE companies ADD COLUMN IF NOT EXISTS require_job_approval BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS reviewed_by UUID REFERENCES users(id) ON DELETE SET NULL;
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS reviewed_at TIMESTAMP;
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS review_note TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_jobs_scheduled ON jobs(published_at) WHERE status::text = 'SCHEDULED' AND deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_jobs_pending_review ON jobs(company_id) WHERE status::text = 'PENDING_REVIEW' AND deleted_at IS NULL;
@@ -0,0 +1,18 @@
+-- Migration: Job revisions
+-- Every update of a job stores the resulting version as a snapshot, so employers and admins
+-- can see what changed and restore a previous version. Revision 1 is the job as it was
+-- before its first tracked update.
+
+CREATE TABLE IF NOT EXISTS job_revisions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    job_id UUID NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+    revision_number INTEGER NOT NULL,
+    snapshot JSONB NOT NULL,
+    source VARCHAR(20) NOT NULL,
+    changed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    restored_from INTEGER,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (job_id, revision_number)
+);
+
+CREATE INDEX IF NOT EXISTS idx_job_revisions_job_id ON job_revisions(job_id);
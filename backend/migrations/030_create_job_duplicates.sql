@@ -0,0 +1,46 @@
+-- Migration: Duplicate job detection
+-- Fingerprints hold the normalised title, company and location of a job and a simhash of
+-- its description. Likely duplicates found at create/import time are flagged for admins,
+-- who can dismiss the flag or merge the two jobs.
+
+CREATE TABLE IF NOT EXISTS job_fingerprints (
+    job_id UUID PRIMARY KEY REFERENCES jobs(id) ON DELETE CASCADE,
+    title_key VARCHAR(255) NOT NULL,
+    company_key VARCHAR(255) NOT NULL,
+    location_key VARCHAR(255) NOT NULL DEFAULT '',
+    description_hash BIGINT NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_job_fingerprints_company_key ON job_fingerprints(company_key);
+
+CREATE TABLE IF NOT EXISTS job_duplicates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    job_id UUID NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+    duplicate_of_id UUID NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+    score REAL NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+    resolved_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    resolved_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (job_id, duplicate_of_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_job_duplicates_status ON job_duplicates(status);
+CREATE INDEX IF NOT EXISTS idx_job_duplicates_duplicate_of_id ON job_duplicates(duplicate_of_id);
+
+-- Import jobs skipped because the job already exists
+ALTER TABLE import_jobs ADD COLUMN IF NOT EXISTS duplicate_of_id UUID REFERENCES jobs(id) ON DELETE SET NULL;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_trigger WHERE tgname = 'update_job_duplicates_updated_at'
+    ) THEN
+        CREATE TRIGGER update_job_duplicates_updated_at
+        BEFORE UPDATE ON job_duplicates
+        FOR EACH ROW
+        EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+END $$;
@@ -0,0 +1,50 @@
+-- Migration: Saved searches and job alerts
+-- A saved search stores the filters of a job search. New jobs matching it are recorded in
+-- saved_search_matches and sent to the job seeker instantly, daily or weekly, by email and in-app.
+
+CREATE TABLE IF NOT EXISTS saved_searches (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    filters JSONB NOT NULL DEFAULT '{}',
+    frequency VARCHAR(20) NOT NULL DEFAULT 'DAILY',
+    email_enabled BOOLEAN NOT NULL DEFAULT true,
+    is_active BOOLEAN NOT NULL DEFAULT true,
+    unsubscribe_token VARCHAR(64) NOT NULL UNIQUE,
+    last_matched_at TIMESTAMP,
+    last_notified_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_saved_searches_user_id ON saved_searches(user_id);
+CREATE INDEX IF NOT EXISTS idx_saved_searches_active ON saved_searches(frequency) WHERE is_active = true;
+
+CREATE TABLE IF NOT EXISTS saved_search_matches (
+    saved_search_id UUID NOT NULL REFERENCES saved_searches(id) ON DELETE CASCADE,
+    job_id UUID NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+    matched_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    notified_at TIMESTAMP,
+    PRIMARY KEY (saved_search_id, job_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_saved_search_matches_pending ON saved_search_matches(saved_search_id) WHERE notified_at IS NULL;
+
+-- Job alert notification preferences
+ALTER TABLE notification_preferences
+ADD COLUMN IF NOT EXISTS email_job_alert BOOLEAN DEFAULT TRUE;
+
+ALTER TABLE notification_preferences
+ADD COLUMN IF NOT EXISTS app_job_alert BOOLEAN DEFAULT TRUE;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_trigger WHERE tgname = 'update_saved_searches_updated_at'
+    ) THEN
+        CREATE TRIGGER update_saved_searches_updated_at
+        BEFORE UPDATE ON saved_searches
+        FOR EACH ROW
+        EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+END $$;
@@ -0,0 +1,20 @@
+-- Migration: Notification emails and daily digests
+-- Notifications of high-volume types (e.g. new applications) are queued in notification_digest_items
+-- and emailed once a day in a single digest when the user keeps email_daily_digest enabled.
+
+CREATE TABLE IF NOT EXISTS notification_digest_items (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    type VARCHAR(50) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    message TEXT NOT NULL,
+    link VARCHAR(500),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    sent_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_digest_items_pending
+    ON notification_digest_items(user_id, created_at) WHERE sent_at IS NULL;
+
+ALTER TABLE notification_preferences
+ADD COLUMN IF NOT EXISTS email_daily_digest BOOLEAN DEFAULT TRUE;
@@ -0,0 +1,64 @@
+-- Migration: Web push subscriptions
+-- A push subscription is a browser (device) of a user registered for Web Push. Subscriptions the
+-- push service reports as expired, or that keep failing, are deleted when sending.
+
+CREATE TABLE IF NOT EXISTS push_subscriptions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    endpoint TEXT NOT NULL UNIQUE,
+    p256dh VARCHAR(255) NOT NULL,
+    auth VARCHAR(255) NOT NULL,
+    user_agent VARCHAR(500),
+    failure_count INTEGER NOT NULL DEFAULT 0,
+    last_success_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_push_subscriptions_user_id ON push_subscriptions(user_id);
+
+-- Push notification preferences
+ALTER TABLE notification_preferences
+ADD COLUMN IF NOT EXISTS push_application_status BOOLEAN DEFAULT TRUE;
+
+ALTER TABLE notification_preferences
+ADD COLUMN IF NOT EXISTS push_new_application BOOLEAN DEFAULT TRUE;
+
+ALTER TABLE notification_preferences
+ADD COLUMN IF NOT EXISTS push_new_job BOOLEAN DEFAULT TRUE;
+
+ALTER TABLE notification_preferences
+ADD COLUMN IF NOT EXISTS push_job_expiring BOOLEAN DEFAULT TRUE;
+
+ALTER TABLE notification_preferences
+ADD COLUMN IF NOT EXISTS push_profile_viewed BOOLEAN DEFAULT FALSE;
+
+ALTER TABLE notification_preferences
+ADD COLUMN IF NOT EXISTS push_company_review BOOLEAN DEFAULT TRUE;
+
+ALTER TABLE notification_preferences
+ADD COLUMN IF NOT EXISTS push_team_invitation BOOLEAN DEFAULT TRUE;
+
+ALTER TABLE notification_preferences
+ADD COLUMN IF NOT EXISTS push_job_moderation BOOLEAN DEFAULT TRUE;
+
+ALTER TABLE notification_preferences
+ADD COLUMN IF NOT EXISTS push_company_verification BOOLEAN DEFAULT TRUE;
+
+ALTER TABLE notification_preferences
+ADD COLUMN IF NOT EXISTS push_new_message BOOLEAN DEFAULT TRUE;
+
+ALTER TABLE notification_preferences
+ADD COLUMN IF NOT EXISTS push_job_alert BOOLEAN DEFAULT TRUE;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_trigger WHERE tgname = 'update_push_subscriptions_updated_at'
+    ) THEN
+        CREATE TRIGGER update_push_subscriptions_updated_at
+        BEFORE UPDATE ON push_subscriptions
+        FOR EACH ROW
+        EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+END $$;
@@ -0,0 +1,63 @@
+-- Migration: Outbound webhooks
+-- Companies register endpoints that receive signed JSON events. Every event sent to an endpoint
+-- is recorded as a delivery, which is retried with exponential backoff until it succeeds or
+-- runs out of attempts. Deliveries can be replayed as a new delivery of the same event.
+
+CREATE TABLE IF NOT EXISTS webhooks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    company_id UUID NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    url TEXT NOT NULL,
+    description VARCHAR(255),
+    events TEXT[] NOT NULL,
+    secret VARCHAR(100) NOT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhooks_company_id ON webhooks(company_id);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    webhook_id UUID NOT NULL REFERENCES webhooks(id) ON DELETE CASCADE,
+    event_id UUID NOT NULL,
+    event VARCHAR(50) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP,
+    last_attempt_at TIMESTAMP,
+    response_status INTEGER,
+    response_body TEXT,
+    error TEXT,
+    duration_ms INTEGER,
+    delivered_at TIMESTAMP,
+    replay_of UUID REFERENCES webhook_deliveries(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_webhook_id ON webhook_deliveries(webhook_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON webhook_deliveries(next_attempt_at) WHERE status = 'PENDING';
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_trigger WHERE tgname = 'update_webhooks_updated_at'
+    ) THEN
+        CREATE TRIGGER update_webhooks_updated_at
+        BEFORE UPDATE ON webhooks
+        FOR EACH ROW
+        EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_trigger WHERE tgname = 'update_webhook_deliveries_updated_at'
+    ) THEN
+        CREATE TRIGGER update_webhook_deliveries_updated_at
+        BEFORE UPDATE ON webhook_deliveries
+        FOR EACH ROW
+        EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+END $$;
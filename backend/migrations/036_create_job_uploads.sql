@@ -0,0 +1,64 @@
+-- Migration: Bulk job uploads
+-- Employers and admins upload a CSV or XLSX file with one job per row. Every row is validated
+-- first and the report is stored; once the upload is confirmed, the valid rows are created as
+-- jobs in the background.
+
+CREATE TABLE IF NOT EXISTS job_uploads (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    uploaded_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    mode VARCHAR(20) NOT NULL,
+    filename VARCHAR(255) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'VALIDATED',
+    total_rows INTEGER NOT NULL DEFAULT 0,
+    valid_rows INTEGER NOT NULL DEFAULT 0,
+    created_jobs INTEGER NOT NULL DEFAULT 0,
+    failed_rows INTEGER NOT NULL DEFAULT 0,
+    ignored_columns TEXT[],
+    confirmed_at TIMESTAMP,
+    completed_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_job_uploads_uploaded_by ON job_uploads(uploaded_by, mode, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_job_uploads_pending ON job_uploads(confirmed_at) WHERE status = 'PENDING';
+
+CREATE TABLE IF NOT EXISTS job_upload_rows (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    upload_id UUID NOT NULL REFERENCES job_uploads(id) ON DELETE CASCADE,
+    row_number INTEGER NOT NULL,
+    title VARCHAR(255),
+    data JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    errors JSONB,
+    error TEXT,
+    job_id UUID REFERENCES jobs(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_job_upload_rows_upload_id ON job_upload_rows(upload_id, row_number);
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_trigger WHERE tgname = 'update_job_uploads_updated_at'
+    ) THEN
+        CREATE TRIGGER update_job_uploads_updated_at
+        BEFORE UPDATE ON job_uploads
+        FOR EACH ROW
+        EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+END $$;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_trigger WHERE tgname = 'update_job_upload_rows_updated_at'
+    ) THEN
+        CREATE TRIGGER update_job_upload_rows_updated_at
+        BEFORE UPDATE ON job_upload_rows
+        FOR EACH ROW
+        EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+END $$;
@@ -0,0 +1,10 @@
+-- Migration: Lease claimed import jobs
+-- A worker claims an import job for a limited time. Jobs whose claim expired, e.g. because the
+-- instance processing them crashed, are claimed again by any instance, instead of every restart
+-- returning all processing jobs to pending.
+
+ALTER TABLE import_jobs ADD COLUMN IF NOT EXISTS claimed_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_import_jobs_claimed_at ON import_jobs(claimed_at) WHERE status = 'processing';
+
+COMMENT ON COLUMN import_jobs.claimed_at IS 'When a worker claimed the job; a processing job is claimed again once its lease expired';
@@ -0,0 +1,18 @@
+-- Migration: Link imported jobs to their job source
+-- Jobs were only linked to the source that imported them through import_jobs and import_queues,
+-- so deleting an import queue stopped the source from closing those jobs once their postings
+-- disappeared. The source is now stored on the job itself.
+
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS job_source_id UUID REFERENCES job_sources(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_jobs_job_source_id ON jobs(job_source_id) WHERE job_source_id IS NOT NULL;
+
+-- Backfill jobs whose import queue still exists
+UPDATE jobs SET job_source_id = iq.job_source_id
+FROM import_jobs ij
+JOIN import_queues iq ON iq.id = ij.queue_id
+WHERE ij.job_id = jobs.id
+AND iq.job_source_id IS NOT NULL
+AND jobs.job_source_id IS NULL;
+
+COMMENT ON COLUMN jobs.job_source_id IS 'Job source whose crawl imported the job';
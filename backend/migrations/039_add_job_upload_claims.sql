@@ -0,0 +1,10 @@
+-- Migration: Lease claimed job uploads
+-- A worker claims an upload for a limited time and renews the claim after every row. Uploads whose
+-- claim expired, e.g. because the instance processing them crashed, are claimed again by any
+-- instance, instead of every restart returning all processing uploads to pending.
+
+ALTER TABLE job_uploads ADD COLUMN IF NOT EXISTS claimed_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_job_uploads_claimed_at ON job_uploads(claimed_at) WHERE status = 'PROCESSING';
+
+COMMENT ON COLUMN job_uploads.claimed_at IS 'When a worker last claimed or renewed the upload; a processing upload is claimed again once its lease expired';
@@ -0,0 +1,10 @@
+-- Migration: Lease claimed link extraction tasks
+-- Import workers claim link extraction tasks the way they claim import jobs. Tasks whose claim
+-- expired, e.g. because the instance extracting them crashed, are claimed again by any instance,
+-- instead of every restart extracting all unfinished tasks again.
+
+ALTER TABLE link_extraction_tasks ADD COLUMN IF NOT EXISTS claimed_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_link_extraction_tasks_unfinished ON link_extraction_tasks(created_at) WHERE status IN ('pending', 'processing');
+
+COMMENT ON COLUMN link_extraction_tasks.claimed_at IS 'When a worker claimed the task; a processing task is claimed again once its lease expired';